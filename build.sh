@@ -32,6 +32,9 @@ else
     popd
 fi
 
+go get gopkg.in/yaml.v2
+go get github.com/prometheus/client_golang/prometheus
+
 pushd $GOPATH/src/$CLIENT_IMPORT_PATH
 go build
 popd
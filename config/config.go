@@ -16,10 +16,14 @@
 package config
 
 import (
+	"fmt"
 	"reflect"
 	"time"
 
 	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/discovery"
+	"github.com/hazelcast/hazelcast-go-client/logger"
+	"github.com/hazelcast/hazelcast-go-client/security"
 	"github.com/hazelcast/hazelcast-go-client/serialization"
 )
 
@@ -50,6 +54,21 @@ type Config struct {
 	// flakeIDGeneratorConfigMap is mapping of names to flakeIDGeneratorConfigs.
 	flakeIDGeneratorConfigMap map[string]*FlakeIDGeneratorConfig
 
+	// nearCacheConfigMap is mapping of map names to nearCacheConfigs.
+	nearCacheConfigMap map[string]*NearCacheConfig
+
+	// reliableTopicConfigMap is mapping of reliable topic names to reliableTopicConfigs.
+	reliableTopicConfigMap map[string]*ReliableTopicConfig
+
+	// loadBalancer is used to select the member that non key based operations are sent to.
+	loadBalancer core.LoadBalancer
+
+	// logger is the logger of the client.
+	logger logger.Logger
+
+	// credentialsFactory creates the credentials that the client authenticates with.
+	credentialsFactory security.CredentialsFactory
+
 	properties Properties
 }
 
@@ -61,6 +80,8 @@ func New() *Config {
 		serializationConfig:       NewSerializationConfig(),
 		lifecycleListeners:        make([]interface{}, 0),
 		flakeIDGeneratorConfigMap: make(map[string]*FlakeIDGeneratorConfig),
+		nearCacheConfigMap:        make(map[string]*NearCacheConfig),
+		reliableTopicConfigMap:    make(map[string]*ReliableTopicConfig),
 		properties:                make(Properties),
 	}
 }
@@ -90,6 +111,48 @@ func (cc *Config) SerializationConfig() *SerializationConfig {
 	return cc.serializationConfig
 }
 
+// LoadBalancer returns the configured LoadBalancer.
+// If it is nil, the client uses a random LoadBalancer.
+func (cc *Config) LoadBalancer() core.LoadBalancer {
+	return cc.loadBalancer
+}
+
+// SetLoadBalancer sets the LoadBalancer that is used to select the member that non key based
+// operations are sent to. A LoadBalancer instance should not be shared between clients.
+func (cc *Config) SetLoadBalancer(loadBalancer core.LoadBalancer) {
+	cc.loadBalancer = loadBalancer
+}
+
+// Logger returns the configured logger.
+// If it is nil, the client uses a logger.DefaultLogger with the level set by the
+// "hazelcast.logging.level" property.
+func (cc *Config) Logger() logger.Logger {
+	return cc.logger
+}
+
+// SetLogger sets the logger of the client.
+func (cc *Config) SetLogger(logger logger.Logger) {
+	cc.logger = logger
+}
+
+// CredentialsFactory returns the configured CredentialsFactory.
+// If it is nil, the client authenticates with the group name and password in GroupConfig.
+func (cc *Config) CredentialsFactory() security.CredentialsFactory {
+	return cc.credentialsFactory
+}
+
+// SetCredentialsFactory sets the factory of the credentials that the client authenticates with.
+// The credentials are sent with the custom authentication message, so that they can be checked by
+// the login modules configured on the cluster.
+func (cc *Config) SetCredentialsFactory(credentialsFactory security.CredentialsFactory) {
+	cc.credentialsFactory = credentialsFactory
+}
+
+// SetCredentials sets a CredentialsFactory which always returns the given credentials.
+func (cc *Config) SetCredentials(credentials security.Credentials) {
+	cc.credentialsFactory = security.NewStaticCredentialsFactory(credentials)
+}
+
 // SetProperty sets a new pair of property as (name, value).
 func (cc *Config) SetProperty(name string, value string) {
 	cc.properties[name] = value
@@ -124,6 +187,37 @@ func (cc *Config) AddFlakeIDGeneratorConfig(config *FlakeIDGeneratorConfig) {
 	cc.flakeIDGeneratorConfigMap[config.Name()] = config
 }
 
+// GetNearCacheConfig returns the NearCacheConfig for the map with the given name.
+// GetNearCacheConfig returns nil if the Near Cache is not configured for the map.
+func (cc *Config) GetNearCacheConfig(name string) *NearCacheConfig {
+	return cc.nearCacheConfigMap[name]
+}
+
+// AddNearCacheConfig adds the given config to the Near Cache configurations map.
+// The Near Cache is enabled for the map with the same name as the config.
+func (cc *Config) AddNearCacheConfig(config *NearCacheConfig) {
+	cc.nearCacheConfigMap[config.Name()] = config
+}
+
+// NearCacheConfigs returns the mapping of map names to Near Cache configurations.
+func (cc *Config) NearCacheConfigs() map[string]*NearCacheConfig {
+	return cc.nearCacheConfigMap
+}
+
+// GetReliableTopicConfig returns the ReliableTopicConfig for the reliable topic with the given name.
+// If no configuration is added for the name, it returns a new one with the default parameters.
+func (cc *Config) GetReliableTopicConfig(name string) *ReliableTopicConfig {
+	if config, found := cc.reliableTopicConfigMap[name]; found {
+		return config
+	}
+	return NewReliableTopicConfig(name)
+}
+
+// AddReliableTopicConfig adds the given config to the reliable topic configurations map.
+func (cc *Config) AddReliableTopicConfig(config *ReliableTopicConfig) {
+	cc.reliableTopicConfigMap[config.Name()] = config
+}
+
 // AddMembershipListener adds a membership listener.
 func (cc *Config) AddMembershipListener(listener interface{}) {
 	cc.membershipListeners = append(cc.membershipListeners, listener)
@@ -172,6 +266,15 @@ type SerializationConfig struct {
 
 	// classDefinitions contains ClassDefinitions for portable structs.
 	classDefinitions []serialization.ClassDefinition
+
+	// structs is a map of the struct types that are serialized as Portable by their hz tags and their IDs.
+	structs map[reflect.Type]StructID
+}
+
+// StructID is the portable factory and class ID pair of a struct type registered with SerializationConfig.AddStruct.
+type StructID struct {
+	FactoryID int32
+	ClassID   int32
 }
 
 // NewSerializationConfig returns a SerializationConfig with default values.
@@ -182,6 +285,7 @@ func NewSerializationConfig() *SerializationConfig {
 		portableFactories:         make(map[int32]serialization.PortableFactory),
 		portableVersion:           0,
 		customSerializers:         make(map[reflect.Type]serialization.Serializer),
+		structs:                   make(map[reflect.Type]StructID),
 	}
 }
 
@@ -220,6 +324,11 @@ func (sc *SerializationConfig) ClassDefinitions() []serialization.ClassDefinitio
 	return sc.classDefinitions
 }
 
+// Structs returns a map of the struct types registered with AddStruct and their IDs.
+func (sc *SerializationConfig) Structs() map[reflect.Type]StructID {
+	return sc.structs
+}
+
 // SetByteOrder sets the byte order. If true, it means BigEndian, otherwise LittleEndian.
 func (sc *SerializationConfig) SetByteOrder(isBigEndian bool) {
 	sc.isBigEndian = isBigEndian
@@ -240,6 +349,37 @@ func (sc *SerializationConfig) AddClassDefinition(classDefinition ...serializati
 	sc.classDefinitions = append(sc.classDefinitions, classDefinition...)
 }
 
+// AddStruct registers a struct type, or a pointer to a struct type, to be serialized as a Portable with the given
+// factory and class IDs, so that the members can query its fields.
+// Only the fields with an `hz:"name"` tag are serialized, under the name in the tag, and the class definition is
+// generated from them. The supported field types are bool, byte, uint16, int16, int32, int64, int, float32, float64,
+// string, the slices of these except int, and the registered structs, pointers to them and slices of them.
+// The objects are deserialized as values of the registered type.
+func (sc *SerializationConfig) AddStruct(typ reflect.Type, factoryID int32, classID int32) error {
+	structType := typ
+	if structType != nil && structType.Kind() == reflect.Ptr {
+		structType = structType.Elem()
+	}
+	if structType == nil || structType.Kind() != reflect.Struct {
+		return core.NewHazelcastIllegalArgumentError(fmt.Sprintf("%v is not a struct or a pointer to a struct", typ), nil)
+	}
+	if classID == 0 {
+		return core.NewHazelcastIllegalArgumentError("portable class id cannot be zero", nil)
+	}
+	id := StructID{FactoryID: factoryID, ClassID: classID}
+	for registered, registeredID := range sc.structs {
+		if registered == typ {
+			continue
+		}
+		if registeredID == id || registered == structType || registered.Kind() == reflect.Ptr && registered.Elem() == structType {
+			return core.NewHazelcastIllegalArgumentError(fmt.Sprintf("%v conflicts with the registered struct %v",
+				typ, registered), nil)
+		}
+	}
+	sc.structs[typ] = id
+	return nil
+}
+
 // SetPortableVersion sets the portable version.
 func (sc *SerializationConfig) SetPortableVersion(version int32) {
 	sc.portableVersion = version
@@ -308,6 +448,9 @@ type NetworkConfig struct {
 	// addresses are the candidate addresses slice that client will use to establish initial connection.
 	addresses []string
 
+	// addressProvider provides the candidate addresses instead of the addresses slice if it is set.
+	addressProvider discovery.AddressProvider
+
 	// connectionAttemptLimit is how many times client will retry to connect to the members in the addresses slice.
 	// While client is trying to connect initially to one of the members in the addresses slice, all might not be
 	// available. Instead of giving up, returning Error and stopping client, it will attempt to retry as many times as
@@ -333,6 +476,9 @@ type NetworkConfig struct {
 	// executed on the owner.
 	// The cached table is updated every 10 seconds.
 	smartRouting bool
+
+	// sslConfig is the TLS configuration used for the connections to the members.
+	sslConfig *SSLConfig
 }
 
 // NewNetworkConfig returns a new NetworkConfig with default configuration.
@@ -344,6 +490,7 @@ func NewNetworkConfig() *NetworkConfig {
 		connectionTimeout:       5 * time.Second,
 		redoOperation:           false,
 		smartRouting:            true,
+		sslConfig:               NewSSLConfig(),
 	}
 }
 
@@ -352,6 +499,12 @@ func (nc *NetworkConfig) Addresses() []string {
 	return nc.addresses
 }
 
+// AddressProvider returns the AddressProvider of the candidate addresses.
+// If it is nil, the client uses the addresses slice.
+func (nc *NetworkConfig) AddressProvider() discovery.AddressProvider {
+	return nc.addressProvider
+}
+
 // ConnectionAttemptLimit returns connection attempt limit.
 func (nc *NetworkConfig) ConnectionAttemptLimit() int32 {
 	return nc.connectionAttemptLimit
@@ -377,6 +530,11 @@ func (nc *NetworkConfig) IsSmartRouting() bool {
 	return nc.smartRouting
 }
 
+// SSLConfig returns the TLS configuration of the client.
+func (nc *NetworkConfig) SSLConfig() *SSLConfig {
+	return nc.sslConfig
+}
+
 // AddAddress adds given addresses to candidate address list that client will use to establish initial connection.
 func (nc *NetworkConfig) AddAddress(addresses ...string) {
 	nc.addresses = append(nc.addresses, addresses...)
@@ -423,3 +581,14 @@ func (nc *NetworkConfig) SetRedoOperation(redoOperation bool) {
 func (nc *NetworkConfig) SetSmartRouting(smartRouting bool) {
 	nc.smartRouting = smartRouting
 }
+
+// SetAddressProvider sets the AddressProvider that is called on every connection attempt to the cluster,
+// including the reconnections. It replaces the addresses slice.
+func (nc *NetworkConfig) SetAddressProvider(addressProvider discovery.AddressProvider) {
+	nc.addressProvider = addressProvider
+}
+
+// SetSSLConfig sets the TLS configuration of the client.
+func (nc *NetworkConfig) SetSSLConfig(sslConfig *SSLConfig) {
+	nc.sslConfig = sslConfig
+}
@@ -0,0 +1,616 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+	"github.com/hazelcast/hazelcast-go-client/serialization/classdef"
+	"gopkg.in/yaml.v2"
+)
+
+// FileFormat is the format of a declarative configuration.
+type FileFormat int
+
+const (
+	// YAMLFormat is the YAML format.
+	YAMLFormat FileFormat = iota
+	// JSONFormat is the JSON format.
+	JSONFormat
+)
+
+const (
+	bigEndian    = "BIG_ENDIAN"
+	littleEndian = "LITTLE_ENDIAN"
+)
+
+var envVariablePattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_.]*)\}`)
+
+var fieldTypes = map[string]int32{
+	"portable":       classdef.TypePortable,
+	"byte":           classdef.TypeByte,
+	"bool":           classdef.TypeBool,
+	"uint16":         classdef.TypeUint16,
+	"int16":          classdef.TypeInt16,
+	"int32":          classdef.TypeInt32,
+	"int64":          classdef.TypeInt64,
+	"float32":        classdef.TypeFloat32,
+	"float64":        classdef.TypeFloat64,
+	"utf":            classdef.TypeUTF,
+	"portable-array": classdef.TypePortableArray,
+	"byte-array":     classdef.TypeByteArray,
+	"bool-array":     classdef.TypeBoolArray,
+	"uint16-array":   classdef.TypeUint16Array,
+	"int16-array":    classdef.TypeInt16Array,
+	"int32-array":    classdef.TypeInt32Array,
+	"int64-array":    classdef.TypeInt64Array,
+	"float32-array":  classdef.TypeFloat32Array,
+	"float64-array":  classdef.TypeFloat64Array,
+	"utf-array":      classdef.TypeUTFArray,
+}
+
+// fieldAdders adds the fields of the types other than portable and portable-array to a class definition.
+var fieldAdders = map[int32]func(builder *classdef.ClassDefinitionBuilder, fieldName string) error{
+	classdef.TypeByte:         (*classdef.ClassDefinitionBuilder).AddByteField,
+	classdef.TypeBool:         (*classdef.ClassDefinitionBuilder).AddBoolField,
+	classdef.TypeUint16:       (*classdef.ClassDefinitionBuilder).AddUInt16Field,
+	classdef.TypeInt16:        (*classdef.ClassDefinitionBuilder).AddInt16Field,
+	classdef.TypeInt32:        (*classdef.ClassDefinitionBuilder).AddInt32Field,
+	classdef.TypeInt64:        (*classdef.ClassDefinitionBuilder).AddInt64Field,
+	classdef.TypeFloat32:      (*classdef.ClassDefinitionBuilder).AddFloat32Field,
+	classdef.TypeFloat64:      (*classdef.ClassDefinitionBuilder).AddFloat64Field,
+	classdef.TypeUTF:          (*classdef.ClassDefinitionBuilder).AddUTFField,
+	classdef.TypeByteArray:    (*classdef.ClassDefinitionBuilder).AddByteArrayField,
+	classdef.TypeBoolArray:    (*classdef.ClassDefinitionBuilder).AddBoolArrayField,
+	classdef.TypeUint16Array:  (*classdef.ClassDefinitionBuilder).AddUInt16ArrayField,
+	classdef.TypeInt16Array:   (*classdef.ClassDefinitionBuilder).AddInt16ArrayField,
+	classdef.TypeInt32Array:   (*classdef.ClassDefinitionBuilder).AddInt32ArrayField,
+	classdef.TypeInt64Array:   (*classdef.ClassDefinitionBuilder).AddInt64ArrayField,
+	classdef.TypeFloat32Array: (*classdef.ClassDefinitionBuilder).AddFloat32ArrayField,
+	classdef.TypeFloat64Array: (*classdef.ClassDefinitionBuilder).AddFloat64ArrayField,
+	classdef.TypeUTFArray:     (*classdef.ClassDefinitionBuilder).AddUTFArrayField,
+}
+
+// The types below define the layout of the declarative configuration.
+// The pointer fields are left nil if they are not set, so that the defaults of Config are kept.
+
+type fileConfigRoot struct {
+	Client *fileConfig `yaml:"hazelcast-client" json:"hazelcast-client"`
+}
+
+type fileConfig struct {
+	Group             *fileGroupConfig                       `yaml:"group,omitempty" json:"group,omitempty"`
+	Network           *fileNetworkConfig                     `yaml:"network,omitempty" json:"network,omitempty"`
+	Serialization     *fileSerializationConfig               `yaml:"serialization,omitempty" json:"serialization,omitempty"`
+	FlakeIDGenerators map[string]*fileFlakeIDGeneratorConfig `yaml:"flake-id-generator,omitempty" json:"flake-id-generator,omitempty"`
+	Properties        map[string]interface{}                 `yaml:"properties,omitempty" json:"properties,omitempty"`
+}
+
+type fileGroupConfig struct {
+	Name     *string `yaml:"name,omitempty" json:"name,omitempty"`
+	Password *string `yaml:"password,omitempty" json:"password,omitempty"`
+}
+
+type fileNetworkConfig struct {
+	ClusterMembers          []string `yaml:"cluster-members,omitempty" json:"cluster-members,omitempty"`
+	SmartRouting            *bool    `yaml:"smart-routing,omitempty" json:"smart-routing,omitempty"`
+	RedoOperation           *bool    `yaml:"redo-operation,omitempty" json:"redo-operation,omitempty"`
+	ConnectionTimeout       *string  `yaml:"connection-timeout,omitempty" json:"connection-timeout,omitempty"`
+	ConnectionAttemptLimit  *int32   `yaml:"connection-attempt-limit,omitempty" json:"connection-attempt-limit,omitempty"`
+	ConnectionAttemptPeriod *string  `yaml:"connection-attempt-period,omitempty" json:"connection-attempt-period,omitempty"`
+}
+
+type fileSerializationConfig struct {
+	ByteOrder        *string                `yaml:"byte-order,omitempty" json:"byte-order,omitempty"`
+	PortableVersion  *int32                 `yaml:"portable-version,omitempty" json:"portable-version,omitempty"`
+	ClassDefinitions []*fileClassDefinition `yaml:"class-definitions,omitempty" json:"class-definitions,omitempty"`
+}
+
+type fileClassDefinition struct {
+	FactoryID int32                  `yaml:"factory-id" json:"factory-id"`
+	ClassID   int32                  `yaml:"class-id" json:"class-id"`
+	Version   int32                  `yaml:"version" json:"version"`
+	Fields    []*fileFieldDefinition `yaml:"fields,omitempty" json:"fields,omitempty"`
+}
+
+type fileFieldDefinition struct {
+	Name      string `yaml:"name" json:"name"`
+	Type      string `yaml:"type" json:"type"`
+	FactoryID int32  `yaml:"factory-id,omitempty" json:"factory-id,omitempty"`
+	ClassID   int32  `yaml:"class-id,omitempty" json:"class-id,omitempty"`
+}
+
+type fileFlakeIDGeneratorConfig struct {
+	PrefetchCount          *int32 `yaml:"prefetch-count,omitempty" json:"prefetch-count,omitempty"`
+	PrefetchValidityMillis *int64 `yaml:"prefetch-validity-millis,omitempty" json:"prefetch-validity-millis,omitempty"`
+}
+
+// LoadFromFile loads a Config from the given YAML or JSON file.
+// The format is determined by the extension of the file, which should be one of ".yaml", ".yml" or ".json".
+// See LoadFromReader for the layout of the file.
+func LoadFromFile(path string) (*Config, error) {
+	var format FileFormat
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		format = YAMLFormat
+	case ".json":
+		format = JSONFormat
+	default:
+		return nil, core.NewHazelcastIllegalArgumentError("unknown configuration file extension: "+path, nil)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, core.NewHazelcastIOError("error while opening the configuration file "+path, err)
+	}
+	defer file.Close()
+	return LoadFromReader(file, format)
+}
+
+// LoadFromReader loads a Config in the given format from the given reader.
+// The configuration is under the "hazelcast-client" root, e.g.:
+//
+//	hazelcast-client:
+//	  group:
+//	    name: dev
+//	    password: ${GROUP_PASSWORD}
+//	  network:
+//	    cluster-members:
+//	      - 127.0.0.1:5701
+//	    smart-routing: true
+//	    redo-operation: false
+//	    connection-timeout: 5s
+//	    connection-attempt-limit: 2
+//	    connection-attempt-period: 3s
+//	  serialization:
+//	    byte-order: BIG_ENDIAN
+//	    portable-version: 0
+//	    class-definitions:
+//	      - factory-id: 1
+//	        class-id: 1
+//	        version: 0
+//	        fields:
+//	          - name: age
+//	            type: int32
+//	          - name: address
+//	            type: portable
+//	            factory-id: 1
+//	            class-id: 2
+//	  flake-id-generator:
+//	    idGenerator:
+//	      prefetch-count: 100
+//	      prefetch-validity-millis: 600000
+//	  properties:
+//	    hazelcast.client.heartbeat.timeout: 60000
+//
+// The durations are in the format accepted by time.ParseDuration.
+// The ${NAME} references in the values are replaced with the value of the NAME environment variable
+// after the configuration is parsed, so the values of the variables do not need to be escaped.
+// A value which refers to a variable can be used for a boolean or a numeric key as well, e.g.
+// "smart-routing: ${SMART_ROUTING}". In JSON such values are written as strings.
+// LoadFromReader returns a HazelcastIllegalArgumentError if the configuration contains an unknown key or
+// an invalid value, or refers to an environment variable that is not set.
+func LoadFromReader(reader io.Reader, format FileFormat) (*Config, error) {
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, core.NewHazelcastIOError("error while reading the configuration", err)
+	}
+	var document interface{}
+	switch format {
+	case YAMLFormat:
+		err = yaml.UnmarshalStrict(content, &document)
+	case JSONFormat:
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.UseNumber()
+		err = decoder.Decode(&document)
+	default:
+		return nil, core.NewHazelcastIllegalArgumentError(fmt.Sprintf("unknown configuration format: %d", format), nil)
+	}
+	if err != nil {
+		return nil, core.NewHazelcastIllegalArgumentError("invalid configuration", err)
+	}
+	root := &fileConfigRoot{}
+	if document, err = substituteEnvVariables(document, reflect.TypeOf(root)); err != nil {
+		return nil, err
+	}
+	if err = decodeDocument(document, format, root); err != nil {
+		return nil, core.NewHazelcastIllegalArgumentError("invalid configuration", err)
+	}
+	if root.Client == nil {
+		return nil, core.NewHazelcastIllegalArgumentError("configuration does not have the hazelcast-client root", nil)
+	}
+	return root.Client.toConfig()
+}
+
+// decodeDocument decodes the parsed document to the configuration types, rejecting the unknown keys.
+func decodeDocument(document interface{}, format FileFormat, root *fileConfigRoot) error {
+	if format == YAMLFormat {
+		content, err := yaml.Marshal(document)
+		if err != nil {
+			return err
+		}
+		return yaml.UnmarshalStrict(content, root)
+	}
+	content, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(root)
+}
+
+// substituteEnvVariables replaces the environment variable references in the string values of the parsed
+// document, before it is decoded to the configuration types. t is the type that the value is decoded to,
+// a substituted value is converted to it if it is a boolean or an integer, so that the references can be
+// used for the values of every type.
+func substituteEnvVariables(value interface{}, t reflect.Type) (interface{}, error) {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		for key, element := range v {
+			substituted, err := substituteEnvVariables(element, elementType(t, fmt.Sprint(key)))
+			if err != nil {
+				return nil, err
+			}
+			v[key] = substituted
+		}
+	case map[string]interface{}:
+		for key, element := range v {
+			substituted, err := substituteEnvVariables(element, elementType(t, key))
+			if err != nil {
+				return nil, err
+			}
+			v[key] = substituted
+		}
+	case []interface{}:
+		for i, element := range v {
+			substituted, err := substituteEnvVariables(element, elementType(t, ""))
+			if err != nil {
+				return nil, err
+			}
+			v[i] = substituted
+		}
+	case string:
+		if !envVariablePattern.MatchString(v) {
+			return v, nil
+		}
+		substituted, err := substituteEnvVariablesInString(v)
+		if err != nil {
+			return nil, err
+		}
+		return convertSubstitutedValue(substituted, t)
+	}
+	return value, nil
+}
+
+// elementType returns the type of the value with the given key in a struct or a map, or the type of
+// the elements of a slice. It returns nil if the type is not known.
+func elementType(t reflect.Type, key string) reflect.Type {
+	if t == nil {
+		return nil
+	}
+	switch t.Kind() {
+	case reflect.Map, reflect.Slice:
+		return t.Elem()
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0] == key {
+				return t.Field(i).Type
+			}
+		}
+	}
+	return nil
+}
+
+func convertSubstitutedValue(value string, t reflect.Type) (interface{}, error) {
+	if t == nil {
+		return value, nil
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, core.NewHazelcastIllegalArgumentError("environment variable referenced in the configuration "+
+				"is not a valid bool", err)
+		}
+		return b, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(value, 10, t.Bits())
+		if err != nil {
+			return nil, core.NewHazelcastIllegalArgumentError("environment variable referenced in the configuration "+
+				"is not a valid "+t.Kind().String(), err)
+		}
+		return i, nil
+	}
+	return value, nil
+}
+
+func substituteEnvVariablesInString(s string) (string, error) {
+	var err error
+	s = envVariablePattern.ReplaceAllStringFunc(s, func(reference string) string {
+		name := envVariablePattern.FindStringSubmatch(reference)[1]
+		value, found := os.LookupEnv(name)
+		if !found && err == nil {
+			err = core.NewHazelcastIllegalArgumentError("environment variable "+name+
+				" referenced in the configuration is not set", nil)
+		}
+		return value
+	})
+	return s, err
+}
+
+func (fc *fileConfig) toConfig() (*Config, error) {
+	config := New()
+	if fc.Group != nil {
+		if fc.Group.Name != nil {
+			config.GroupConfig().SetName(*fc.Group.Name)
+		}
+		if fc.Group.Password != nil {
+			config.GroupConfig().SetPassword(*fc.Group.Password)
+		}
+	}
+	if fc.Network != nil {
+		if err := fc.Network.apply(config.NetworkConfig()); err != nil {
+			return nil, err
+		}
+	}
+	if fc.Serialization != nil {
+		if err := fc.Serialization.apply(config.SerializationConfig()); err != nil {
+			return nil, err
+		}
+	}
+	for name, flakeIDGeneratorConfig := range fc.FlakeIDGenerators {
+		idGeneratorConfig, err := flakeIDGeneratorConfig.toConfig(name)
+		if err != nil {
+			return nil, err
+		}
+		config.AddFlakeIDGeneratorConfig(idGeneratorConfig)
+	}
+	for name, value := range fc.Properties {
+		switch v := value.(type) {
+		case string:
+			config.SetProperty(name, v)
+		case float64:
+			config.SetProperty(name, strconv.FormatFloat(v, 'f', -1, 64))
+		case int, bool:
+			config.SetProperty(name, fmt.Sprint(v))
+		default:
+			return nil, core.NewHazelcastIllegalArgumentError(fmt.Sprintf("invalid value for property %s: %v",
+				name, value), nil)
+		}
+	}
+	return config, nil
+}
+
+func (fnc *fileNetworkConfig) apply(networkConfig *NetworkConfig) error {
+	networkConfig.AddAddress(fnc.ClusterMembers...)
+	if fnc.SmartRouting != nil {
+		networkConfig.SetSmartRouting(*fnc.SmartRouting)
+	}
+	if fnc.RedoOperation != nil {
+		networkConfig.SetRedoOperation(*fnc.RedoOperation)
+	}
+	if fnc.ConnectionAttemptLimit != nil {
+		networkConfig.SetConnectionAttemptLimit(*fnc.ConnectionAttemptLimit)
+	}
+	if fnc.ConnectionTimeout != nil {
+		connectionTimeout, err := parseDuration("connection-timeout", *fnc.ConnectionTimeout)
+		if err != nil {
+			return err
+		}
+		networkConfig.SetConnectionTimeout(connectionTimeout)
+	}
+	if fnc.ConnectionAttemptPeriod != nil {
+		connectionAttemptPeriod, err := parseDuration("connection-attempt-period", *fnc.ConnectionAttemptPeriod)
+		if err != nil {
+			return err
+		}
+		networkConfig.SetConnectionAttemptPeriod(connectionAttemptPeriod)
+	}
+	return nil
+}
+
+func parseDuration(key string, value string) (time.Duration, error) {
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, core.NewHazelcastIllegalArgumentError("invalid duration for "+key+": "+value, err)
+	}
+	if duration < 0 {
+		return 0, core.NewHazelcastIllegalArgumentError(key+" cannot be negative", nil)
+	}
+	return duration, nil
+}
+
+func (fsc *fileSerializationConfig) apply(serializationConfig *SerializationConfig) error {
+	if fsc.ByteOrder != nil {
+		switch *fsc.ByteOrder {
+		case bigEndian:
+			serializationConfig.SetByteOrder(true)
+		case littleEndian:
+			serializationConfig.SetByteOrder(false)
+		default:
+			return core.NewHazelcastIllegalArgumentError("byte-order should be "+bigEndian+" or "+littleEndian+
+				", found "+*fsc.ByteOrder, nil)
+		}
+	}
+	if fsc.PortableVersion != nil {
+		serializationConfig.SetPortableVersion(*fsc.PortableVersion)
+	}
+	for _, fileClassDefinition := range fsc.ClassDefinitions {
+		classDefinition, err := fileClassDefinition.toClassDefinition()
+		if err != nil {
+			return err
+		}
+		serializationConfig.AddClassDefinition(classDefinition)
+	}
+	return nil
+}
+
+func (fcd *fileClassDefinition) toClassDefinition() (serialization.ClassDefinition, error) {
+	builder := classdef.NewClassDefinitionBuilder(fcd.FactoryID, fcd.ClassID, fcd.Version)
+	fieldNames := make(map[string]struct{}, len(fcd.Fields))
+	for _, field := range fcd.Fields {
+		fieldType, found := fieldTypes[field.Type]
+		if !found {
+			return nil, core.NewHazelcastIllegalArgumentError(fmt.Sprintf("unknown type %s of field %s in class definition"+
+				" with factory id %d and class id %d", field.Type, field.Name, fcd.FactoryID, fcd.ClassID), nil)
+		}
+		isPortable := fieldType == classdef.TypePortable || fieldType == classdef.TypePortableArray
+		if isPortable && field.ClassID == 0 {
+			return nil, core.NewHazelcastIllegalArgumentError("portable field "+field.Name+" should have a non zero class-id", nil)
+		}
+		if !isPortable && (field.FactoryID != 0 || field.ClassID != 0) {
+			return nil, core.NewHazelcastIllegalArgumentError("only portable fields can have factory-id and class-id, field "+
+				field.Name+" is "+field.Type, nil)
+		}
+		if _, found := fieldNames[field.Name]; found {
+			return nil, core.NewHazelcastIllegalArgumentError("duplicate field "+field.Name+" in class definition", nil)
+		}
+		fieldNames[field.Name] = struct{}{}
+		var err error
+		switch fieldType {
+		case classdef.TypePortable:
+			err = builder.AddPortableField(field.Name, classdef.NewClassDefinitionBuilder(field.FactoryID, field.ClassID,
+				fcd.Version).Build())
+		case classdef.TypePortableArray:
+			err = builder.AddPortableArrayField(field.Name, classdef.NewClassDefinitionBuilder(field.FactoryID,
+				field.ClassID, fcd.Version).Build())
+		default:
+			err = fieldAdders[fieldType](builder, field.Name)
+		}
+		if err != nil {
+			return nil, core.NewHazelcastIllegalArgumentError("invalid field "+field.Name+" in class definition", err)
+		}
+	}
+	return builder.Build(), nil
+}
+
+func (ffc *fileFlakeIDGeneratorConfig) toConfig(name string) (*FlakeIDGeneratorConfig, error) {
+	config := NewFlakeIDGeneratorConfig(name)
+	if ffc == nil {
+		return config, nil
+	}
+	if ffc.PrefetchCount != nil {
+		if *ffc.PrefetchCount < 0 || *ffc.PrefetchCount > MaximumPrefetchCount {
+			return nil, core.NewHazelcastIllegalArgumentError(fmt.Sprintf("prefetch-count of flake id generator %s"+
+				" should be in the range of 0-%d", name, MaximumPrefetchCount), nil)
+		}
+		config.SetPrefetchCount(*ffc.PrefetchCount)
+	}
+	if ffc.PrefetchValidityMillis != nil {
+		if *ffc.PrefetchValidityMillis < 0 {
+			return nil, core.NewHazelcastIllegalArgumentError("prefetch-validity-millis of flake id generator "+name+
+				" cannot be negative", nil)
+		}
+		config.SetPrefetchValidityMillis(*ffc.PrefetchValidityMillis)
+	}
+	return config, nil
+}
+
+// DumpYAML returns the effective configuration in the YAML layout that is accepted by LoadFromReader.
+// Only the parts of the configuration that can be loaded declaratively are included.
+// Note that the group password is included as well.
+func (cc *Config) DumpYAML() ([]byte, error) {
+	groupName, groupPassword := cc.groupConfig.Name(), cc.groupConfig.Password()
+	smartRouting, redoOperation := cc.networkConfig.IsSmartRouting(), cc.networkConfig.IsRedoOperation()
+	connectionTimeout := cc.networkConfig.ConnectionTimeout().String()
+	connectionAttemptLimit := cc.networkConfig.ConnectionAttemptLimit()
+	connectionAttemptPeriod := cc.networkConfig.ConnectionAttemptPeriod().String()
+	byteOrder := littleEndian
+	if cc.serializationConfig.IsBigEndian() {
+		byteOrder = bigEndian
+	}
+	portableVersion := cc.serializationConfig.PortableVersion()
+	fc := &fileConfig{
+		Group: &fileGroupConfig{Name: &groupName, Password: &groupPassword},
+		Network: &fileNetworkConfig{
+			ClusterMembers:          cc.networkConfig.Addresses(),
+			SmartRouting:            &smartRouting,
+			RedoOperation:           &redoOperation,
+			ConnectionTimeout:       &connectionTimeout,
+			ConnectionAttemptLimit:  &connectionAttemptLimit,
+			ConnectionAttemptPeriod: &connectionAttemptPeriod,
+		},
+		Serialization: &fileSerializationConfig{
+			ByteOrder:       &byteOrder,
+			PortableVersion: &portableVersion,
+		},
+	}
+	for _, classDefinition := range cc.serializationConfig.ClassDefinitions() {
+		fc.Serialization.ClassDefinitions = append(fc.Serialization.ClassDefinitions,
+			newFileClassDefinition(classDefinition))
+	}
+	if len(cc.flakeIDGeneratorConfigMap) > 0 {
+		fc.FlakeIDGenerators = make(map[string]*fileFlakeIDGeneratorConfig, len(cc.flakeIDGeneratorConfigMap))
+		for name, flakeIDGeneratorConfig := range cc.flakeIDGeneratorConfigMap {
+			prefetchCount := flakeIDGeneratorConfig.PrefetchCount()
+			prefetchValidityMillis := flakeIDGeneratorConfig.PrefetchValidityMillis()
+			fc.FlakeIDGenerators[name] = &fileFlakeIDGeneratorConfig{
+				PrefetchCount:          &prefetchCount,
+				PrefetchValidityMillis: &prefetchValidityMillis,
+			}
+		}
+	}
+	if len(cc.properties) > 0 {
+		fc.Properties = make(map[string]interface{}, len(cc.properties))
+		for name, value := range cc.properties {
+			fc.Properties[name] = value
+		}
+	}
+	return yaml.Marshal(&fileConfigRoot{Client: fc})
+}
+
+func newFileClassDefinition(classDefinition serialization.ClassDefinition) *fileClassDefinition {
+	fcd := &fileClassDefinition{
+		FactoryID: classDefinition.FactoryID(),
+		ClassID:   classDefinition.ClassID(),
+		Version:   classDefinition.Version(),
+	}
+	ordered, ok := classDefinition.(interface {
+		FieldDefinitions() []serialization.FieldDefinition
+	})
+	if !ok {
+		return fcd
+	}
+	for _, field := range ordered.FieldDefinitions() {
+		for typeName, fieldType := range fieldTypes {
+			if fieldType == field.Type() {
+				fcd.Fields = append(fcd.Fields, &fileFieldDefinition{
+					Name:      field.Name(),
+					Type:      typeName,
+					FactoryID: field.FactoryID(),
+					ClassID:   field.ClassID(),
+				})
+				break
+			}
+		}
+	}
+	return fcd
+}
@@ -0,0 +1,334 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/serialization/classdef"
+)
+
+const testYAMLConfig = `
+hazelcast-client:
+  group:
+    name: test-group
+    password: ${HZ_TEST_GROUP_PASSWORD}
+  network:
+    cluster-members:
+      - 127.0.0.1:5701
+      - 127.0.0.1:5702
+    smart-routing: false
+    redo-operation: true
+    connection-timeout: 10s
+    connection-attempt-limit: 5
+    connection-attempt-period: 500ms
+  serialization:
+    byte-order: LITTLE_ENDIAN
+    portable-version: 2
+    class-definitions:
+      - factory-id: 1
+        class-id: 1
+        version: 2
+        fields:
+          - name: age
+            type: int32
+          - name: address
+            type: portable
+            factory-id: 1
+            class-id: 2
+  flake-id-generator:
+    idGenerator:
+      prefetch-count: 50
+      prefetch-validity-millis: 1000
+  properties:
+    hazelcast.client.heartbeat.timeout: 60000
+    hazelcast.logging.level: debug
+`
+
+const testJSONConfig = `{
+	"hazelcast-client": {
+		"group": {"name": "test-group", "password": "${HZ_TEST_GROUP_PASSWORD}"},
+		"network": {
+			"cluster-members": ["127.0.0.1:5701", "127.0.0.1:5702"],
+			"smart-routing": false,
+			"redo-operation": true,
+			"connection-timeout": "10s",
+			"connection-attempt-limit": 5,
+			"connection-attempt-period": "500ms"
+		},
+		"serialization": {
+			"byte-order": "LITTLE_ENDIAN",
+			"portable-version": 2,
+			"class-definitions": [{
+				"factory-id": 1, "class-id": 1, "version": 2,
+				"fields": [
+					{"name": "age", "type": "int32"},
+					{"name": "address", "type": "portable", "factory-id": 1, "class-id": 2}
+				]
+			}]
+		},
+		"flake-id-generator": {
+			"idGenerator": {"prefetch-count": 50, "prefetch-validity-millis": 1000}
+		},
+		"properties": {
+			"hazelcast.client.heartbeat.timeout": 60000,
+			"hazelcast.logging.level": "debug"
+		}
+	}
+}`
+
+func setTestGroupPassword() {
+	os.Setenv("HZ_TEST_GROUP_PASSWORD", "secret")
+}
+
+func assertTestConfig(t *testing.T, config *Config) {
+	if config.GroupConfig().Name() != "test-group" || config.GroupConfig().Password() != "secret" {
+		t.Errorf("unexpected group config: %s %s", config.GroupConfig().Name(), config.GroupConfig().Password())
+	}
+	networkConfig := config.NetworkConfig()
+	if !reflect.DeepEqual(networkConfig.Addresses(), []string{"127.0.0.1:5701", "127.0.0.1:5702"}) {
+		t.Errorf("unexpected addresses: %v", networkConfig.Addresses())
+	}
+	if networkConfig.IsSmartRouting() || !networkConfig.IsRedoOperation() {
+		t.Error("unexpected smart routing or redo operation")
+	}
+	if networkConfig.ConnectionTimeout() != 10*time.Second ||
+		networkConfig.ConnectionAttemptPeriod() != 500*time.Millisecond ||
+		networkConfig.ConnectionAttemptLimit() != 5 {
+		t.Error("unexpected connection timeout, attempt period or attempt limit")
+	}
+	serializationConfig := config.SerializationConfig()
+	if serializationConfig.IsBigEndian() || serializationConfig.PortableVersion() != 2 {
+		t.Error("unexpected byte order or portable version")
+	}
+	if len(serializationConfig.ClassDefinitions()) != 1 {
+		t.Fatalf("expected 1 class definition, found %d", len(serializationConfig.ClassDefinitions()))
+	}
+	classDefinition := serializationConfig.ClassDefinitions()[0]
+	if classDefinition.FactoryID() != 1 || classDefinition.ClassID() != 1 || classDefinition.Version() != 2 ||
+		classDefinition.FieldCount() != 2 {
+		t.Error("unexpected class definition")
+	}
+	address := classDefinition.Field("address")
+	if address == nil || address.Type() != classdef.TypePortable || address.Index() != 1 || address.ClassID() != 2 {
+		t.Error("unexpected portable field definition")
+	}
+	idGeneratorConfig := config.GetFlakeIDGeneratorConfig("idGenerator")
+	if idGeneratorConfig.PrefetchCount() != 50 || idGeneratorConfig.PrefetchValidityMillis() != 1000 {
+		t.Error("unexpected flake id generator config")
+	}
+	if config.Properties()["hazelcast.client.heartbeat.timeout"] != "60000" ||
+		config.Properties()["hazelcast.logging.level"] != "debug" {
+		t.Errorf("unexpected properties: %v", config.Properties())
+	}
+}
+
+func TestLoadFromReaderYAML(t *testing.T) {
+	setTestGroupPassword()
+	config, err := LoadFromReader(strings.NewReader(testYAMLConfig), YAMLFormat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertTestConfig(t, config)
+}
+
+func TestLoadFromReaderJSON(t *testing.T) {
+	setTestGroupPassword()
+	config, err := LoadFromReader(strings.NewReader(testJSONConfig), JSONFormat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertTestConfig(t, config)
+}
+
+func TestLoadFromFile(t *testing.T) {
+	setTestGroupPassword()
+	dir, err := ioutil.TempDir("", "hazelcast-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for name, content := range map[string]string{"client.yml": testYAMLConfig, "client.json": testJSONConfig} {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		config, err := LoadFromFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertTestConfig(t, config)
+	}
+	if _, err := LoadFromFile(filepath.Join(dir, "client.xml")); err == nil {
+		t.Error("LoadFromFile should return an error for an unknown extension")
+	}
+}
+
+func TestLoadFromReaderDefaults(t *testing.T) {
+	config, err := LoadFromReader(strings.NewReader("hazelcast-client:\n  group:\n    name: test-group\n"), YAMLFormat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defaultConfig := New()
+	if config.GroupConfig().Name() != "test-group" ||
+		config.GroupConfig().Password() != defaultConfig.GroupConfig().Password() ||
+		config.NetworkConfig().ConnectionTimeout() != defaultConfig.NetworkConfig().ConnectionTimeout() ||
+		!config.NetworkConfig().IsSmartRouting() || !config.SerializationConfig().IsBigEndian() {
+		t.Error("the values that are not set should have their defaults")
+	}
+}
+
+func TestLoadFromReaderInvalidConfigs(t *testing.T) {
+	invalidConfigs := map[string]string{
+		"unknown key":          "hazelcast-client:\n  group:\n    nam: dev\n",
+		"unknown section":      "hazelcast-client:\n  cache: {}\n",
+		"missing root":         "group:\n  name: dev\n",
+		"invalid duration":     "hazelcast-client:\n  network:\n    connection-timeout: 5\n",
+		"invalid byte order":   "hazelcast-client:\n  serialization:\n    byte-order: MIDDLE_ENDIAN\n",
+		"unknown field type":   "hazelcast-client:\n  serialization:\n    class-definitions:\n      - factory-id: 1\n        class-id: 1\n        version: 0\n        fields:\n          - name: a\n            type: int8\n",
+		"portable without id":  "hazelcast-client:\n  serialization:\n    class-definitions:\n      - factory-id: 1\n        class-id: 1\n        version: 0\n        fields:\n          - name: a\n            type: portable\n",
+		"invalid prefetch":     "hazelcast-client:\n  flake-id-generator:\n    gen:\n      prefetch-count: -1\n",
+		"undefined env":        "hazelcast-client:\n  group:\n    name: ${HZ_TEST_UNDEFINED_VARIABLE}\n",
+		"invalid yaml content": "hazelcast-client: [",
+	}
+	for name, content := range invalidConfigs {
+		_, err := LoadFromReader(strings.NewReader(content), YAMLFormat)
+		if _, ok := err.(*core.HazelcastIllegalArgumentError); !ok {
+			t.Errorf("%s: expected HazelcastIllegalArgumentError, got %v", name, err)
+		}
+	}
+	_, err := LoadFromReader(strings.NewReader(`{"hazelcast-client": {"group": {"nam": "dev"}}}`), JSONFormat)
+	if _, ok := err.(*core.HazelcastIllegalArgumentError); !ok {
+		t.Errorf("unknown key in JSON: expected HazelcastIllegalArgumentError, got %v", err)
+	}
+}
+
+func TestLoadFromReaderEnvVariablesWithSpecialCharacters(t *testing.T) {
+	password := "p\"a\\s: s#w\nord"
+	os.Setenv("HZ_TEST_SPECIAL_PASSWORD", password)
+	os.Setenv("HZ_TEST_SPECIAL_HOST", "127.0.0.1")
+	defer os.Unsetenv("HZ_TEST_SPECIAL_PASSWORD")
+	defer os.Unsetenv("HZ_TEST_SPECIAL_HOST")
+	contents := map[FileFormat]string{
+		YAMLFormat: "hazelcast-client:\n" +
+			"  # the ${HZ_TEST_UNDEFINED_VARIABLE} in a comment is not substituted\n" +
+			"  group:\n" +
+			"    password: ${HZ_TEST_SPECIAL_PASSWORD}\n" +
+			"  network:\n" +
+			"    cluster-members:\n" +
+			"      - ${HZ_TEST_SPECIAL_HOST}:5701\n" +
+			"  properties:\n" +
+			"    hazelcast.client.custom: \"prefix-${HZ_TEST_SPECIAL_PASSWORD}\"\n",
+		JSONFormat: `{"hazelcast-client": {
+			"group": {"password": "${HZ_TEST_SPECIAL_PASSWORD}"},
+			"network": {"cluster-members": ["${HZ_TEST_SPECIAL_HOST}:5701"]},
+			"properties": {"hazelcast.client.custom": "prefix-${HZ_TEST_SPECIAL_PASSWORD}"}
+		}}`,
+	}
+	for format, content := range contents {
+		config, err := LoadFromReader(strings.NewReader(content), format)
+		if err != nil {
+			t.Fatalf("format %d: %v", format, err)
+		}
+		if config.GroupConfig().Password() != password {
+			t.Errorf("format %d: expected password %q, got %q", format, password, config.GroupConfig().Password())
+		}
+		if !reflect.DeepEqual(config.NetworkConfig().Addresses(), []string{"127.0.0.1:5701"}) {
+			t.Errorf("format %d: unexpected addresses: %v", format, config.NetworkConfig().Addresses())
+		}
+		if config.Properties()["hazelcast.client.custom"] != "prefix-"+password {
+			t.Errorf("format %d: unexpected properties: %v", format, config.Properties())
+		}
+		if _, found := config.Properties()["group"]; found {
+			t.Errorf("format %d: the env variable injected a key", format)
+		}
+	}
+}
+
+func TestLoadFromReaderEnvVariablesInBoolAndIntValues(t *testing.T) {
+	os.Setenv("HZ_TEST_SMART_ROUTING", "false")
+	os.Setenv("HZ_TEST_ATTEMPT_LIMIT", "7")
+	defer os.Unsetenv("HZ_TEST_SMART_ROUTING")
+	defer os.Unsetenv("HZ_TEST_ATTEMPT_LIMIT")
+	contents := map[FileFormat]string{
+		YAMLFormat: "hazelcast-client:\n" +
+			"  network:\n" +
+			"    smart-routing: ${HZ_TEST_SMART_ROUTING}\n" +
+			"    connection-attempt-limit: ${HZ_TEST_ATTEMPT_LIMIT}\n" +
+			"  properties:\n" +
+			"    hazelcast.client.custom: ${HZ_TEST_ATTEMPT_LIMIT}\n",
+		JSONFormat: `{"hazelcast-client": {
+			"network": {"smart-routing": "${HZ_TEST_SMART_ROUTING}", "connection-attempt-limit": "${HZ_TEST_ATTEMPT_LIMIT}"},
+			"properties": {"hazelcast.client.custom": "${HZ_TEST_ATTEMPT_LIMIT}"}
+		}}`,
+	}
+	for format, content := range contents {
+		config, err := LoadFromReader(strings.NewReader(content), format)
+		if err != nil {
+			t.Fatalf("format %d: %v", format, err)
+		}
+		if config.NetworkConfig().IsSmartRouting() {
+			t.Errorf("format %d: expected smart routing to be disabled", format)
+		}
+		if limit := config.NetworkConfig().ConnectionAttemptLimit(); limit != 7 {
+			t.Errorf("format %d: expected connection attempt limit 7, got %d", format, limit)
+		}
+		if config.Properties()["hazelcast.client.custom"] != "7" {
+			t.Errorf("format %d: unexpected properties: %v", format, config.Properties())
+		}
+	}
+}
+
+func TestLoadFromReaderEnvVariablesWithInvalidValues(t *testing.T) {
+	os.Setenv("HZ_TEST_INVALID_VALUE", "yes please")
+	defer os.Unsetenv("HZ_TEST_INVALID_VALUE")
+	invalidConfigs := []string{
+		"hazelcast-client:\n  network:\n    smart-routing: ${HZ_TEST_INVALID_VALUE}\n",
+		"hazelcast-client:\n  network:\n    connection-attempt-limit: ${HZ_TEST_INVALID_VALUE}\n",
+	}
+	for _, content := range invalidConfigs {
+		_, err := LoadFromReader(strings.NewReader(content), YAMLFormat)
+		if _, ok := err.(*core.HazelcastIllegalArgumentError); !ok {
+			t.Errorf("expected HazelcastIllegalArgumentError, got %v", err)
+		}
+	}
+}
+
+func TestDumpYAML(t *testing.T) {
+	setTestGroupPassword()
+	config, err := LoadFromReader(strings.NewReader(testYAMLConfig), YAMLFormat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dump, err := config.DumpYAML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(dump), "hazelcast-client:\n") {
+		t.Fatalf("unexpected dump:\n%s", dump)
+	}
+	reloaded, err := LoadFromReader(strings.NewReader(string(dump)), YAMLFormat)
+	if err != nil {
+		t.Fatalf("dumped configuration cannot be loaded: %v\n%s", err, dump)
+	}
+	assertTestConfig(t, reloaded)
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import "time"
+
+// InMemoryFormat is the format that the Near Cache stores its values in.
+type InMemoryFormat int
+
+const (
+	// InMemoryFormatBinary stores the values in serialized form.
+	// Every read deserializes the value, so the returned values are never shared.
+	InMemoryFormatBinary InMemoryFormat = iota
+
+	// InMemoryFormatObject stores the values in deserialized form.
+	// Reads are faster, but the same value instance is returned to every caller.
+	InMemoryFormatObject
+)
+
+// EvictionPolicy is the policy that is used to select the entry to evict when a Near Cache is full.
+type EvictionPolicy int
+
+const (
+	// EvictionPolicyLRU evicts the least recently used entry.
+	EvictionPolicyLRU EvictionPolicy = iota
+
+	// EvictionPolicyLFU evicts the least frequently used entry.
+	EvictionPolicyLFU
+
+	// EvictionPolicyRandom evicts a random entry.
+	EvictionPolicyRandom
+
+	// EvictionPolicyNone does not evict any entry. New entries are not cached when the Near Cache is full.
+	EvictionPolicyNone
+)
+
+const (
+	// DefaultNearCacheMaxEntryCount is the default value for MaxEntryCount().
+	DefaultNearCacheMaxEntryCount = 10000
+
+	// DefaultNearCacheInMemoryFormat is the default value for InMemoryFormat().
+	DefaultNearCacheInMemoryFormat = InMemoryFormatBinary
+
+	// DefaultNearCacheEvictionPolicy is the default value for EvictionPolicy().
+	DefaultNearCacheEvictionPolicy = EvictionPolicyLRU
+)
+
+// NearCacheConfig contains the configuration for the Near Cache of a map.
+// A Near Cache keeps the values of the recently read keys on the client side, so that the reads of the
+// same keys do not go over the network.
+type NearCacheConfig struct {
+	// name is the name of the map that this configuration is used for.
+	name string
+
+	// inMemoryFormat is the format that the values are stored in.
+	inMemoryFormat InMemoryFormat
+
+	// maxEntryCount is the maximum number of entries in the Near Cache.
+	maxEntryCount int32
+
+	// evictionPolicy is the policy used to select the entry to evict when the Near Cache is full.
+	evictionPolicy EvictionPolicy
+
+	// timeToLive is the maximum duration that an entry stays in the Near Cache. Zero means infinite.
+	timeToLive time.Duration
+
+	// maxIdle is the maximum duration that an entry stays in the Near Cache without being read. Zero means infinite.
+	maxIdle time.Duration
+
+	// invalidateOnChange determines if the entries are invalidated when they are changed in the cluster.
+	invalidateOnChange bool
+}
+
+// NewNearCacheConfig returns a new NearCacheConfig for the map with the given name and default parameters.
+func NewNearCacheConfig(name string) *NearCacheConfig {
+	return &NearCacheConfig{
+		name:               name,
+		inMemoryFormat:     DefaultNearCacheInMemoryFormat,
+		maxEntryCount:      DefaultNearCacheMaxEntryCount,
+		evictionPolicy:     DefaultNearCacheEvictionPolicy,
+		invalidateOnChange: true,
+	}
+}
+
+// Name returns the name of the map that this configuration is used for.
+func (ncc *NearCacheConfig) Name() string {
+	return ncc.name
+}
+
+// SetName sets the name of the map that this configuration is used for.
+func (ncc *NearCacheConfig) SetName(name string) {
+	ncc.name = name
+}
+
+// InMemoryFormat returns the format that the values are stored in.
+func (ncc *NearCacheConfig) InMemoryFormat() InMemoryFormat {
+	return ncc.inMemoryFormat
+}
+
+// SetInMemoryFormat sets the format that the values are stored in.
+// Default value is InMemoryFormatBinary.
+func (ncc *NearCacheConfig) SetInMemoryFormat(inMemoryFormat InMemoryFormat) {
+	ncc.inMemoryFormat = inMemoryFormat
+}
+
+// MaxEntryCount returns the maximum number of entries in the Near Cache.
+func (ncc *NearCacheConfig) MaxEntryCount() int32 {
+	return ncc.maxEntryCount
+}
+
+// SetMaxEntryCount sets the maximum number of entries in the Near Cache.
+// It should be positive, otherwise it will panic.
+func (ncc *NearCacheConfig) SetMaxEntryCount(maxEntryCount int32) {
+	if maxEntryCount <= 0 {
+		panic("maxEntryCount should be positive")
+	}
+	ncc.maxEntryCount = maxEntryCount
+}
+
+// EvictionPolicy returns the policy used to select the entry to evict when the Near Cache is full.
+func (ncc *NearCacheConfig) EvictionPolicy() EvictionPolicy {
+	return ncc.evictionPolicy
+}
+
+// SetEvictionPolicy sets the policy used to select the entry to evict when the Near Cache is full.
+// Default value is EvictionPolicyLRU.
+func (ncc *NearCacheConfig) SetEvictionPolicy(evictionPolicy EvictionPolicy) {
+	ncc.evictionPolicy = evictionPolicy
+}
+
+// TimeToLive returns the maximum duration that an entry stays in the Near Cache.
+func (ncc *NearCacheConfig) TimeToLive() time.Duration {
+	return ncc.timeToLive
+}
+
+// SetTimeToLive sets the maximum duration that an entry stays in the Near Cache.
+// Zero means infinite, which is the default value.
+func (ncc *NearCacheConfig) SetTimeToLive(timeToLive time.Duration) {
+	if timeToLive < 0 {
+		panic("timeToLive should not be negative")
+	}
+	ncc.timeToLive = timeToLive
+}
+
+// MaxIdle returns the maximum duration that an entry stays in the Near Cache without being read.
+func (ncc *NearCacheConfig) MaxIdle() time.Duration {
+	return ncc.maxIdle
+}
+
+// SetMaxIdle sets the maximum duration that an entry stays in the Near Cache without being read.
+// Zero means infinite, which is the default value.
+func (ncc *NearCacheConfig) SetMaxIdle(maxIdle time.Duration) {
+	if maxIdle < 0 {
+		panic("maxIdle should not be negative")
+	}
+	ncc.maxIdle = maxIdle
+}
+
+// InvalidateOnChange returns true if the entries are invalidated when they are changed in the cluster.
+func (ncc *NearCacheConfig) InvalidateOnChange() bool {
+	return ncc.invalidateOnChange
+}
+
+// SetInvalidateOnChange sets invalidateOnChange.
+// If true, the client listens for the changes on the map and invalidates the changed entries.
+// Default value is true.
+func (ncc *NearCacheConfig) SetInvalidateOnChange(invalidateOnChange bool) {
+	ncc.invalidateOnChange = invalidateOnChange
+}
@@ -42,4 +42,36 @@ var (
 	// InvocationRetryPause time is the pause time between each retry cycle of an invocation in milliseconds.
 	InvocationRetryPause = NewHazelcastPropertyInt64WithTimeUnit("hazelcast.client.invocation.retry.pause.millis",
 		1000, time.Millisecond)
+
+	// LoggingLevel is the level of the default logger of the client.
+	// It should be one of off, error, warn, info, debug or trace.
+	// It is not used if a logger is set on the config.
+	LoggingLevel = NewHazelcastPropertyString("hazelcast.logging.level", "info")
+
+	// StatisticsEnabled enables sending the statistics of the client to the cluster periodically,
+	// so that they can be viewed in the Management Center.
+	StatisticsEnabled = NewHazelcastPropertyBool("hazelcast.client.statistics.enabled", false)
+
+	// StatisticsPeriodSeconds is the period of sending the statistics of the client to the cluster.
+	StatisticsPeriodSeconds = NewHazelcastPropertyInt64WithTimeUnit("hazelcast.client.statistics.period.seconds",
+		3, time.Second)
+
+	// EventThreadCount is the number of goroutines that run the event listeners.
+	// The events of the same partition are always handled by the same goroutine, so that they are
+	// received in order.
+	EventThreadCount = NewHazelcastPropertyInt("hazelcast.client.event.thread.count", 5)
+
+	// EventQueueCapacity is the maximum number of events that are waiting for the event listeners.
+	// The capacity is shared equally among the event goroutines.
+	EventQueueCapacity = NewHazelcastPropertyInt("hazelcast.client.event.queue.capacity", 100000)
+
+	// EventQueueOverflowPolicy is what happens when an event arrives while the queue of its goroutine is full.
+	// It should be one of:
+	//  * block: the goroutine which reads the responses waits until there is room in the queue
+	//  * drop_oldest: the oldest event in the queue is dropped
+	//  * error: the new event is dropped and an error is logged, at most once in 10 seconds
+	// The number of dropped events is reported in the statistics of the client.
+	// The policy applies only to the user listeners, the events of the client's own listeners,
+	// e.g. the membership and the Near Cache invalidation listeners, are never dropped.
+	EventQueueOverflowPolicy = NewHazelcastPropertyString("hazelcast.client.event.queue.overflow.policy", "error")
 )
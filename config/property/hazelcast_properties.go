@@ -32,6 +32,7 @@ import (
 // A Hazelcast property can be set via:
 //  * an environmental variable
 //  * programmatic configuration Config.SetProperty
+//  * declarative configuration config.LoadFromFile
 type HazelcastProperties struct {
 	properties config.Properties
 }
@@ -96,3 +97,17 @@ func (hp *HazelcastProperties) GetPositiveDuration(property *HazelcastProperty)
 	}
 	return duration
 }
+
+// GetPositiveInt returns the int value of the given property.
+// It returns the default value if the set value cannot be parsed or is not positive.
+// It panics if the default value cannot be parsed to int.
+func (hp *HazelcastProperties) GetPositiveInt(property *HazelcastProperty) int {
+	if value, err := strconv.Atoi(hp.GetString(property)); err == nil && value > 0 {
+		return value
+	}
+	value, err := strconv.Atoi(property.DefaultValue())
+	if err != nil {
+		panic(fmt.Sprintf("%s cannot be parsed to int", property.DefaultValue()))
+	}
+	return value
+}
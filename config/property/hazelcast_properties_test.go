@@ -160,3 +160,25 @@ func TestHazelcastProperties_GetPositiveDurationFromEnv(t *testing.T) {
 	}
 
 }
+
+func TestHazelcastProperties_GetPositiveInt(t *testing.T) {
+	name := "testInt"
+	property := NewHazelcastPropertyInt(name, 5)
+	cfg := config.New()
+	properties := NewHazelcastProperties(cfg.Properties())
+	if value := properties.GetPositiveInt(property); value != 5 {
+		t.Errorf("expected 5 got %d", value)
+	}
+	cfg.SetProperty(name, "8")
+	if value := properties.GetPositiveInt(property); value != 8 {
+		t.Errorf("expected 8 got %d", value)
+	}
+	cfg.SetProperty(name, "0")
+	if value := properties.GetPositiveInt(property); value != 5 {
+		t.Errorf("expected the default value 5 for a non-positive value, got %d", value)
+	}
+	cfg.SetProperty(name, "abc")
+	if value := properties.GetPositiveInt(property); value != 5 {
+		t.Errorf("expected the default value 5 for an invalid value, got %d", value)
+	}
+}
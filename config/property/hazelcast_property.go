@@ -46,6 +46,14 @@ func NewHazelcastPropertyInt64WithTimeUnit(name string, defaultValue int64, time
 	}
 }
 
+// NewHazelcastPropertyInt returns a Hazelcast property with the given defaultValue.
+func NewHazelcastPropertyInt(name string, defaultValue int) *HazelcastProperty {
+	return &HazelcastProperty{
+		name:         name,
+		defaultValue: strconv.Itoa(defaultValue),
+	}
+}
+
 // NewHazelcastPropertyString returns a Hazelcast property with the given defaultValue.
 func NewHazelcastPropertyString(name string, defaultValue string) *HazelcastProperty {
 	return &HazelcastProperty{
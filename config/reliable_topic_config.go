@@ -0,0 +1,99 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+// TopicOverloadPolicy is the policy that is applied when a message is published to a reliable topic
+// whose ringbuffer is full, i.e. the oldest message in the ringbuffer has not expired yet.
+type TopicOverloadPolicy int
+
+const (
+	// TopicOverloadPolicyBlock blocks the publisher until the oldest message expires and the message can be added.
+	TopicOverloadPolicyBlock TopicOverloadPolicy = iota
+
+	// TopicOverloadPolicyError returns a core.HazelcastTopicOverloadError to the publisher.
+	TopicOverloadPolicyError
+
+	// TopicOverloadPolicyDiscardOldest overwrites the oldest message, even if it has not expired yet.
+	TopicOverloadPolicyDiscardOldest
+
+	// TopicOverloadPolicyDiscardNewest drops the published message silently.
+	TopicOverloadPolicyDiscardNewest
+)
+
+const (
+	// DefaultReliableTopicReadBatchSize is the default value for ReadBatchSize().
+	DefaultReliableTopicReadBatchSize = 10
+
+	// DefaultTopicOverloadPolicy is the default value for TopicOverloadPolicy().
+	DefaultTopicOverloadPolicy = TopicOverloadPolicyBlock
+)
+
+// ReliableTopicConfig contains the configuration for a reliable topic.
+type ReliableTopicConfig struct {
+	// name is the name of the reliable topic that this configuration is used for.
+	name string
+
+	// readBatchSize is the maximum number of messages that a listener reads from the ringbuffer at once.
+	readBatchSize int32
+
+	// topicOverloadPolicy is the policy that is applied when the ringbuffer of the topic is full.
+	topicOverloadPolicy TopicOverloadPolicy
+}
+
+// NewReliableTopicConfig returns a new ReliableTopicConfig for the reliable topic with the given name
+// and default parameters.
+func NewReliableTopicConfig(name string) *ReliableTopicConfig {
+	return &ReliableTopicConfig{
+		name:                name,
+		readBatchSize:       DefaultReliableTopicReadBatchSize,
+		topicOverloadPolicy: DefaultTopicOverloadPolicy,
+	}
+}
+
+// Name returns the name of the reliable topic that this configuration is used for.
+func (rtc *ReliableTopicConfig) Name() string {
+	return rtc.name
+}
+
+// SetName sets the name of the reliable topic that this configuration is used for.
+func (rtc *ReliableTopicConfig) SetName(name string) {
+	rtc.name = name
+}
+
+// ReadBatchSize returns the maximum number of messages that a listener reads from the ringbuffer at once.
+func (rtc *ReliableTopicConfig) ReadBatchSize() int32 {
+	return rtc.readBatchSize
+}
+
+// SetReadBatchSize sets the maximum number of messages that a listener reads from the ringbuffer at once.
+// The read batch size should be positive, otherwise it will panic.
+// Default value is DefaultReliableTopicReadBatchSize.
+func (rtc *ReliableTopicConfig) SetReadBatchSize(readBatchSize int32) {
+	if readBatchSize <= 0 {
+		panic("readBatchSize should be positive")
+	}
+	rtc.readBatchSize = readBatchSize
+}
+
+// TopicOverloadPolicy returns the policy that is applied when the ringbuffer of the topic is full.
+func (rtc *ReliableTopicConfig) TopicOverloadPolicy() TopicOverloadPolicy {
+	return rtc.topicOverloadPolicy
+}
+
+// SetTopicOverloadPolicy sets the policy that is applied when the ringbuffer of the topic is full.
+// Default value is TopicOverloadPolicyBlock.
+func (rtc *ReliableTopicConfig) SetTopicOverloadPolicy(topicOverloadPolicy TopicOverloadPolicy) {
+	rtc.topicOverloadPolicy = topicOverloadPolicy
+}
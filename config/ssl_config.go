@@ -0,0 +1,164 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License")
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+)
+
+// SSLConfig contains the TLS configuration of the client.
+// When it is enabled, every connection to the members of the cluster is encrypted.
+// If client certificates are added, the client authenticates itself to the members as well (mutual TLS).
+type SSLConfig struct {
+	// enabled determines if TLS is used for the connections.
+	enabled bool
+
+	// caPool is the set of root certificate authorities that the client uses to verify member certificates.
+	// If it is nil, the host's root CA set is used.
+	caPool *x509.CertPool
+
+	// certificates are the client certificates presented to the members.
+	certificates []tls.Certificate
+
+	// serverName is used to verify the hostname on the certificates returned by the members.
+	// If it is empty, the host of the member address is used.
+	serverName string
+
+	// minVersion is the minimum TLS version that is acceptable.
+	minVersion uint16
+
+	// cipherSuites is the list of supported cipher suites. If it is nil, a default list is used.
+	cipherSuites []uint16
+}
+
+// NewSSLConfig returns a new SSLConfig with default configuration.
+// TLS is disabled by default.
+func NewSSLConfig() *SSLConfig {
+	return &SSLConfig{
+		minVersion: tls.VersionTLS12,
+	}
+}
+
+// Enabled returns true if TLS is enabled.
+func (sc *SSLConfig) Enabled() bool {
+	return sc.enabled
+}
+
+// SetEnabled enables or disables TLS.
+func (sc *SSLConfig) SetEnabled(enabled bool) {
+	sc.enabled = enabled
+}
+
+// CAPool returns the set of root certificate authorities used to verify member certificates.
+func (sc *SSLConfig) CAPool() *x509.CertPool {
+	return sc.caPool
+}
+
+// SetCAPool sets the set of root certificate authorities used to verify member certificates.
+func (sc *SSLConfig) SetCAPool(caPool *x509.CertPool) {
+	sc.caPool = caPool
+}
+
+// SetCAPath reads the PEM encoded certificates in the given file and adds them to the CA pool.
+// SetCAPath returns a HazelcastIOError if the file cannot be read, and a HazelcastIllegalArgumentError
+// if it does not contain any certificate.
+func (sc *SSLConfig) SetCAPath(path string) error {
+	pem, err := ioutil.ReadFile(path)
+	if err != nil {
+		return core.NewHazelcastIOError("error while reading the CA file "+path, err)
+	}
+	if sc.caPool == nil {
+		sc.caPool = x509.NewCertPool()
+	}
+	if !sc.caPool.AppendCertsFromPEM(pem) {
+		return core.NewHazelcastIllegalArgumentError("no certificate could be parsed from the CA file "+path, nil)
+	}
+	return nil
+}
+
+// Certificates returns the client certificates presented to the members.
+func (sc *SSLConfig) Certificates() []tls.Certificate {
+	return sc.certificates
+}
+
+// AddCertificate adds a client certificate that is presented to the members for mutual authentication.
+func (sc *SSLConfig) AddCertificate(certificate tls.Certificate) {
+	sc.certificates = append(sc.certificates, certificate)
+}
+
+// AddClientCertAndKeyPath loads a PEM encoded client certificate and its private key from the given files
+// and adds it to the client certificates.
+// AddClientCertAndKeyPath returns a HazelcastIOError if the pair cannot be loaded.
+func (sc *SSLConfig) AddClientCertAndKeyPath(certPath string, keyPath string) error {
+	certificate, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return core.NewHazelcastIOError("error while loading the client certificate and key", err)
+	}
+	sc.certificates = append(sc.certificates, certificate)
+	return nil
+}
+
+// ServerName returns the name that is used to verify the hostname on member certificates.
+func (sc *SSLConfig) ServerName() string {
+	return sc.serverName
+}
+
+// SetServerName sets the name that is used to verify the hostname on member certificates.
+// If it is not set, the host of the member address is used.
+func (sc *SSLConfig) SetServerName(serverName string) {
+	sc.serverName = serverName
+}
+
+// MinVersion returns the minimum acceptable TLS version.
+func (sc *SSLConfig) MinVersion() uint16 {
+	return sc.minVersion
+}
+
+// SetMinVersion sets the minimum acceptable TLS version, such as tls.VersionTLS12.
+// Default value is tls.VersionTLS12.
+func (sc *SSLConfig) SetMinVersion(minVersion uint16) {
+	sc.minVersion = minVersion
+}
+
+// CipherSuites returns the list of supported cipher suites.
+func (sc *SSLConfig) CipherSuites() []uint16 {
+	return sc.cipherSuites
+}
+
+// SetCipherSuites sets the list of supported cipher suites, such as tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256.
+// If it is not set, the default list of crypto/tls is used.
+func (sc *SSLConfig) SetCipherSuites(cipherSuites ...uint16) {
+	sc.cipherSuites = cipherSuites
+}
+
+// TLSConfig returns a new tls.Config built from this configuration for a connection to the given host.
+// The host is used for hostname verification if no server name is set.
+func (sc *SSLConfig) TLSConfig(host string) *tls.Config {
+	serverName := sc.serverName
+	if serverName == "" {
+		serverName = host
+	}
+	return &tls.Config{
+		RootCAs:      sc.caPool,
+		Certificates: sc.certificates,
+		ServerName:   serverName,
+		MinVersion:   sc.minVersion,
+		CipherSuites: sc.cipherSuites,
+	}
+}
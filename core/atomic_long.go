@@ -0,0 +1,82 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import "context"
+
+// AtomicLong is a strongly consistent, distributed int64 counter.
+// Unlike PNCounter, every update is applied on the partition owner of the counter,
+// so all the callers observe the updates in the same order.
+//
+// The functions passed to Alter, AlterAndGet, GetAndAlter and Apply are executed on the cluster.
+// They should be IdentifiedDataSerializable structs that have a counterpart registered on the
+// server side implementing com.hazelcast.core.IFunction.
+type AtomicLong interface {
+	// DistributedObject is the base interface for all distributed objects.
+	DistributedObject
+
+	// Get returns the current value.
+	Get() (value int64, err error)
+
+	// Set sets the value.
+	Set(newValue int64) (err error)
+
+	// GetAndSet sets the value.
+	// It returns the previous value.
+	GetAndSet(newValue int64) (oldValue int64, err error)
+
+	// CompareAndSet sets the value to updated only if the current value is equal to expected.
+	// It returns true if the value was set.
+	CompareAndSet(expected int64, updated int64) (set bool, err error)
+
+	// AddAndGet adds delta to the current value.
+	// It returns the updated value.
+	AddAndGet(delta int64) (updatedValue int64, err error)
+
+	// GetAndAdd adds delta to the current value.
+	// It returns the previous value.
+	GetAndAdd(delta int64) (oldValue int64, err error)
+
+	// IncrementAndGet increments the current value by one.
+	// It returns the updated value.
+	IncrementAndGet() (updatedValue int64, err error)
+
+	// DecrementAndGet decrements the current value by one.
+	// It returns the updated value.
+	DecrementAndGet() (updatedValue int64, err error)
+
+	// GetAndIncrement increments the current value by one.
+	// It returns the previous value.
+	GetAndIncrement() (oldValue int64, err error)
+
+	// Alter replaces the value with the result of applying the function to it.
+	Alter(function interface{}) (err error)
+
+	// AlterAndGet replaces the value with the result of applying the function to it.
+	// It returns the updated value.
+	AlterAndGet(function interface{}) (updatedValue int64, err error)
+
+	// GetAndAlter replaces the value with the result of applying the function to it.
+	// It returns the previous value.
+	GetAndAlter(function interface{}) (oldValue int64, err error)
+
+	// Apply applies the function to the value without changing it.
+	// It returns the result of the function.
+	Apply(function interface{}) (result interface{}, err error)
+
+	// WithContext returns a view of this counter whose operations are bound to the given context,
+	// see Map.WithContext.
+	WithContext(ctx context.Context) AtomicLong
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import "context"
+
+// AtomicReference is a strongly consistent, distributed reference to an object.
+// The value may be nil.
+//
+// The values are compared in their serialized form, so CompareAndSet and Contains match
+// values that serialize to the same bytes.
+// The functions passed to Alter, AlterAndGet, GetAndAlter and Apply are executed on the cluster,
+// see AtomicLong.
+type AtomicReference interface {
+	// DistributedObject is the base interface for all distributed objects.
+	DistributedObject
+
+	// Get returns the current value, or nil if the value is nil.
+	Get() (value interface{}, err error)
+
+	// Set sets the value.
+	Set(newValue interface{}) (err error)
+
+	// GetAndSet sets the value.
+	// It returns the previous value.
+	GetAndSet(newValue interface{}) (oldValue interface{}, err error)
+
+	// SetAndGet sets the value.
+	// It returns the new value.
+	SetAndGet(newValue interface{}) (value interface{}, err error)
+
+	// CompareAndSet sets the value to updated only if the current value is equal to expected.
+	// It returns true if the value was set.
+	CompareAndSet(expected interface{}, updated interface{}) (set bool, err error)
+
+	// Contains returns true if the current value is equal to expected.
+	Contains(expected interface{}) (found bool, err error)
+
+	// IsNil returns true if the value is nil.
+	IsNil() (isNil bool, err error)
+
+	// Clear sets the value to nil.
+	Clear() (err error)
+
+	// Alter replaces the value with the result of applying the function to it.
+	Alter(function interface{}) (err error)
+
+	// AlterAndGet replaces the value with the result of applying the function to it.
+	// It returns the updated value.
+	AlterAndGet(function interface{}) (updatedValue interface{}, err error)
+
+	// GetAndAlter replaces the value with the result of applying the function to it.
+	// It returns the previous value.
+	GetAndAlter(function interface{}) (oldValue interface{}, err error)
+
+	// Apply applies the function to the value without changing it.
+	// It returns the result of the function.
+	Apply(function interface{}) (result interface{}, err error)
+
+	// WithContext returns a view of this reference whose operations are bound to the given context,
+	// see Map.WithContext.
+	WithContext(ctx context.Context) AtomicReference
+}
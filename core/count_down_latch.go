@@ -0,0 +1,51 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"time"
+)
+
+// CountDownLatch is a distributed synchronization aid that allows one or more callers to wait
+// until a set of operations being performed by other callers completes.
+//
+// The latch is initialized with a count by TrySetCount. Await blocks until the count reaches zero
+// by the calls of CountDown. Once the count has reached zero, it can be set again by TrySetCount.
+type CountDownLatch interface {
+	// DistributedObject is the base interface for all distributed objects.
+	DistributedObject
+
+	// TrySetCount sets the count to the given value if the current count is zero.
+	// It returns true if the count was set, false if the current count is not zero.
+	// It returns a HazelcastIllegalArgumentError if count is negative.
+	TrySetCount(count int32) (set bool, err error)
+
+	// CountDown decrements the count, releasing the waiting callers if the count reaches zero.
+	// If the count is already zero, nothing happens.
+	CountDown() (err error)
+
+	// GetCount returns the current count.
+	GetCount() (count int32, err error)
+
+	// Await waits until the count reaches zero or the timeout expires.
+	// It returns true if the count reached zero, false if the timeout expired.
+	// The invocation timeout is counted after the given timeout.
+	Await(timeout time.Duration) (completed bool, err error)
+
+	// WithContext returns a view of this latch whose operations are bound to the given context,
+	// see Map.WithContext.
+	WithContext(ctx context.Context) CountDownLatch
+}
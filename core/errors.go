@@ -112,12 +112,41 @@ type HazelcastUnsupportedOperationError struct {
 	*HazelcastErrorType
 }
 
+// HazelcastCancellationError is returned when an operation is cancelled before its result is available,
+// e.g. the context of the operation is cancelled.
+type HazelcastCancellationError struct {
+	*HazelcastErrorType
+}
+
 // HazelcastConsistencyLostError is an error that indicates that the consistency guarantees provided by
 // some service has been lost. The exact guarantees depend on the service.
 type HazelcastConsistencyLostError struct {
 	*HazelcastErrorType
 }
 
+// HazelcastTransactionError is returned when a transaction fails, e.g. it is used after it has been
+// committed or rolled back, or it has timed out.
+type HazelcastTransactionError struct {
+	*HazelcastErrorType
+}
+
+// HazelcastIllegalMonitorStateError is returned when a lock is released by an owner that does not hold it.
+type HazelcastIllegalMonitorStateError struct {
+	*HazelcastErrorType
+}
+
+// HazelcastStaleSequenceError is returned when a ringbuffer is read from a sequence that is smaller than
+// its head sequence, i.e. the item at the sequence has already been overwritten.
+type HazelcastStaleSequenceError struct {
+	*HazelcastErrorType
+}
+
+// HazelcastTopicOverloadError is returned when a message is published to a reliable topic whose ringbuffer
+// is full and the overload policy of the topic is config.TopicOverloadPolicyError.
+type HazelcastTopicOverloadError struct {
+	*HazelcastErrorType
+}
+
 // NewHazelcastNilPointerError returns a HazelcastNilPointerError.
 func NewHazelcastNilPointerError(message string, cause error) *HazelcastNilPointerError {
 	return &HazelcastNilPointerError{&HazelcastErrorType{message: message, cause: cause}}
@@ -197,3 +226,28 @@ func NewHazelcastUnsupportedOperationError(message string, cause error) *Hazelca
 func NewHazelcastConsistencyLostError(message string, cause error) *HazelcastConsistencyLostError {
 	return &HazelcastConsistencyLostError{&HazelcastErrorType{message: message, cause: cause}}
 }
+
+// NewHazelcastCancellationError returns a HazelcastCancellationError.
+func NewHazelcastCancellationError(message string, cause error) *HazelcastCancellationError {
+	return &HazelcastCancellationError{&HazelcastErrorType{message: message, cause: cause}}
+}
+
+// NewHazelcastTransactionError returns a HazelcastTransactionError.
+func NewHazelcastTransactionError(message string, cause error) *HazelcastTransactionError {
+	return &HazelcastTransactionError{&HazelcastErrorType{message: message, cause: cause}}
+}
+
+// NewHazelcastIllegalMonitorStateError returns a HazelcastIllegalMonitorStateError.
+func NewHazelcastIllegalMonitorStateError(message string, cause error) *HazelcastIllegalMonitorStateError {
+	return &HazelcastIllegalMonitorStateError{&HazelcastErrorType{message: message, cause: cause}}
+}
+
+// NewHazelcastStaleSequenceError returns a HazelcastStaleSequenceError.
+func NewHazelcastStaleSequenceError(message string, cause error) *HazelcastStaleSequenceError {
+	return &HazelcastStaleSequenceError{&HazelcastErrorType{message: message, cause: cause}}
+}
+
+// NewHazelcastTopicOverloadError returns a HazelcastTopicOverloadError.
+func NewHazelcastTopicOverloadError(message string, cause error) *HazelcastTopicOverloadError {
+	return &HazelcastTopicOverloadError{&HazelcastErrorType{message: message, cause: cause}}
+}
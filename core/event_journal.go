@@ -0,0 +1,86 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+// EventJournalMapEvent is a map event read from the event journal of a map.
+// The event journal keeps the recent events of each partition in a ringbuffer, so the events can be read again
+// from a sequence that is stored before.
+type EventJournalMapEvent interface {
+	// Key returns the key of the entry.
+	Key() interface{}
+
+	// NewValue returns the value of the entry after the event, or nil if the entry is removed.
+	NewValue() interface{}
+
+	// OldValue returns the value of the entry before the event, or nil if the entry is added.
+	OldValue() interface{}
+
+	// EventType returns the type of the event, it has the same values as EntryEvent.EventType.
+	EventType() int32
+
+	// PartitionID returns the ID of the partition whose event journal the event is read from.
+	PartitionID() int32
+
+	// Sequence returns the sequence of the event in the event journal of its partition.
+	Sequence() int64
+}
+
+// EventJournalState is the state of the event journal of a partition when it is subscribed.
+type EventJournalState struct {
+	// OldestSequence is the sequence of the oldest event in the event journal.
+	OldestSequence int64
+
+	// NewestSequence is the sequence of the newest event in the event journal.
+	// It is OldestSequence-1 if the event journal is empty.
+	NewestSequence int64
+}
+
+// EventJournalReadResultSet is the result of a read from the event journal of a partition.
+type EventJournalReadResultSet interface {
+	// ReadResultSet contains the events, or their projections if a projection is used.
+	// Get returns an EventJournalMapEvent for each event if no projection is used.
+	ReadResultSet
+
+	// NextSequence returns the sequence to continue to read from. It is greater than the
+	// sequence of the last item plus one if the last events are filtered out by the predicate.
+	NextSequence() int64
+}
+
+// EventJournalSubscription is a stream of the events in the event journals of all partitions of a map.
+// The events of a partition are received in the order of their sequences, there is no order between the events
+// of different partitions.
+type EventJournalSubscription interface {
+	// Events returns the channel that the events are received from.
+	// The channel is closed when the subscription is closed or it has failed, see Err.
+	Events() <-chan EventJournalMapEvent
+
+	// InitialStates returns the states of the event journals of the partitions when they were subscribed,
+	// mapped by partition ID.
+	InitialStates() map[int32]EventJournalState
+
+	// Offsets returns the sequences that the partitions are read from next, mapped by partition ID.
+	// An offset is advanced when an event of the partition is received from the channel, so
+	// the offsets can be stored and passed to Map.SubscribeToEventJournal to continue from the same events later.
+	Offsets() map[int32]int64
+
+	// Err returns the error that the subscription has failed with, e.g. a HazelcastStaleSequenceError
+	// if an offset is older than the oldest event of its partition. The reads interrupted by a lost connection
+	// are retried, so they do not fail the subscription.
+	// It returns nil if the subscription is active or it is closed by Close.
+	Err() error
+
+	// Close stops reading the event journals and closes the events channel.
+	Close()
+}
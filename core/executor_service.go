@@ -0,0 +1,67 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+// ExecutorService executes tasks on the members of the cluster.
+//
+// A task should be a serializable struct, typically IdentifiedDataSerializable, that has a
+// counterpart registered on the server side implementing java.util.concurrent.Callable or
+// java.lang.Runnable. The result of the task is returned by the future of the submission.
+//
+// The futures returned for invalid arguments, e.g. a nil task, are completed with the error.
+type ExecutorService interface {
+	// DistributedObject is the base interface for all distributed objects.
+	DistributedObject
+
+	// Submit submits the task to a randomly selected member.
+	Submit(task interface{}) CancellableFuture
+
+	// SubmitToMember submits the task to the given member.
+	SubmitToMember(task interface{}, member Member) CancellableFuture
+
+	// SubmitToKeyOwner submits the task to the owner of the given key.
+	SubmitToKeyOwner(task interface{}, key interface{}) CancellableFuture
+
+	// SubmitToMembers submits the task to the members selected by the given selector.
+	// It returns the futures of the task by member.
+	// It returns a HazelcastIllegalStateError if no member is selected.
+	SubmitToMembers(task interface{}, selector MemberSelector) (futures map[Member]CancellableFuture, err error)
+
+	// SubmitToAllMembers submits the task to all the members of the cluster.
+	// It returns the futures of the task by member.
+	SubmitToAllMembers(task interface{}) (futures map[Member]CancellableFuture, err error)
+
+	// Shutdown initiates a shutdown of the executor on the cluster. The previously submitted tasks are
+	// executed, but no new tasks are accepted.
+	Shutdown() (err error)
+
+	// IsShutdown returns true if the executor has been shut down.
+	IsShutdown() (isShutdown bool, err error)
+}
+
+// CancellableFuture is a Future of a task that can be cancelled.
+type CancellableFuture interface {
+	Future
+
+	// Cancel attempts to cancel the task. If the task has already started, it is interrupted only
+	// if mayInterrupt is true.
+	// It returns true if the task was cancelled, in which case the methods of the future
+	// return a HazelcastCancellationError.
+	// It returns false if the task has already completed or could not be cancelled.
+	Cancel(mayInterrupt bool) (cancelled bool, err error)
+
+	// IsCancelled returns true if the task was cancelled by Cancel.
+	IsCancelled() bool
+}
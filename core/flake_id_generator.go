@@ -14,6 +14,8 @@
 
 package core
 
+import "context"
+
 // FlakeIDGenerator is a cluster-wide unique ID generator. Generated IDs are 'int64' primitive values
 // and are k-ordered (roughly ordered). IDs are in the range from `0` to `math.MaxInt64`.
 //
@@ -53,4 +55,9 @@ type FlakeIDGenerator interface {
 	// See Node ID overflow note above.
 	// NewID returns 'HazelcastUnsupportedOperationError' if the cluster version is below 3.10.
 	NewID() (id int64, err error)
+
+	// WithContext returns a view of this generator whose operations are bound to the given context,
+	// see Map.WithContext. The view shares the prefetched IDs of this generator, so the context only
+	// bounds the calls of NewID that fetch a new batch of IDs from the cluster.
+	WithContext(ctx context.Context) FlakeIDGenerator
 }
@@ -0,0 +1,39 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import "time"
+
+// Future is the result of an asynchronous operation.
+// The result is computed once, all the calls to Get return the same value and error.
+type Future interface {
+	// Get waits until the operation is completed and returns its result.
+	Get() (value interface{}, err error)
+
+	// GetWithTimeout waits at most the given duration for the operation to complete and returns its result.
+	// GetWithTimeout returns a HazelcastTimeoutError if the operation is not completed in time.
+	// The operation is not cancelled when the timeout passes, the result can be retrieved later.
+	GetWithTimeout(timeout time.Duration) (value interface{}, err error)
+
+	// Done returns a channel that is closed when the operation is completed.
+	// It can be used in a select statement together with other channels.
+	Done() <-chan struct{}
+
+	// AndThen registers a callback that is called with the result of the operation once it is completed.
+	// If the operation is already completed, the callback is called immediately on the calling goroutine.
+	// Otherwise it is called on a separate goroutine once the operation is completed, so a slow callback
+	// does not delay the other operations of the client. The callbacks of the same future are called in order.
+	AndThen(callback func(value interface{}, err error))
+}
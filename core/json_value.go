@@ -0,0 +1,47 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"encoding/json"
+)
+
+// JSONValue is a JSON document that is stored in the cluster as a HazelcastJsonValue, so that the members can
+// run predicates on its fields, such as predicate.Equal("address.city", "London").
+// The string is not validated by the client, it should be valid JSON.
+// JSONValue requires Hazelcast 3.12 or newer members, older members cannot deserialize it.
+type JSONValue string
+
+// NewJSONValue returns the JSON encoding of v as a JSONValue, see json.Marshal.
+func NewJSONValue(v interface{}) (JSONValue, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", NewHazelcastSerializationError("cannot marshal to JSON", err)
+	}
+	return JSONValue(b), nil
+}
+
+// Unmarshal parses the JSON document into the value pointed to by v, see json.Unmarshal.
+func (v JSONValue) Unmarshal(target interface{}) error {
+	if err := json.Unmarshal([]byte(v), target); err != nil {
+		return NewHazelcastSerializationError("cannot unmarshal JSON", err)
+	}
+	return nil
+}
+
+// String returns the JSON document.
+func (v JSONValue) String() string {
+	return string(v)
+}
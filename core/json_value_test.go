@@ -0,0 +1,60 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+type jsonAddress struct {
+	City string `json:"city"`
+}
+
+type jsonPerson struct {
+	Name    string      `json:"name"`
+	Age     int         `json:"age"`
+	Address jsonAddress `json:"address"`
+}
+
+func TestJSONValue(t *testing.T) {
+	person := jsonPerson{Name: "Joe", Age: 30, Address: jsonAddress{City: "Istanbul"}}
+	value, err := NewJSONValue(person)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"name":"Joe","age":30,"address":{"city":"Istanbul"}}`
+	if value.String() != expected {
+		t.Errorf("expected %s, got %s", expected, value)
+	}
+	var ret jsonPerson
+	if err := value.Unmarshal(&ret); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ret, person) {
+		t.Errorf("expected %v, got %v", person, ret)
+	}
+}
+
+func TestJSONValue_Errors(t *testing.T) {
+	if _, err := NewJSONValue(make(chan int)); err == nil {
+		t.Error("expected an error for a value that cannot be marshaled")
+	}
+	var ret jsonPerson
+	err := JSONValue(`{"name":`).Unmarshal(&ret)
+	if _, ok := err.(*HazelcastSerializationError); !ok {
+		t.Errorf("expected HazelcastSerializationError, got %v", err)
+	}
+}
@@ -14,6 +14,8 @@
 
 package core
 
+import "context"
+
 // List is a concurrent, distributed, ordered collection. The user of this
 // interface has precise control over where in the list each element is
 // inserted.  The user can access elements by their integer index (position in the list),
@@ -106,4 +108,8 @@ type List interface {
 
 	// ToSlice returns a slice that contains all elements of this list in proper sequence.
 	ToSlice() (elements []interface{}, err error)
+
+	// WithContext returns a view of this list whose operations are bound to the given context,
+	// see Map.WithContext.
+	WithContext(ctx context.Context) List
 }
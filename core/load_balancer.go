@@ -0,0 +1,105 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License")
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"math/rand"
+	"sync/atomic"
+)
+
+// LoadBalancer is used to select the member that a non key based operation will be sent to.
+// It is set on the client configuration and initialized once when the client starts.
+type LoadBalancer interface {
+	// Init initializes the LoadBalancer with the cluster that the client is connected to.
+	Init(cluster Cluster)
+
+	// Next returns the next member to route to.
+	// Next returns nil if no member is available.
+	Next() Member
+}
+
+type abstractLoadBalancer struct {
+	cluster  Cluster
+	selector MemberSelector
+}
+
+func (b *abstractLoadBalancer) Init(cluster Cluster) {
+	b.cluster = cluster
+}
+
+func (b *abstractLoadBalancer) members() []Member {
+	if b.cluster == nil {
+		return nil
+	}
+	members := b.cluster.GetMembers()
+	if b.selector == nil {
+		return members
+	}
+	selected := make([]Member, 0, len(members))
+	for _, member := range members {
+		if b.selector.Select(member) {
+			selected = append(selected, member)
+		}
+	}
+	return selected
+}
+
+type roundRobinLoadBalancer struct {
+	abstractLoadBalancer
+	index int64
+}
+
+// NewRoundRobinLoadBalancer returns a LoadBalancer that goes through the members of the cluster one after another.
+func NewRoundRobinLoadBalancer() LoadBalancer {
+	return &roundRobinLoadBalancer{}
+}
+
+// NewMemberSelectingLoadBalancer returns a round robin LoadBalancer that only routes to the members
+// for which the given selector returns true, e.g. MemberSelectors.DataMemberSelector to keep the
+// operations off lite members.
+func NewMemberSelectingLoadBalancer(selector MemberSelector) LoadBalancer {
+	return &roundRobinLoadBalancer{abstractLoadBalancer: abstractLoadBalancer{selector: selector}}
+}
+
+func (b *roundRobinLoadBalancer) Next() Member {
+	members := b.members()
+	size := int64(len(members))
+	if size == 0 {
+		return nil
+	}
+	index := atomic.AddInt64(&b.index, 1) % size
+	if index < 0 {
+		index += size
+	}
+	return members[index]
+}
+
+type randomLoadBalancer struct {
+	abstractLoadBalancer
+}
+
+// NewRandomLoadBalancer returns a LoadBalancer that selects a random member of the cluster.
+func NewRandomLoadBalancer() LoadBalancer {
+	return &randomLoadBalancer{}
+}
+
+func (b *randomLoadBalancer) Next() Member {
+	members := b.members()
+	size := len(members)
+	if size == 0 {
+		return nil
+	}
+	return members[rand.Intn(size)]
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License")
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"testing"
+)
+
+type testMember struct {
+	Member
+	uuid string
+	lite bool
+}
+
+func (m *testMember) UUID() string {
+	return m.uuid
+}
+
+func (m *testMember) IsLiteMember() bool {
+	return m.lite
+}
+
+type testCluster struct {
+	Cluster
+	members []Member
+}
+
+func (c *testCluster) GetMembers() []Member {
+	return c.members
+}
+
+func newTestCluster() *testCluster {
+	return &testCluster{members: []Member{
+		&testMember{uuid: "1"},
+		&testMember{uuid: "2", lite: true},
+		&testMember{uuid: "3"},
+	}}
+}
+
+func TestRoundRobinLoadBalancer(t *testing.T) {
+	lb := NewRoundRobinLoadBalancer()
+	cluster := newTestCluster()
+	lb.Init(cluster)
+	counts := make(map[string]int)
+	for i := 0; i < 30; i++ {
+		counts[lb.Next().UUID()]++
+	}
+	for _, member := range cluster.members {
+		if counts[member.UUID()] != 10 {
+			t.Fatalf("expected member %s to be selected 10 times, got %d", member.UUID(), counts[member.UUID()])
+		}
+	}
+}
+
+func TestRandomLoadBalancer(t *testing.T) {
+	lb := NewRandomLoadBalancer()
+	cluster := newTestCluster()
+	lb.Init(cluster)
+	for i := 0; i < 30; i++ {
+		next := lb.Next()
+		found := false
+		for _, member := range cluster.members {
+			if member == next {
+				found = true
+			}
+		}
+		if !found {
+			t.Fatal("random load balancer returned a member that is not in the cluster")
+		}
+	}
+}
+
+func TestMemberSelectingLoadBalancer(t *testing.T) {
+	lb := NewMemberSelectingLoadBalancer(MemberSelectors.DataMemberSelector)
+	lb.Init(newTestCluster())
+	for i := 0; i < 30; i++ {
+		if lb.Next().IsLiteMember() {
+			t.Fatal("member selecting load balancer returned a lite member")
+		}
+	}
+}
+
+func TestLoadBalancerWithoutMembers(t *testing.T) {
+	for _, lb := range []LoadBalancer{NewRoundRobinLoadBalancer(), NewRandomLoadBalancer(),
+		NewMemberSelectingLoadBalancer(MemberSelectors.DataMemberSelector)} {
+		if lb.Next() != nil {
+			t.Fatal("load balancer should return nil before it is initialized")
+		}
+		lb.Init(&testCluster{})
+		if lb.Next() != nil {
+			t.Fatal("load balancer should return nil when there is no member")
+		}
+	}
+}
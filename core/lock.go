@@ -0,0 +1,104 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// Lock is a distributed re-entrant mutual exclusion lock.
+//
+// Go has no thread identity, so the lock is owned by an owner ID instead of a thread.
+// The operations of a Lock use the owner ID stored in the context of the view returned
+// by WithContext, see WithLockOwner. The operations of a Lock without an owner in its
+// context, and the key locks of Map and MultiMap, share a single default owner ID per client.
+type Lock interface {
+	// DistributedObject is the base interface for all distributed objects.
+	DistributedObject
+
+	// Lock acquires the lock, waiting until it becomes available.
+	// Locks are re-entrant; if the lock is acquired N times by the same owner,
+	// it should be released N times before another owner can acquire it.
+	Lock() (err error)
+
+	// LockWithLease acquires the lock for the given lease time, waiting until it becomes available.
+	// The lock is released automatically after the lease time.
+	LockWithLease(lease time.Duration) (err error)
+
+	// TryLock acquires the lock only if it is available at the time of invocation.
+	// It returns true if the lock was acquired.
+	TryLock() (locked bool, err error)
+
+	// TryLockWithTimeout acquires the lock if it becomes available within the given timeout.
+	// It returns true if the lock was acquired.
+	TryLockWithTimeout(timeout time.Duration) (locked bool, err error)
+
+	// TryLockWithTimeoutAndLease acquires the lock for the given lease time if it becomes available
+	// within the given timeout.
+	// It returns true if the lock was acquired.
+	TryLockWithTimeoutAndLease(timeout time.Duration, lease time.Duration) (locked bool, err error)
+
+	// Unlock releases the lock once. The lock is freed when its hold count reaches zero.
+	// It returns a HazelcastIllegalMonitorStateError if the owner does not hold the lock.
+	Unlock() (err error)
+
+	// ForceUnlock releases the lock regardless of its owner.
+	ForceUnlock() (err error)
+
+	// IsLocked returns true if the lock is held by any owner.
+	IsLocked() (locked bool, err error)
+
+	// IsLockedByCurrentThread returns true if the lock is held by the owner of this view.
+	IsLockedByCurrentThread() (locked bool, err error)
+
+	// GetLockCount returns the number of times the lock is re-entrantly held.
+	GetLockCount() (count int32, err error)
+
+	// GetRemainingLeaseTime returns the remaining lease time of the lock.
+	// It returns a negative duration if the lock is not held.
+	GetRemainingLeaseTime() (remaining time.Duration, err error)
+
+	// WithContext returns a view of this lock whose operations are bound to the given context,
+	// see Map.WithContext. If the context carries an owner ID, see WithLockOwner,
+	// the operations of the view are performed on behalf of that owner.
+	WithContext(ctx context.Context) Lock
+}
+
+type lockOwnerKey struct{}
+
+// lastLockOwnerID starts after the default owner ID of the client.
+var lastLockOwnerID int64 = 1
+
+// NewLockOwnerID returns a new owner ID that is unique within the process.
+// It never returns the default owner ID used by operations without an owner in their context.
+func NewLockOwnerID() int64 {
+	return atomic.AddInt64(&lastLockOwnerID, 1)
+}
+
+// WithLockOwner returns a copy of ctx that carries the given lock owner ID.
+// Lock, Map and MultiMap views created with such a context acquire and release locks
+// on behalf of that owner.
+func WithLockOwner(ctx context.Context, ownerID int64) context.Context {
+	return context.WithValue(ctx, lockOwnerKey{}, ownerID)
+}
+
+// LockOwnerFromContext returns the lock owner ID carried by ctx.
+// The second return value is false if ctx does not carry an owner ID.
+func LockOwnerFromContext(ctx context.Context) (ownerID int64, ok bool) {
+	ownerID, ok = ctx.Value(lockOwnerKey{}).(int64)
+	return
+}
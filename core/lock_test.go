@@ -0,0 +1,45 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"testing"
+)
+
+func TestWithLockOwner(t *testing.T) {
+	ctx := WithLockOwner(context.Background(), 42)
+	ownerID, ok := LockOwnerFromContext(ctx)
+	if !ok || ownerID != 42 {
+		t.Errorf("expected owner ID 42, got %d", ownerID)
+	}
+}
+
+func TestLockOwnerFromContext_NoOwner(t *testing.T) {
+	if _, ok := LockOwnerFromContext(context.Background()); ok {
+		t.Error("LockOwnerFromContext should return false for a context without an owner")
+	}
+}
+
+func TestNewLockOwnerID(t *testing.T) {
+	first := NewLockOwnerID()
+	second := NewLockOwnerID()
+	if first <= 1 || second <= 1 {
+		t.Error("NewLockOwnerID should not return the default owner ID")
+	}
+	if first == second {
+		t.Error("NewLockOwnerID should return unique IDs")
+	}
+}
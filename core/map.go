@@ -15,6 +15,7 @@
 package core
 
 import (
+	"context"
 	"time"
 )
 
@@ -265,6 +266,7 @@ type Map interface {
 	// returns the keys of matching entries.
 	// Specified predicate runs on all members in parallel.
 	// The slice is NOT backed by the map, so changes to the map are NOT reflected in the slice, and vice-versa.
+	// If the predicate is a PagingPredicate, only the keys on its current page are returned.
 	KeySetWithPredicate(predicate interface{}) (keySet []interface{}, err error)
 
 	// Values returns a slice clone of the values contained in this map.
@@ -274,6 +276,7 @@ type Map interface {
 	// ValuesWithPredicate queries the map based on the specified predicate and returns the values of matching entries.
 	// Specified predicate runs on all members in parallel.
 	// The slice is NOT backed by the map, so changes to the map are NOT reflected in the slice, and vice-versa.
+	// If the predicate is a PagingPredicate, only the values on its current page are returned.
 	ValuesWithPredicate(predicate interface{}) (values []interface{}, err error)
 
 	// EntrySet returns a slice of Pairs clone of the mappings contained in this map.
@@ -283,8 +286,23 @@ type Map interface {
 	// EntrySetWithPredicate queries the map based on the specified predicate and returns the matching entries.
 	// Specified predicate runs on all members in parallel.
 	// The slice is NOT backed by the map, so changes to the map are NOT reflected in the slice, and vice-versa.
+	// If the predicate is a PagingPredicate, only the entries on its current page are returned.
 	EntrySetWithPredicate(predicate interface{}) (resultPairs []Pair, err error)
 
+	// Iterator returns an iterator over the entries of this map which fetches batchSize entries at a time.
+	// Unlike EntrySet, it does not load the whole map into memory, so it can be used for large maps.
+	// It returns HazelcastIllegalArgumentError if batchSize is not positive.
+	Iterator(batchSize int32) (iterator MapIterator, err error)
+
+	// KeyIterator returns an iterator over the keys of this map which fetches batchSize keys at a time.
+	// Unlike KeySet, it does not load all the keys into memory, so it can be used for large maps.
+	// It returns HazelcastIllegalArgumentError if batchSize is not positive.
+	KeyIterator(batchSize int32) (iterator MapIterator, err error)
+
+	// Range calls f for each entry of this map until f returns false, see Iterator.
+	// It returns the error that stopped the iteration, if any.
+	Range(f func(key interface{}, value interface{}) bool) (err error)
+
 	// TryLock tries to acquire the lock for the specified key.
 	// If the lock is not available then the current thread
 	// does not wait and returns false immediately.
@@ -428,4 +446,66 @@ type Map interface {
 	// This struct must have a serializable EntryProcessor counter part registered on server side with the actual
 	// org.hazelcast.map.EntryProcessor implementation.
 	ExecuteOnEntriesWithPredicate(entryProcessor interface{}, predicate interface{}) (keyToResultPairs []Pair, err error)
+
+	// GetAsync asynchronously gets the value for the specified key.
+	// The future returns nil if this map does not contain this key.
+	// The errors, including the invalid arguments, are returned through the future.
+	GetAsync(key interface{}) Future
+
+	// PutAsync asynchronously associates the specified value with the specified key in this map.
+	// The future returns the previous value associated with the key, or nil if there was no mapping for the key.
+	PutAsync(key interface{}, value interface{}) Future
+
+	// SetAsync asynchronously associates the specified value with the specified key in this map.
+	// Unlike PutAsync, the previous value is not returned, the future returns nil when the operation is completed.
+	SetAsync(key interface{}, value interface{}) Future
+
+	// RemoveAsync asynchronously removes the mapping for a key from this map if it is present.
+	// The future returns the previous value associated with the key, or nil if there was no mapping for the key.
+	RemoveAsync(key interface{}) Future
+
+	// DeleteAsync asynchronously removes the mapping for a key from this map if it is present.
+	// Unlike RemoveAsync, the previous value is not returned, the future returns nil when the operation is completed.
+	DeleteAsync(key interface{}) Future
+
+	// ExecuteOnKeyAsync asynchronously applies the user defined EntryProcessor to the entry mapped by the key.
+	// The future returns the result of EntryProcessor's process method.
+	ExecuteOnKeyAsync(key interface{}, entryProcessor interface{}) Future
+
+	// ReadFromEventJournal reads the events from the event journal of the partition with the given ID, starting from
+	// startSequence. It waits until at least minSize events are available and reads at most maxSize events.
+	// The events can be filtered by the given predicate and transformed by the given projection, both of them
+	// can be nil. The event journal should be enabled for the map on the members.
+	// ReadFromEventJournal returns HazelcastStaleSequenceError if startSequence is older than the oldest event
+	// in the event journal.
+	ReadFromEventJournal(startSequence int64, minSize int32, maxSize int32, partitionID int32, predicate interface{},
+		projection interface{}) (resultSet EventJournalReadResultSet, err error)
+
+	// SubscribeToEventJournal subscribes to the event journals of all partitions and returns the stream of their
+	// events filtered by the given predicate, which can be nil.
+	// Each partition is read from its sequence in startSequences, or from its oldest event if startSequences does not
+	// contain the partition, so the offsets of an earlier subscription can be given to continue from where it stopped.
+	// If the map is bound to a context by WithContext, the subscription is closed when the context is done.
+	SubscribeToEventJournal(startSequences map[int32]int64, predicate interface{}) (subscription EventJournalSubscription,
+		err error)
+
+	// GetQueryCache returns the query cache with the given name of this map, creating it if it does not exist.
+	// The query cache is a continuously updated local view of the entries that satisfy the given predicate.
+	// If includeValue is false, only the keys of the entries are kept, the values are read from the map
+	// when they are requested.
+	// The predicate and includeValue are only used when the query cache is created.
+	GetQueryCache(name string, predicate interface{}, includeValue bool) (queryCache QueryCache, err error)
+
+	// NearCacheStats returns the statistics of the Near Cache of this map.
+	// NearCacheStats returns nil if the Near Cache is not configured for this map.
+	NearCacheStats() NearCacheStats
+
+	// WithContext returns a view of this map whose operations are bound to the given context.
+	// If the context is cancelled or its deadline passes before an operation completes, the operation
+	// returns a HazelcastCancellationError or a HazelcastTimeoutError and is not retried anymore.
+	// The operation may still be executed on the cluster.
+	// The listeners added through the returned view are not bound to the context.
+	// If the context carries a lock owner ID, see WithLockOwner, the key locks of the view are
+	// acquired and released on behalf of that owner.
+	WithContext(ctx context.Context) Map
 }
@@ -0,0 +1,46 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+// MapIterator iterates over the entries of a map partition by partition, fetching a batch of entries from the
+// owner of the partition at a time, so the map does not need to fit into the memory of the client.
+//
+// The iterator does not take a snapshot of the map: the entries that are added, updated or removed during the
+// iteration may or may not be returned. If a partition migrates to another member during the iteration, some of its
+// entries may be returned twice or may be missed.
+//
+// A MapIterator is used as follows:
+//
+//	it, _ := mp.Iterator(1000)
+//	for it.Next() {
+//		fmt.Println(it.Key(), it.Value())
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type MapIterator interface {
+	// Next advances the iterator to the next entry, which is then available through Key and Value.
+	// It returns false when there are no more entries or an error occurs, see Err.
+	Next() bool
+
+	// Key returns the key of the current entry.
+	Key() interface{}
+
+	// Value returns the value of the current entry. It returns nil if the iterator only iterates over the keys.
+	Value() interface{}
+
+	// Err returns the error that stopped the iteration, or nil if the iteration is completed.
+	Err() error
+}
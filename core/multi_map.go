@@ -14,7 +14,10 @@
 
 package core
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // MultiMap is a specialized map whose keys can be associated with multiple values.
 type MultiMap interface {
@@ -149,4 +152,8 @@ type MultiMap interface {
 	// This in contrast to the regular unlock, which has to be called the same amount of times as
 	// the lock was acquired.
 	ForceUnlock(key interface{}) (err error)
+
+	// WithContext returns a view of this multimap whose operations are bound to the given context,
+	// see Map.WithContext. Like Map, the view honors the lock owner ID carried by the context.
+	WithContext(ctx context.Context) MultiMap
 }
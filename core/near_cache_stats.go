@@ -0,0 +1,41 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import "time"
+
+// NearCacheStats contains the statistics of a Near Cache.
+type NearCacheStats interface {
+	// CreationTime returns the time that the Near Cache was created at.
+	CreationTime() time.Time
+
+	// OwnedEntryCount returns the number of entries in the Near Cache.
+	OwnedEntryCount() int64
+
+	// Hits returns the number of reads that were served from the Near Cache.
+	Hits() int64
+
+	// Misses returns the number of reads that could not be served from the Near Cache.
+	Misses() int64
+
+	// Evictions returns the number of entries that were evicted because the Near Cache was full.
+	Evictions() int64
+
+	// Expirations returns the number of entries that were removed because of their time to live or max idle time.
+	Expirations() int64
+
+	// Invalidations returns the number of entries that were removed because they were changed.
+	Invalidations() int64
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+// Comparator compares the entries of a map to sort the results of a PagingPredicate.
+// A Comparator is sent to the members together with the predicate, so it should be an
+// IdentifiedDataSerializable whose factory is registered on the client, and the members should have
+// an equivalent comparator with the same factory and class IDs.
+type Comparator interface {
+	// Compare returns a negative number if entry1 comes before entry2, a positive number if entry1
+	// comes after entry2, and zero if their order does not matter.
+	Compare(entry1 Pair, entry2 Pair) int
+}
+
+// PagingPredicate is a predicate that splits the results of a map query into pages of a fixed size.
+// The results are sorted by the comparator of the predicate, or by their natural order if it has no comparator:
+// keys are sorted by keys, values by values, and entries by values and then by keys.
+//
+// The map query methods, such as Map.ValuesWithPredicate, return the current page of the predicate.
+// The predicate keeps the last entry of each page it has returned as the anchor of that page,
+// which is used by the members to skip the entries of the earlier pages. So a PagingPredicate should be
+// used for a single map and a single query method, and it should not be used concurrently.
+type PagingPredicate interface {
+	// NextPage moves the predicate to the next page.
+	NextPage()
+
+	// PreviousPage moves the predicate to the previous page, unless it is on the first page.
+	PreviousPage()
+
+	// SetPage moves the predicate to the given page. Pages start from 0, a negative page moves it to the first page.
+	SetPage(page int32)
+
+	// Page returns the current page of the predicate.
+	Page() int32
+
+	// PageSize returns the number of results in a page.
+	PageSize() int32
+
+	// Comparator returns the comparator of the predicate, or nil if it has none.
+	Comparator() Comparator
+
+	// Anchor returns the nearest anchor before the current page and the page that it belongs to.
+	// Anchor returns -1 and nil if there is no such anchor.
+	Anchor() (page int32, anchor Pair)
+
+	// Reset moves the predicate to the first page and forgets its anchors.
+	Reset()
+}
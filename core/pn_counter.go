@@ -14,6 +14,8 @@
 
 package core
 
+import "context"
+
 // PNCounter is PN (Positive-Negative) CRDT counter.
 //
 // The counter supports adding and subtracting values as well as
@@ -119,4 +121,8 @@ type PNCounter interface {
 	// after a method invocation has returned a HazelcastConsistencyLostError
 	// to reset the proxy and to be able to start a new session.
 	Reset()
+
+	// WithContext returns a view of this counter whose operations are bound to the given context,
+	// see Map.WithContext. The view shares the session of this counter, so Reset applies to both.
+	WithContext(ctx context.Context) PNCounter
 }
@@ -16,6 +16,7 @@
 package predicate
 
 import (
+	"github.com/hazelcast/hazelcast-go-client/core"
 	"github.com/hazelcast/hazelcast-go-client/internal/predicate"
 )
 
@@ -112,3 +113,11 @@ func True() interface{} {
 func False() interface{} {
 	return predicate.NewFalse()
 }
+
+// Paging returns a PagingPredicate which returns the results of the inner predicate in pages of pageSize results,
+// sorted by the given comparator. Both the inner predicate and the comparator can be nil.
+// The map queries return a HazelcastIllegalArgumentError if pageSize is not positive or the inner predicate
+// is a PagingPredicate.
+func Paging(inner interface{}, pageSize int32, comparator core.Comparator) core.PagingPredicate {
+	return predicate.NewPaging(inner, pageSize, comparator)
+}
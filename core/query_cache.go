@@ -0,0 +1,115 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+// QueryCache is a local view of the entries of a Map that satisfy a predicate.
+// The view is populated from the cluster when it is created and kept up to date by the events of the map,
+// so its reads are served locally without contacting the cluster.
+//
+// The predicates given to the methods of QueryCache are evaluated on the client. The SQL and InstanceOf predicates
+// can only be evaluated by the cluster, they make those methods return a HazelcastUnsupportedOperationError.
+// If the query cache is created without values, the values returned by its methods are read from the map, and its
+// predicates can only refer to the attributes of the keys, the others make the methods return a
+// HazelcastIllegalArgumentError.
+//
+// Each partition of the map sends its events in sequence. If an event of a partition is missed, for example while
+// the connection to its member is down, the listeners implementing EventLostListener are notified and the view may
+// be inconsistent with the map until TryRecover succeeds. The query cache also follows the members that join
+// the cluster, and it is populated again from the map when the client reconnects.
+type QueryCache interface {
+	// Name returns the name of this query cache.
+	Name() string
+
+	// Get returns the value for the specified key, or nil if this query cache does not contain the key.
+	// If the query cache is created without values, the value of a contained key is read from the map.
+	Get(key interface{}) (value interface{}, err error)
+
+	// ContainsKey determines whether this query cache contains the specified key.
+	ContainsKey(key interface{}) (found bool, err error)
+
+	// Size returns the number of entries in this query cache.
+	Size() int32
+
+	// IsEmpty returns true if this query cache does not contain any entries.
+	IsEmpty() bool
+
+	// KeySet returns a slice of the keys contained in this query cache.
+	KeySet() (keySet []interface{}, err error)
+
+	// KeySetWithPredicate returns a slice of the keys of the entries in this query cache
+	// that satisfy the specified predicate.
+	KeySetWithPredicate(predicate interface{}) (keySet []interface{}, err error)
+
+	// Values returns a slice of the values contained in this query cache.
+	Values() (values []interface{}, err error)
+
+	// ValuesWithPredicate returns a slice of the values of the entries in this query cache
+	// that satisfy the specified predicate.
+	ValuesWithPredicate(predicate interface{}) (values []interface{}, err error)
+
+	// EntrySet returns a slice of Pairs of the entries contained in this query cache.
+	EntrySet() (resultPairs []Pair, err error)
+
+	// EntrySetWithPredicate returns a slice of Pairs of the entries in this query cache
+	// that satisfy the specified predicate.
+	EntrySetWithPredicate(predicate interface{}) (resultPairs []Pair, err error)
+
+	// AddEntryListener adds a listener which is notified of the changes of this query cache.
+	// To receive an event, listener should implement a corresponding interface for that event.
+	// Supported listeners for QueryCache:
+	//  * EntryAddedListener
+	//  * EntryRemovedListener
+	//  * EntryUpdatedListener
+	//  * EntryEvictedListener
+	//  * EntryMergedListener
+	//  * EntryExpiredListener
+	//  * MapEvictedListener
+	//  * MapClearedListener
+	//  * EventLostListener
+	// If includeValue is false, the events do not carry the values of the entries.
+	// AddEntryListener returns an ID which is used as a key to remove the listener.
+	AddEntryListener(listener interface{}, includeValue bool) (registrationID string, err error)
+
+	// RemoveEntryListener removes the listener with the given registrationID.
+	// It returns true if the listener is removed, false if there is no such listener.
+	RemoveEntryListener(registrationID string) (removed bool)
+
+	// AddIndex adds an index for the given attribute to speed up the queries on this query cache.
+	// An ordered index is also used for range queries such as GreaterLess and Between,
+	// see Map.AddIndex for the attribute syntax.
+	AddIndex(attribute string, ordered bool) (err error)
+
+	// TryRecover asks the members to send the events of this query cache again, starting from the
+	// first event missed by each partition. It can be called after an EventLostEvent is received.
+	// TryRecover returns true if all the partitions that missed events are recovered, and false if
+	// some of their events are no longer available on the members. In that case the query cache should be
+	// destroyed and created again.
+	TryRecover() (recovered bool, err error)
+
+	// Destroy removes this query cache from the client and the cluster.
+	Destroy() (err error)
+}
+
+// EventLostEvent is fired when a query cache misses an event of a partition.
+type EventLostEvent interface {
+	// PartitionID returns the ID of the partition whose event is missed.
+	PartitionID() int32
+}
+
+// EventLostListener is notified when a query cache misses an event.
+type EventLostListener interface {
+	// EventLost is invoked when a query cache misses an event.
+	EventLost(event EventLostEvent)
+}
@@ -14,7 +14,10 @@
 
 package core
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // Queue is a concurrent, blocking, distributed, observable queue. Queue is not a partitioned data-structure.
 // All of the Queue content is stored in a single machine (and in the backup).
@@ -109,4 +112,9 @@ type Queue interface {
 
 	// ToSlice returns all the items in this queue in proper sequence.
 	ToSlice() (items []interface{}, err error)
+
+	// WithContext returns a view of this queue whose operations are bound to the given context,
+	// see Map.WithContext.
+	// A blocking operation such as Take returns when the context is done.
+	WithContext(ctx context.Context) Queue
 }
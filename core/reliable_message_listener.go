@@ -0,0 +1,41 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+// ReliableMessageListener is a MessageListener for a reliable topic which controls the sequence that
+// it starts to read the messages from and how the gaps in the sequence are handled.
+//
+// A reliable topic reads the messages of each listener from the ringbuffer of the topic in a separate goroutine,
+// so a slow listener does not slow down the other listeners. A MessageListener which is not a ReliableMessageListener
+// starts from the next published message and stops if it falls behind the ringbuffer.
+type ReliableMessageListener interface {
+	// MessageListener is invoked for each message in the order of their sequences.
+	MessageListener
+
+	// RetrieveInitialSequence returns the sequence of the first message that the listener reads.
+	// The sequence stored by StoreSequence plus one can be returned to continue from the last processed message.
+	// It returns -1 to start from the next published message.
+	RetrieveInitialSequence() int64
+
+	// StoreSequence is called with the sequence of each message before the message is passed to OnMessage.
+	// The sequence can be stored to continue from it after a restart, see RetrieveInitialSequence.
+	StoreSequence(sequence int64)
+
+	// IsLossTolerant reports whether the listener tolerates the messages that it misses.
+	// If the listener falls behind the ringbuffer, i.e. the messages that it has not read yet are
+	// overwritten, a loss tolerant listener continues from the oldest message in the ringbuffer.
+	// The other listeners are removed from the topic.
+	IsLossTolerant() bool
+}
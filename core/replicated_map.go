@@ -15,6 +15,7 @@
 package core
 
 import (
+	"context"
 	"time"
 )
 
@@ -143,4 +144,8 @@ type ReplicatedMap interface {
 	// listener added before.
 	// It returns true if remove operation is successful, false if unsuccessful or this listener did not exist.
 	RemoveEntryListener(registrationID string) (removed bool, err error)
+
+	// WithContext returns a view of this replicated map whose operations are bound to the given context,
+	// see Map.WithContext.
+	WithContext(ctx context.Context) ReplicatedMap
 }
@@ -14,6 +14,8 @@
 
 package core
 
+import "context"
+
 // Ringbuffer is a data-structure where the content is stored in a ring like structure. A ringbuffer has a capacity so it
 // won't grow beyond that capacity and endanger the stability of the system. If that capacity is exceeded, than the oldest
 // item in the ringbuffer is overwritten.
@@ -87,6 +89,11 @@ type Ringbuffer interface {
 	// If there are less items available than minCount, then this call will not return a response until
 	// a necessary number of items becomes available.
 	ReadMany(startSequence int64, minCount int32, maxCount int32, filter interface{}) (readResultSet ReadResultSet, err error)
+
+	// WithContext returns a view of this ringbuffer whose operations are bound to the given context,
+	// see Map.WithContext.
+	// ReadOne and ReadMany, which block until enough items are available, return when the context is done.
+	WithContext(ctx context.Context) Ringbuffer
 }
 
 // OverflowPolicy is a policy with which one can control the behavior of what should be done
@@ -0,0 +1,112 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import "time"
+
+// ScheduledExecutorService schedules tasks to run on the members of the cluster after a delay,
+// or periodically.
+//
+// The tasks have the same requirements as the tasks of ExecutorService. A task that implements
+// NamedTask is scheduled with its name, so scheduling another task with the same name fails
+// until the first one is disposed. Other tasks are given a random name.
+//
+// A task scheduled by Schedule, ScheduleAtFixedRate or ScheduleOnKeyOwner is bound to a partition,
+// and survives the loss of a member. A task scheduled by ScheduleOnMember is lost with its member.
+type ScheduledExecutorService interface {
+	// DistributedObject is the base interface for all distributed objects.
+	DistributedObject
+
+	// Schedule schedules the task to run once after the given delay.
+	Schedule(task interface{}, delay time.Duration) (future ScheduledFuture, err error)
+
+	// ScheduleAtFixedRate schedules the task to run first after the given initial delay,
+	// and then periodically with the given period.
+	ScheduleAtFixedRate(task interface{}, initialDelay time.Duration, period time.Duration) (
+		future ScheduledFuture, err error)
+
+	// ScheduleOnMember schedules the task to run once on the given member after the given delay.
+	ScheduleOnMember(task interface{}, member Member, delay time.Duration) (future ScheduledFuture, err error)
+
+	// ScheduleOnKeyOwner schedules the task to run once on the owner of the given key after the given delay.
+	ScheduleOnKeyOwner(task interface{}, key interface{}, delay time.Duration) (future ScheduledFuture, err error)
+
+	// GetScheduledFuture returns the future of a scheduled task from its handler URN,
+	// see ScheduledFuture.HandlerURN.
+	// It returns a HazelcastIllegalArgumentError if the URN is not valid.
+	GetScheduledFuture(handlerURN string) (future ScheduledFuture, err error)
+
+	// GetAllScheduledFutures returns the futures of all the tasks scheduled by this executor, by member.
+	// The futures of the disposed tasks are not included.
+	GetAllScheduledFutures() (futures map[Member][]ScheduledFuture, err error)
+
+	// Shutdown initiates a shutdown of the executor on all the members. The previously scheduled tasks
+	// are executed, but no new tasks are accepted.
+	Shutdown() (err error)
+}
+
+// NamedTask is implemented by the tasks that are scheduled with a given name.
+type NamedTask interface {
+	// TaskName returns the name of the task.
+	TaskName() string
+}
+
+// ScheduledFuture is a handle to a scheduled task.
+//
+// The handle can be recreated from its URN by ScheduledExecutorService.GetScheduledFuture,
+// so it can be stored and used by another client, e.g. after a restart.
+// The resources of a task are kept on the cluster until it is disposed.
+type ScheduledFuture interface {
+	// HandlerURN returns the URN that identifies the task.
+	HandlerURN() string
+
+	// Get waits until the task is completed and returns its result.
+	// It returns a HazelcastCancellationError if the task is cancelled.
+	Get() (value interface{}, err error)
+
+	// GetDelay returns the remaining delay until the next run of the task.
+	GetDelay() (delay time.Duration, err error)
+
+	// Cancel attempts to cancel the task.
+	// It returns true if the task was cancelled.
+	Cancel(mayInterrupt bool) (cancelled bool, err error)
+
+	// IsCancelled returns true if the task was cancelled.
+	IsCancelled() (cancelled bool, err error)
+
+	// IsDone returns true if the task is completed or cancelled.
+	IsDone() (done bool, err error)
+
+	// Dispose cancels the task and releases its resources on the cluster.
+	// The methods of the future return an error after it is disposed.
+	Dispose() (err error)
+
+	// GetStats returns the statistics of the task.
+	GetStats() (stats ScheduledTaskStatistics, err error)
+}
+
+// ScheduledTaskStatistics contains the statistics of a scheduled task.
+type ScheduledTaskStatistics struct {
+	// TotalRuns is the number of times the task has run.
+	TotalRuns int64
+	// LastRunDuration is the duration of the last run of the task.
+	LastRunDuration time.Duration
+	// LastIdleTime is the time the task waited before its last run.
+	LastIdleTime time.Duration
+	// TotalRunTime is the total time the task has spent running.
+	TotalRunTime time.Duration
+	// TotalIdleTime is the total time the task has spent waiting for its runs.
+	TotalIdleTime time.Duration
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"time"
+)
+
+// Semaphore is a distributed counting semaphore.
+//
+// The semaphore maintains a set of permits. Acquire blocks until the requested permits are available
+// and takes them, Release adds permits back. The permits are not bound to the caller that acquired them,
+// so any caller can release permits.
+// The methods return a HazelcastIllegalArgumentError if the given number of permits is negative.
+type Semaphore interface {
+	// DistributedObject is the base interface for all distributed objects.
+	DistributedObject
+
+	// Init sets the number of available permits if the semaphore has not been initialized yet.
+	// It returns true if the permits were set, false if the semaphore was already initialized.
+	Init(permits int32) (initialized bool, err error)
+
+	// Acquire acquires the given number of permits, waiting until they are available.
+	// The wait is not bound by the invocation timeout, a semaphore bound to a context by WithContext
+	// stops waiting when the context is done.
+	Acquire(permits int32) (err error)
+
+	// TryAcquire acquires the given number of permits if they become available within the timeout.
+	// It returns true if the permits were acquired. The invocation timeout is counted after the given timeout.
+	TryAcquire(permits int32, timeout time.Duration) (acquired bool, err error)
+
+	// Release releases the given number of permits, increasing the number of available permits.
+	Release(permits int32) (err error)
+
+	// AvailablePermits returns the number of available permits.
+	AvailablePermits() (permits int32, err error)
+
+	// DrainPermits acquires all of the available permits.
+	// It returns the number of permits acquired.
+	DrainPermits() (permits int32, err error)
+
+	// ReducePermits reduces the number of available permits by the given reduction without blocking.
+	ReducePermits(reduction int32) (err error)
+
+	// WithContext returns a view of this semaphore whose operations are bound to the given context,
+	// see Map.WithContext.
+	WithContext(ctx context.Context) Semaphore
+}
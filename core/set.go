@@ -14,6 +14,8 @@
 
 package core
 
+import "context"
+
 // Set is the concurrent, distributed implementation of collection that contains no duplicate elements.
 // As implied by its name, this interface models the mathematical 'set' abstraction.
 type Set interface {
@@ -67,4 +69,8 @@ type Set interface {
 
 	// ToSlice returns all the items in this set in proper sequence.
 	ToSlice() (items []interface{}, err error)
+
+	// WithContext returns a view of this set whose operations are bound to the given context,
+	// see Map.WithContext.
+	WithContext(ctx context.Context) Set
 }
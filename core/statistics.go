@@ -0,0 +1,105 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import "time"
+
+// Statistics is a snapshot of the statistics of a client.
+// It is returned by Instance.Statistics.
+type Statistics struct {
+	// Timestamp is the time that the snapshot was taken at.
+	Timestamp time.Time
+
+	// Connections are the statistics of the active connections to the members.
+	Connections []ConnectionStatistics
+
+	// PendingInvocations is the number of invocations that are waiting for a response.
+	PendingInvocations int64
+
+	// InvocationRetries is the number of times that invocations were retried.
+	InvocationRetries int64
+
+	// InvocationLatencies are the latencies of the invocations which got a response from the cluster,
+	// keyed by the name of the message type, e.g. "MapGet".
+	InvocationLatencies map[string]LatencyStatistics
+
+	// Reconnects is the number of times that the client connected to the cluster again after losing its owner connection.
+	Reconnects int64
+
+	// HeartbeatFailures is the number of times that a connection stopped receiving heartbeats.
+	HeartbeatFailures int64
+
+	// PendingEvents is the number of events that are waiting for the event listeners.
+	PendingEvents int64
+
+	// DroppedEvents is the number of events that were dropped because the event queue was full.
+	// See property.EventQueueOverflowPolicy.
+	DroppedEvents int64
+
+	// Maps are the statistics of the maps keyed by map name.
+	Maps map[string]MapStatistics
+}
+
+// ConnectionStatistics contains the statistics of a connection to a member.
+type ConnectionStatistics struct {
+	// ConnectionID is the ID of the connection, which is unique within the client.
+	ConnectionID int64
+
+	// Address is the address of the member.
+	Address string
+
+	// Owner is true if this is the owner connection of the client.
+	Owner bool
+
+	// BytesSent is the number of bytes written to the connection.
+	BytesSent int64
+
+	// BytesReceived is the number of bytes read from the connection.
+	BytesReceived int64
+
+	// MessagesSent is the number of messages written to the connection.
+	MessagesSent int64
+
+	// MessagesReceived is the number of messages read from the connection.
+	MessagesReceived int64
+}
+
+// LatencyStatistics contains the latencies of the invocations of a message type.
+type LatencyStatistics struct {
+	// Count is the number of invocations.
+	Count int64
+
+	// Total is the sum of the latencies.
+	Total time.Duration
+
+	// Max is the highest latency.
+	Max time.Duration
+}
+
+// Mean returns the average latency, or 0 if there are no invocations.
+func (ls LatencyStatistics) Mean() time.Duration {
+	if ls.Count == 0 {
+		return 0
+	}
+	return ls.Total / time.Duration(ls.Count)
+}
+
+// MapStatistics contains the statistics of a map.
+type MapStatistics struct {
+	// Operations are the number of operations sent to the cluster for the map,
+	// keyed by the name of the message type, e.g. "MapPut".
+	// Operations served from the Near Cache are not included.
+	Operations map[string]int64
+}
@@ -14,6 +14,8 @@
 
 package core
 
+import "context"
+
 // Topic is a distribution mechanism for publishing messages that are delivered to multiple subscribers, which
 // is also known as a publish/subscribe (pub/sub) messaging model. Publish and subscriptions are cluster-wide. When a
 // member subscribes for a topic, it is actually registering for messages published by any member in the cluster,
@@ -38,4 +40,9 @@ type Topic interface {
 
 	// Publish publishes the message to all subscribers of this topic.
 	Publish(message interface{}) (err error)
+
+	// WithContext returns a view of this topic whose operations are bound to the given context,
+	// see Map.WithContext.
+	// Only Publish is bound to the context, the message listeners are not.
+	WithContext(ctx context.Context) Topic
 }
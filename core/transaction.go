@@ -0,0 +1,300 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import "time"
+
+// TransactionType is the type of a transaction.
+type TransactionType int32
+
+const (
+	// TransactionTypeTwoPhase is a two phase transaction. Before committing, the transaction log is
+	// copied to the backup members, so if the member that owns the transaction crashes, another member
+	// can complete the commit. This is the default transaction type.
+	TransactionTypeTwoPhase TransactionType = 1
+
+	// TransactionTypeOnePhase is a one phase transaction. It is faster than a two phase transaction,
+	// but if the member that owns the transaction crashes during the commit, the system may be left
+	// in an inconsistent state.
+	TransactionTypeOnePhase TransactionType = 2
+)
+
+const (
+	defaultTransactionTimeout    = 2 * time.Minute
+	defaultTransactionDurability = 1
+)
+
+// TransactionOptions contains the configuration of a transaction.
+type TransactionOptions struct {
+	timeout         time.Duration
+	durability      int32
+	transactionType TransactionType
+}
+
+// NewTransactionOptions returns TransactionOptions with a two minute timeout,
+// a durability of 1 and the two phase transaction type.
+func NewTransactionOptions() *TransactionOptions {
+	return &TransactionOptions{
+		timeout:         defaultTransactionTimeout,
+		durability:      defaultTransactionDurability,
+		transactionType: TransactionTypeTwoPhase,
+	}
+}
+
+// Timeout returns the timeout of the transaction.
+func (o *TransactionOptions) Timeout() time.Duration {
+	return o.timeout
+}
+
+// Durability returns the number of backups of the transaction log.
+func (o *TransactionOptions) Durability() int32 {
+	return o.durability
+}
+
+// TransactionType returns the type of the transaction.
+func (o *TransactionOptions) TransactionType() TransactionType {
+	return o.transactionType
+}
+
+// SetTimeout sets the timeout of the transaction. If the transaction is not committed
+// within the timeout, it is rolled back by the cluster.
+// It returns the options for chaining.
+func (o *TransactionOptions) SetTimeout(timeout time.Duration) *TransactionOptions {
+	o.timeout = timeout
+	return o
+}
+
+// SetDurability sets the number of backups of the transaction log.
+// It returns the options for chaining.
+func (o *TransactionOptions) SetDurability(durability int32) *TransactionOptions {
+	o.durability = durability
+	return o
+}
+
+// SetTransactionType sets the type of the transaction.
+// It returns the options for chaining.
+func (o *TransactionOptions) SetTransactionType(transactionType TransactionType) *TransactionOptions {
+	o.transactionType = transactionType
+	return o
+}
+
+// TransactionContext provides a context to perform transactional operations.
+// Operations on the transactional proxies returned by the context are sent over the
+// connection that the transaction was started on.
+//
+// A TransactionContext is not safe to use from multiple goroutines and can be used for
+// a single transaction only.
+type TransactionContext interface {
+	// Begin starts the transaction.
+	// It returns a HazelcastIllegalStateError if the transaction is already started.
+	Begin() (err error)
+
+	// Commit commits the transaction.
+	// It returns a HazelcastTransactionError if the transaction is not active or has timed out.
+	Commit() (err error)
+
+	// Rollback rolls back the transaction.
+	// It returns a HazelcastIllegalStateError if the transaction is not active.
+	Rollback() (err error)
+
+	// TxnID returns the ID of the transaction, or an empty string if it is not started.
+	TxnID() string
+
+	// GetMap returns the transactional map with the given name.
+	GetMap(name string) (TransactionalMap, error)
+
+	// GetQueue returns the transactional queue with the given name.
+	GetQueue(name string) (TransactionalQueue, error)
+
+	// GetList returns the transactional list with the given name.
+	GetList(name string) (TransactionalList, error)
+
+	// GetSet returns the transactional set with the given name.
+	GetSet(name string) (TransactionalSet, error)
+
+	// GetMultiMap returns the transactional multi-map with the given name.
+	GetMultiMap(name string) (TransactionalMultiMap, error)
+}
+
+// TransactionalMap is the transactional counterpart of Map.
+// Changes made through it are visible to others only after the transaction is committed.
+type TransactionalMap interface {
+	// DistributedObject is the base interface for all distributed objects.
+	DistributedObject
+
+	// ContainsKey returns true if the map contains an entry with the specified key.
+	ContainsKey(key interface{}) (found bool, err error)
+
+	// Get returns the value for the specified key, or nil if the map does not contain the key.
+	Get(key interface{}) (value interface{}, err error)
+
+	// GetForUpdate locks the key and returns its value. The lock is released when the
+	// transaction is committed or rolled back.
+	GetForUpdate(key interface{}) (value interface{}, err error)
+
+	// Size returns the number of entries in the map, including the changes made in the transaction.
+	Size() (size int32, err error)
+
+	// IsEmpty returns true if the map contains no entries.
+	IsEmpty() (empty bool, err error)
+
+	// Put associates the value with the key.
+	// It returns the old value of the key, or nil if there was none.
+	Put(key interface{}, value interface{}) (oldValue interface{}, err error)
+
+	// PutWithTTL associates the value with the key. The entry expires after the given ttl.
+	// It returns the old value of the key, or nil if there was none.
+	PutWithTTL(key interface{}, value interface{}, ttl time.Duration) (oldValue interface{}, err error)
+
+	// Set associates the value with the key without returning the old value.
+	Set(key interface{}, value interface{}) (err error)
+
+	// PutIfAbsent associates the value with the key if the key is not already associated with a value.
+	// It returns the current value of the key, or nil if there was none.
+	PutIfAbsent(key interface{}, value interface{}) (oldValue interface{}, err error)
+
+	// Replace replaces the value of the key only if the key is already associated with a value.
+	// It returns the old value of the key, or nil if there was none.
+	Replace(key interface{}, value interface{}) (oldValue interface{}, err error)
+
+	// ReplaceIfSame replaces the value of the key only if it is currently associated with oldValue.
+	// It returns true if the value was replaced.
+	ReplaceIfSame(key interface{}, oldValue interface{}, newValue interface{}) (replaced bool, err error)
+
+	// Remove removes the entry of the key.
+	// It returns the removed value, or nil if there was none.
+	Remove(key interface{}) (value interface{}, err error)
+
+	// RemoveIfSame removes the entry of the key only if it is currently associated with value.
+	// It returns true if the entry was removed.
+	RemoveIfSame(key interface{}, value interface{}) (removed bool, err error)
+
+	// Delete removes the entry of the key without returning the old value.
+	Delete(key interface{}) (err error)
+
+	// KeySet returns a slice of the keys in the map.
+	KeySet() (keySet []interface{}, err error)
+
+	// KeySetWithPredicate returns a slice of the keys whose entries match the predicate.
+	// Paging predicates are not supported.
+	KeySetWithPredicate(predicate interface{}) (keySet []interface{}, err error)
+
+	// Values returns a slice of the values in the map.
+	Values() (values []interface{}, err error)
+
+	// ValuesWithPredicate returns a slice of the values whose entries match the predicate.
+	// Paging predicates are not supported.
+	ValuesWithPredicate(predicate interface{}) (values []interface{}, err error)
+}
+
+// TransactionalQueue is the transactional counterpart of Queue.
+type TransactionalQueue interface {
+	// DistributedObject is the base interface for all distributed objects.
+	DistributedObject
+
+	// Offer inserts the item at the tail of the queue if there is space available.
+	// It returns true if the item was added.
+	Offer(item interface{}) (added bool, err error)
+
+	// OfferWithTimeout inserts the item at the tail of the queue, waiting up to the timeout
+	// for space to become available.
+	// It returns true if the item was added.
+	OfferWithTimeout(item interface{}, timeout time.Duration) (added bool, err error)
+
+	// Take retrieves and removes the head of the queue, waiting until an item becomes available.
+	Take() (item interface{}, err error)
+
+	// Poll retrieves and removes the head of the queue.
+	// It returns nil if the queue is empty.
+	Poll() (item interface{}, err error)
+
+	// PollWithTimeout retrieves and removes the head of the queue, waiting up to the timeout
+	// for an item to become available.
+	// It returns nil if the timeout expires.
+	PollWithTimeout(timeout time.Duration) (item interface{}, err error)
+
+	// Peek retrieves the head of the queue without removing it.
+	// It returns nil if the queue is empty.
+	Peek() (item interface{}, err error)
+
+	// PeekWithTimeout retrieves the head of the queue without removing it, waiting up to the timeout
+	// for an item to become available.
+	// It returns nil if the timeout expires.
+	PeekWithTimeout(timeout time.Duration) (item interface{}, err error)
+
+	// Size returns the number of items in the queue.
+	Size() (size int32, err error)
+}
+
+// TransactionalList is the transactional counterpart of List.
+type TransactionalList interface {
+	// DistributedObject is the base interface for all distributed objects.
+	DistributedObject
+
+	// Add appends the item to the list.
+	// It returns true if the list changed.
+	Add(item interface{}) (changed bool, err error)
+
+	// Remove removes the first occurrence of the item from the list.
+	// It returns true if the list contained the item.
+	Remove(item interface{}) (removed bool, err error)
+
+	// Size returns the number of items in the list.
+	Size() (size int32, err error)
+}
+
+// TransactionalSet is the transactional counterpart of Set.
+type TransactionalSet interface {
+	// DistributedObject is the base interface for all distributed objects.
+	DistributedObject
+
+	// Add adds the item to the set if it is not already present.
+	// It returns true if the set changed.
+	Add(item interface{}) (added bool, err error)
+
+	// Remove removes the item from the set.
+	// It returns true if the set contained the item.
+	Remove(item interface{}) (removed bool, err error)
+
+	// Size returns the number of items in the set.
+	Size() (size int32, err error)
+}
+
+// TransactionalMultiMap is the transactional counterpart of MultiMap.
+type TransactionalMultiMap interface {
+	// DistributedObject is the base interface for all distributed objects.
+	DistributedObject
+
+	// Put stores a key-value pair in the multi-map.
+	// It returns true if the size of the multi-map increased.
+	Put(key interface{}, value interface{}) (increased bool, err error)
+
+	// Get returns a slice of the values associated with the key.
+	Get(key interface{}) (values []interface{}, err error)
+
+	// Remove removes the association of the value with the key.
+	// It returns true if the value was detached from the key.
+	Remove(key interface{}, value interface{}) (removed bool, err error)
+
+	// RemoveAll detaches all values from the key.
+	// It returns a slice of the values that were associated with the key.
+	RemoveAll(key interface{}) (oldValues []interface{}, err error)
+
+	// ValueCount returns the number of values associated with the key.
+	ValueCount(key interface{}) (valueCount int32, err error)
+
+	// Size returns the total number of values in the multi-map.
+	Size() (size int32, err error)
+}
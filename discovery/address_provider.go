@@ -0,0 +1,41 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package discovery finds the addresses of the members that the client connects to.
+package discovery
+
+// AddressProvider provides the candidate addresses of the cluster members.
+// It is called every time the client tries to connect to the cluster, both on start and on reconnect,
+// so that an implementation can follow the members when they move.
+//
+// The addresses are in the same format as config.NetworkConfig.Addresses: "ip:port", or "ip" in which
+// case ports 5701 to 5703 are tried. IPv6 addresses with a port are in brackets, e.g. "[::1]:5701".
+// The addresses that are not IP addresses, such as host names, are ignored with a warning.
+type AddressProvider interface {
+	// LoadAddresses returns the current candidate addresses.
+	LoadAddresses() ([]string, error)
+}
+
+type staticAddressProvider struct {
+	addresses []string
+}
+
+// NewStaticAddressProvider returns an AddressProvider which always returns the given addresses.
+func NewStaticAddressProvider(addresses ...string) AddressProvider {
+	return &staticAddressProvider{addresses: addresses}
+}
+
+func (p *staticAddressProvider) LoadAddresses() ([]string, error) {
+	return p.addresses, nil
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"time"
+)
+
+// LookupTimeout is the timeout of each DNS lookup of the DNS address providers.
+const LookupTimeout = 5 * time.Second
+
+// Resolver looks up DNS records. *net.Resolver implements it.
+// It can be replaced with a fake in tests.
+type Resolver interface {
+	// LookupHost returns the addresses of the A and AAAA records of the given host.
+	LookupHost(ctx context.Context, host string) (addrs []string, err error)
+
+	// LookupSRV returns the SRV records of the given service.
+	LookupSRV(ctx context.Context, service, proto, name string) (cname string, addrs []*net.SRV, err error)
+}
+
+type dnsAddressProvider struct {
+	host     string
+	port     int
+	resolver Resolver
+}
+
+// NewDNSAddressProvider returns an AddressProvider which resolves the given host name to the addresses
+// of its A and AAAA records, e.g. a headless service in Kubernetes. All of the addresses use the given port.
+// If the resolver is nil, net.DefaultResolver is used.
+func NewDNSAddressProvider(host string, port int, resolver Resolver) AddressProvider {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	return &dnsAddressProvider{host: host, port: port, resolver: resolver}
+}
+
+func (p *dnsAddressProvider) LoadAddresses() ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), LookupTimeout)
+	defer cancel()
+	ips, err := p.resolver.LookupHost(ctx, p.host)
+	if err != nil {
+		return nil, err
+	}
+	addresses := make([]string, len(ips))
+	for i, ip := range ips {
+		addresses[i] = net.JoinHostPort(ip, strconv.Itoa(p.port))
+	}
+	return addresses, nil
+}
+
+type dnsSRVAddressProvider struct {
+	service  string
+	proto    string
+	name     string
+	resolver Resolver
+}
+
+// NewDNSSRVAddressProvider returns an AddressProvider which looks up the SRV records of the given service,
+// e.g. NewDNSSRVAddressProvider("hazelcast", "tcp", "example.com", nil) looks up _hazelcast._tcp.example.com.
+// The targets of the records are resolved to their addresses and combined with the ports of the records.
+// If service and proto are empty, name is looked up directly.
+// If the resolver is nil, net.DefaultResolver is used.
+func NewDNSSRVAddressProvider(service string, proto string, name string, resolver Resolver) AddressProvider {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	return &dnsSRVAddressProvider{service: service, proto: proto, name: name, resolver: resolver}
+}
+
+func (p *dnsSRVAddressProvider) LoadAddresses() ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), LookupTimeout)
+	defer cancel()
+	_, records, err := p.resolver.LookupSRV(ctx, p.service, p.proto, p.name)
+	if err != nil {
+		return nil, err
+	}
+	var addresses []string
+	for _, record := range records {
+		ips, err := p.resolver.LookupHost(ctx, record.Target)
+		if err != nil {
+			return nil, err
+		}
+		for _, ip := range ips {
+			addresses = append(addresses, net.JoinHostPort(ip, strconv.Itoa(int(record.Port))))
+		}
+	}
+	return addresses, nil
+}
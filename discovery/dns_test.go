@@ -0,0 +1,87 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"context"
+	"errors"
+	"net"
+	"reflect"
+	"testing"
+)
+
+type fakeResolver struct {
+	hosts   map[string][]string
+	records map[string][]*net.SRV
+}
+
+func (r *fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if ips, found := r.hosts[host]; found {
+		return ips, nil
+	}
+	return nil, errors.New("no such host " + host)
+}
+
+func (r *fakeResolver) LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	cname := "_" + service + "._" + proto + "." + name
+	if records, found := r.records[cname]; found {
+		return cname, records, nil
+	}
+	return "", nil, errors.New("no such service " + cname)
+}
+
+func TestDNSAddressProvider(t *testing.T) {
+	resolver := &fakeResolver{hosts: map[string][]string{
+		"hazelcast.default.svc": {"10.0.0.1", "10.0.0.2"},
+	}}
+	addresses, err := NewDNSAddressProvider("hazelcast.default.svc", 5701, resolver).LoadAddresses()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"10.0.0.1:5701", "10.0.0.2:5701"}
+	if !reflect.DeepEqual(addresses, expected) {
+		t.Fatalf("expected %v, got %v", expected, addresses)
+	}
+}
+
+func TestDNSAddressProviderLookupError(t *testing.T) {
+	_, err := NewDNSAddressProvider("unknown", 5701, &fakeResolver{}).LoadAddresses()
+	if err == nil {
+		t.Fatal("expected the lookup error")
+	}
+}
+
+func TestDNSSRVAddressProvider(t *testing.T) {
+	resolver := &fakeResolver{
+		hosts: map[string][]string{
+			"member1.example.com.": {"10.0.0.1"},
+			"member2.example.com.": {"10.0.0.2"},
+		},
+		records: map[string][]*net.SRV{
+			"_hazelcast._tcp.example.com": {
+				{Target: "member1.example.com.", Port: 5701},
+				{Target: "member2.example.com.", Port: 5702},
+			},
+		},
+	}
+	addresses, err := NewDNSSRVAddressProvider("hazelcast", "tcp", "example.com", resolver).LoadAddresses()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"10.0.0.1:5701", "10.0.0.2:5702"}
+	if !reflect.DeepEqual(addresses, expected) {
+		t.Fatalf("expected %v, got %v", expected, addresses)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+type fileAddressProvider struct {
+	path      string
+	mu        sync.Mutex // guards modTime and addresses
+	modTime   time.Time
+	addresses []string
+}
+
+// NewFileAddressProvider returns an AddressProvider which reads the addresses from the given file,
+// e.g. a file that is updated by a service registry agent.
+// The file contains an IP address per line, see AddressProvider for the format.
+// Empty lines and lines starting with '#' are ignored.
+// The file is read again whenever its modification time changes, so the changes are picked up on
+// the next connection attempt.
+func NewFileAddressProvider(path string) AddressProvider {
+	return &fileAddressProvider{path: path}
+}
+
+func (p *fileAddressProvider) LoadAddresses() ([]string, error) {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.addresses != nil && info.ModTime().Equal(p.modTime) {
+		return p.addresses, nil
+	}
+	addresses, err := readAddresses(p.path)
+	if err != nil {
+		return nil, err
+	}
+	p.addresses = addresses
+	p.modTime = info.ModTime()
+	return addresses, nil
+}
+
+func readAddresses(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	addresses := make([]string, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		addresses = append(addresses, line)
+	}
+	return addresses, scanner.Err()
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFileAddressProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "discovery")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "members")
+	if err := ioutil.WriteFile(path, []byte("# members\n10.0.0.1:5701\n\n  10.0.0.2  \n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	provider := NewFileAddressProvider(path)
+	addresses, err := provider.LoadAddresses()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"10.0.0.1:5701", "10.0.0.2"}; !reflect.DeepEqual(addresses, expected) {
+		t.Fatalf("expected %v, got %v", expected, addresses)
+	}
+
+	if err := ioutil.WriteFile(path, []byte("10.0.0.3:5701\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// The modification time might have a coarse resolution.
+	modTime := time.Now().Add(time.Second)
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+	addresses, err = provider.LoadAddresses()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"10.0.0.3:5701"}; !reflect.DeepEqual(addresses, expected) {
+		t.Fatalf("expected the changed addresses %v, got %v", expected, addresses)
+	}
+}
+
+func TestFileAddressProviderMissingFile(t *testing.T) {
+	_, err := NewFileAddressProvider(filepath.Join(os.TempDir(), "missing-discovery-file")).LoadAddresses()
+	if err == nil {
+		t.Fatal("expected an error for a missing file")
+	}
+}
+
+func TestStaticAddressProvider(t *testing.T) {
+	addresses, _ := NewStaticAddressProvider("10.0.0.1:5701").LoadAddresses()
+	if !reflect.DeepEqual(addresses, []string{"10.0.0.1:5701"}) {
+		t.Fatalf("unexpected addresses %v", addresses)
+	}
+}
@@ -63,6 +63,12 @@ type Instance interface {
 	// GetTopic returns the distributed topic instance with the specified name.
 	GetTopic(name string) (core.Topic, error)
 
+	// GetReliableTopic returns the reliable topic instance with the specified name.
+	// A reliable topic stores its messages in a ringbuffer, so that the listeners do not miss the messages
+	// while they are slow or the client is reconnecting. See core.ReliableMessageListener and
+	// config.ReliableTopicConfig.
+	GetReliableTopic(name string) (core.Topic, error)
+
 	// GetMultiMap returns the distributed multi-map instance with the specified name.
 	GetMultiMap(name string) (core.MultiMap, error)
 
@@ -78,6 +84,28 @@ type Instance interface {
 	// GetPNCounter returns the distributed PN (Positive-Negative) CRDT counter instance with the specified name.
 	GetPNCounter(name string) (core.PNCounter, error)
 
+	// GetAtomicLong returns the distributed atomic long instance with the specified name.
+	GetAtomicLong(name string) (core.AtomicLong, error)
+
+	// GetAtomicReference returns the distributed atomic reference instance with the specified name.
+	GetAtomicReference(name string) (core.AtomicReference, error)
+
+	// GetCountDownLatch returns the distributed count down latch instance with the specified name.
+	GetCountDownLatch(name string) (core.CountDownLatch, error)
+
+	// GetSemaphore returns the distributed semaphore instance with the specified name.
+	GetSemaphore(name string) (core.Semaphore, error)
+
+	// GetExecutorService returns the distributed executor service instance with the specified name.
+	GetExecutorService(name string) (core.ExecutorService, error)
+
+	// GetScheduledExecutorService returns the distributed scheduled executor service instance with the specified name.
+	GetScheduledExecutorService(name string) (core.ScheduledExecutorService, error)
+
+	// GetLock returns the distributed lock instance with the specified name.
+	// See core.WithLockOwner for acquiring the lock on behalf of different owners.
+	GetLock(name string) (core.Lock, error)
+
 	// GetFlakeIDGenerator returns the distributed flakeIDGenerator instance with the specified name.
 	GetFlakeIDGenerator(name string) (core.FlakeIDGenerator, error)
 
@@ -96,4 +124,15 @@ type Instance interface {
 	// GetLifecycle returns the lifecycle service for this instance. Lifecycle service allows you
 	// to listen for the lifecycle events.
 	GetLifecycle() core.Lifecycle
+
+	// Statistics returns a snapshot of the statistics of this instance, such as the traffic of the connections
+	// and the latencies of the invocations.
+	// The statistics are collected even if they are not sent to the cluster.
+	// See property.StatisticsEnabled for sending them to the cluster.
+	Statistics() core.Statistics
+
+	// NewTransactionContext returns a new TransactionContext with the given options.
+	// If options is nil, core.NewTransactionOptions() is used.
+	// The transaction must be started with Begin before the transactional proxies are used.
+	NewTransactionContext(options *core.TransactionOptions) core.TransactionContext
 }
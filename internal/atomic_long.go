@@ -0,0 +1,132 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+type atomicLongProxy struct {
+	*partitionSpecificProxy
+}
+
+func newAtomicLongProxy(client *HazelcastClient, serviceName string, name string) (*atomicLongProxy, error) {
+	parSpecProxy, err := newPartitionSpecificProxy(client, serviceName, name)
+	if err != nil {
+		return nil, err
+	}
+	return &atomicLongProxy{parSpecProxy}, nil
+}
+
+func (alp *atomicLongProxy) Get() (value int64, err error) {
+	request := proto.AtomicLongGetEncodeRequest(alp.name)
+	responseMessage, err := alp.invoke(request)
+	return alp.decodeToInt64AndError(responseMessage, err, proto.AtomicLongGetDecodeResponse)
+}
+
+func (alp *atomicLongProxy) Set(newValue int64) (err error) {
+	request := proto.AtomicLongSetEncodeRequest(alp.name, newValue)
+	_, err = alp.invoke(request)
+	return
+}
+
+func (alp *atomicLongProxy) GetAndSet(newValue int64) (oldValue int64, err error) {
+	request := proto.AtomicLongGetAndSetEncodeRequest(alp.name, newValue)
+	responseMessage, err := alp.invoke(request)
+	return alp.decodeToInt64AndError(responseMessage, err, proto.AtomicLongGetAndSetDecodeResponse)
+}
+
+func (alp *atomicLongProxy) CompareAndSet(expected int64, updated int64) (set bool, err error) {
+	request := proto.AtomicLongCompareAndSetEncodeRequest(alp.name, expected, updated)
+	responseMessage, err := alp.invoke(request)
+	return alp.decodeToBoolAndError(responseMessage, err, proto.AtomicLongCompareAndSetDecodeResponse)
+}
+
+func (alp *atomicLongProxy) AddAndGet(delta int64) (updatedValue int64, err error) {
+	request := proto.AtomicLongAddAndGetEncodeRequest(alp.name, delta)
+	responseMessage, err := alp.invoke(request)
+	return alp.decodeToInt64AndError(responseMessage, err, proto.AtomicLongAddAndGetDecodeResponse)
+}
+
+func (alp *atomicLongProxy) GetAndAdd(delta int64) (oldValue int64, err error) {
+	request := proto.AtomicLongGetAndAddEncodeRequest(alp.name, delta)
+	responseMessage, err := alp.invoke(request)
+	return alp.decodeToInt64AndError(responseMessage, err, proto.AtomicLongGetAndAddDecodeResponse)
+}
+
+func (alp *atomicLongProxy) IncrementAndGet() (updatedValue int64, err error) {
+	request := proto.AtomicLongIncrementAndGetEncodeRequest(alp.name)
+	responseMessage, err := alp.invoke(request)
+	return alp.decodeToInt64AndError(responseMessage, err, proto.AtomicLongIncrementAndGetDecodeResponse)
+}
+
+func (alp *atomicLongProxy) DecrementAndGet() (updatedValue int64, err error) {
+	request := proto.AtomicLongDecrementAndGetEncodeRequest(alp.name)
+	responseMessage, err := alp.invoke(request)
+	return alp.decodeToInt64AndError(responseMessage, err, proto.AtomicLongDecrementAndGetDecodeResponse)
+}
+
+func (alp *atomicLongProxy) GetAndIncrement() (oldValue int64, err error) {
+	request := proto.AtomicLongGetAndIncrementEncodeRequest(alp.name)
+	responseMessage, err := alp.invoke(request)
+	return alp.decodeToInt64AndError(responseMessage, err, proto.AtomicLongGetAndIncrementDecodeResponse)
+}
+
+func (alp *atomicLongProxy) Alter(function interface{}) (err error) {
+	functionData, err := alp.validateAndSerialize(function)
+	if err != nil {
+		return err
+	}
+	request := proto.AtomicLongAlterEncodeRequest(alp.name, functionData)
+	_, err = alp.invoke(request)
+	return
+}
+
+func (alp *atomicLongProxy) AlterAndGet(function interface{}) (updatedValue int64, err error) {
+	functionData, err := alp.validateAndSerialize(function)
+	if err != nil {
+		return 0, err
+	}
+	request := proto.AtomicLongAlterAndGetEncodeRequest(alp.name, functionData)
+	responseMessage, err := alp.invoke(request)
+	return alp.decodeToInt64AndError(responseMessage, err, proto.AtomicLongAlterAndGetDecodeResponse)
+}
+
+func (alp *atomicLongProxy) GetAndAlter(function interface{}) (oldValue int64, err error) {
+	functionData, err := alp.validateAndSerialize(function)
+	if err != nil {
+		return 0, err
+	}
+	request := proto.AtomicLongGetAndAlterEncodeRequest(alp.name, functionData)
+	responseMessage, err := alp.invoke(request)
+	return alp.decodeToInt64AndError(responseMessage, err, proto.AtomicLongGetAndAlterDecodeResponse)
+}
+
+func (alp *atomicLongProxy) Apply(function interface{}) (result interface{}, err error) {
+	functionData, err := alp.validateAndSerialize(function)
+	if err != nil {
+		return nil, err
+	}
+	request := proto.AtomicLongApplyEncodeRequest(alp.name, functionData)
+	responseMessage, err := alp.invoke(request)
+	return alp.decodeToObjectAndError(responseMessage, err, proto.AtomicLongApplyDecodeResponse)
+}
+
+func (alp *atomicLongProxy) WithContext(ctx context.Context) core.AtomicLong {
+	return &atomicLongProxy{alp.partitionSpecificProxy.withContext(ctx)}
+}
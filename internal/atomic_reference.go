@@ -0,0 +1,150 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+type atomicReferenceProxy struct {
+	*partitionSpecificProxy
+}
+
+func newAtomicReferenceProxy(client *HazelcastClient, serviceName string, name string) (*atomicReferenceProxy, error) {
+	parSpecProxy, err := newPartitionSpecificProxy(client, serviceName, name)
+	if err != nil {
+		return nil, err
+	}
+	return &atomicReferenceProxy{parSpecProxy}, nil
+}
+
+func (arp *atomicReferenceProxy) Get() (value interface{}, err error) {
+	request := proto.AtomicReferenceGetEncodeRequest(arp.name)
+	responseMessage, err := arp.invoke(request)
+	return arp.decodeToObjectAndError(responseMessage, err, proto.AtomicReferenceGetDecodeResponse)
+}
+
+func (arp *atomicReferenceProxy) Set(newValue interface{}) (err error) {
+	newValueData, err := arp.toNullableData(newValue)
+	if err != nil {
+		return err
+	}
+	request := proto.AtomicReferenceSetEncodeRequest(arp.name, newValueData)
+	_, err = arp.invoke(request)
+	return
+}
+
+func (arp *atomicReferenceProxy) GetAndSet(newValue interface{}) (oldValue interface{}, err error) {
+	newValueData, err := arp.toNullableData(newValue)
+	if err != nil {
+		return nil, err
+	}
+	request := proto.AtomicReferenceGetAndSetEncodeRequest(arp.name, newValueData)
+	responseMessage, err := arp.invoke(request)
+	return arp.decodeToObjectAndError(responseMessage, err, proto.AtomicReferenceGetAndSetDecodeResponse)
+}
+
+func (arp *atomicReferenceProxy) SetAndGet(newValue interface{}) (value interface{}, err error) {
+	newValueData, err := arp.toNullableData(newValue)
+	if err != nil {
+		return nil, err
+	}
+	request := proto.AtomicReferenceSetAndGetEncodeRequest(arp.name, newValueData)
+	responseMessage, err := arp.invoke(request)
+	return arp.decodeToObjectAndError(responseMessage, err, proto.AtomicReferenceSetAndGetDecodeResponse)
+}
+
+func (arp *atomicReferenceProxy) CompareAndSet(expected interface{}, updated interface{}) (set bool, err error) {
+	expectedData, err := arp.toNullableData(expected)
+	if err != nil {
+		return false, err
+	}
+	updatedData, err := arp.toNullableData(updated)
+	if err != nil {
+		return false, err
+	}
+	request := proto.AtomicReferenceCompareAndSetEncodeRequest(arp.name, expectedData, updatedData)
+	responseMessage, err := arp.invoke(request)
+	return arp.decodeToBoolAndError(responseMessage, err, proto.AtomicReferenceCompareAndSetDecodeResponse)
+}
+
+func (arp *atomicReferenceProxy) Contains(expected interface{}) (found bool, err error) {
+	expectedData, err := arp.toNullableData(expected)
+	if err != nil {
+		return false, err
+	}
+	request := proto.AtomicReferenceContainsEncodeRequest(arp.name, expectedData)
+	responseMessage, err := arp.invoke(request)
+	return arp.decodeToBoolAndError(responseMessage, err, proto.AtomicReferenceContainsDecodeResponse)
+}
+
+func (arp *atomicReferenceProxy) IsNil() (isNil bool, err error) {
+	request := proto.AtomicReferenceIsNullEncodeRequest(arp.name)
+	responseMessage, err := arp.invoke(request)
+	return arp.decodeToBoolAndError(responseMessage, err, proto.AtomicReferenceIsNullDecodeResponse)
+}
+
+func (arp *atomicReferenceProxy) Clear() (err error) {
+	request := proto.AtomicReferenceClearEncodeRequest(arp.name)
+	_, err = arp.invoke(request)
+	return
+}
+
+func (arp *atomicReferenceProxy) Alter(function interface{}) (err error) {
+	functionData, err := arp.validateAndSerialize(function)
+	if err != nil {
+		return err
+	}
+	request := proto.AtomicReferenceAlterEncodeRequest(arp.name, functionData)
+	_, err = arp.invoke(request)
+	return
+}
+
+func (arp *atomicReferenceProxy) AlterAndGet(function interface{}) (updatedValue interface{}, err error) {
+	functionData, err := arp.validateAndSerialize(function)
+	if err != nil {
+		return nil, err
+	}
+	request := proto.AtomicReferenceAlterAndGetEncodeRequest(arp.name, functionData)
+	responseMessage, err := arp.invoke(request)
+	return arp.decodeToObjectAndError(responseMessage, err, proto.AtomicReferenceAlterAndGetDecodeResponse)
+}
+
+func (arp *atomicReferenceProxy) GetAndAlter(function interface{}) (oldValue interface{}, err error) {
+	functionData, err := arp.validateAndSerialize(function)
+	if err != nil {
+		return nil, err
+	}
+	request := proto.AtomicReferenceGetAndAlterEncodeRequest(arp.name, functionData)
+	responseMessage, err := arp.invoke(request)
+	return arp.decodeToObjectAndError(responseMessage, err, proto.AtomicReferenceGetAndAlterDecodeResponse)
+}
+
+func (arp *atomicReferenceProxy) Apply(function interface{}) (result interface{}, err error) {
+	functionData, err := arp.validateAndSerialize(function)
+	if err != nil {
+		return nil, err
+	}
+	request := proto.AtomicReferenceApplyEncodeRequest(arp.name, functionData)
+	responseMessage, err := arp.invoke(request)
+	return arp.decodeToObjectAndError(responseMessage, err, proto.AtomicReferenceApplyDecodeResponse)
+}
+
+func (arp *atomicReferenceProxy) WithContext(ctx context.Context) core.AtomicReference {
+	return &atomicReferenceProxy{arp.partitionSpecificProxy.withContext(ctx)}
+}
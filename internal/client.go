@@ -20,6 +20,7 @@ import (
 	"github.com/hazelcast/hazelcast-go-client/core"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
 	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/logger"
 )
 
 type HazelcastClient struct {
@@ -32,15 +33,24 @@ type HazelcastClient struct {
 	ListenerService      *listenerService
 	ClusterService       *clusterService
 	ProxyManager         *proxyManager
-	LoadBalancer         *randomLoadBalancer
+	LoadBalancer         core.LoadBalancer
 	HeartBeatService     *heartBeatService
+	NearCacheManager     *nearCacheManager
+	QueryCacheManager    *queryCacheManager
+	StatisticsService    *statisticsService
+	EventExecutor        *eventExecutor
 	properties           *property.HazelcastProperties
+	logger               logger.Logger
 }
 
 func NewHazelcastClient(config *config.Config) (*HazelcastClient, error) {
 	client := HazelcastClient{ClientConfig: config}
 	client.properties = property.NewHazelcastProperties(config.Properties())
-	err := client.init()
+	err := client.initLogger()
+	if err != nil {
+		return nil, err
+	}
+	err = client.init()
 	return &client, err
 }
 
@@ -100,6 +110,14 @@ func (c *HazelcastClient) GetTopic(name string) (core.Topic, error) {
 	return topic.(core.Topic), nil
 }
 
+func (c *HazelcastClient) GetReliableTopic(name string) (core.Topic, error) {
+	topic, err := c.GetDistributedObject(bufutil.ServiceNameReliableTopic, name)
+	if err != nil {
+		return nil, err
+	}
+	return topic.(core.Topic), nil
+}
+
 func (c *HazelcastClient) GetQueue(name string) (core.Queue, error) {
 	queue, err := c.GetDistributedObject(bufutil.ServiceNameQueue, name)
 	if err != nil {
@@ -124,6 +142,62 @@ func (c *HazelcastClient) GetPNCounter(name string) (core.PNCounter, error) {
 	return counter.(core.PNCounter), nil
 }
 
+func (c *HazelcastClient) GetAtomicLong(name string) (core.AtomicLong, error) {
+	atomicLong, err := c.GetDistributedObject(bufutil.ServiceNameAtomicLong, name)
+	if err != nil {
+		return nil, err
+	}
+	return atomicLong.(core.AtomicLong), nil
+}
+
+func (c *HazelcastClient) GetAtomicReference(name string) (core.AtomicReference, error) {
+	atomicReference, err := c.GetDistributedObject(bufutil.ServiceNameAtomicReference, name)
+	if err != nil {
+		return nil, err
+	}
+	return atomicReference.(core.AtomicReference), nil
+}
+
+func (c *HazelcastClient) GetCountDownLatch(name string) (core.CountDownLatch, error) {
+	latch, err := c.GetDistributedObject(bufutil.ServiceNameCountDownLatch, name)
+	if err != nil {
+		return nil, err
+	}
+	return latch.(core.CountDownLatch), nil
+}
+
+func (c *HazelcastClient) GetSemaphore(name string) (core.Semaphore, error) {
+	semaphore, err := c.GetDistributedObject(bufutil.ServiceNameSemaphore, name)
+	if err != nil {
+		return nil, err
+	}
+	return semaphore.(core.Semaphore), nil
+}
+
+func (c *HazelcastClient) GetExecutorService(name string) (core.ExecutorService, error) {
+	executor, err := c.GetDistributedObject(bufutil.ServiceNameExecutor, name)
+	if err != nil {
+		return nil, err
+	}
+	return executor.(core.ExecutorService), nil
+}
+
+func (c *HazelcastClient) GetScheduledExecutorService(name string) (core.ScheduledExecutorService, error) {
+	scheduler, err := c.GetDistributedObject(bufutil.ServiceNameScheduledExecutor, name)
+	if err != nil {
+		return nil, err
+	}
+	return scheduler.(core.ScheduledExecutorService), nil
+}
+
+func (c *HazelcastClient) GetLock(name string) (core.Lock, error) {
+	lock, err := c.GetDistributedObject(bufutil.ServiceNameLock, name)
+	if err != nil {
+		return nil, err
+	}
+	return lock.(core.Lock), nil
+}
+
 func (c *HazelcastClient) GetDistributedObject(serviceName string, name string) (core.DistributedObject, error) {
 	var clientProxy, err = c.ProxyManager.getOrCreateProxy(serviceName, name)
 	if err != nil {
@@ -140,8 +214,31 @@ func (c *HazelcastClient) GetLifecycle() core.Lifecycle {
 	return c.LifecycleService
 }
 
+func (c *HazelcastClient) Statistics() core.Statistics {
+	return c.StatisticsService.snapshot()
+}
+
+func (c *HazelcastClient) NewTransactionContext(options *core.TransactionOptions) core.TransactionContext {
+	return newTransactionContext(c, options)
+}
+
+func (c *HazelcastClient) initLogger() error {
+	c.logger = c.ClientConfig.Logger()
+	if c.logger != nil {
+		return nil
+	}
+	defaultLogger, err := logger.NewWithLevel(c.properties.GetString(property.LoggingLevel))
+	if err != nil {
+		return core.NewHazelcastIllegalArgumentError("invalid logging level", err)
+	}
+	c.logger = defaultLogger
+	return nil
+}
+
 func (c *HazelcastClient) init() error {
-	c.LifecycleService = newLifecycleService(c.ClientConfig)
+	c.LifecycleService = newLifecycleService(c)
+	c.StatisticsService = newStatisticsService(c)
+	c.EventExecutor = newEventExecutor(c)
 	c.ConnectionManager = newConnectionManager(c)
 	c.HeartBeatService = newHeartBeatService(c)
 	c.InvocationService = newInvocationService(c)
@@ -149,7 +246,9 @@ func (c *HazelcastClient) init() error {
 	c.ListenerService = newListenerService(c)
 	c.PartitionService = newPartitionService(c)
 	c.ProxyManager = newProxyManager(c)
-	c.LoadBalancer = newRandomLoadBalancer(c.ClusterService)
+	c.NearCacheManager = newNearCacheManager(c)
+	c.QueryCacheManager = newQueryCacheManager(c)
+	c.LoadBalancer = newLoadBalancer(c.ClientConfig.LoadBalancer(), c.ClusterService)
 	var err error
 	c.SerializationService, err = serialization.NewSerializationService(c.ClientConfig.SerializationConfig())
 	if err != nil {
@@ -161,6 +260,7 @@ func (c *HazelcastClient) init() error {
 	}
 	c.HeartBeatService.start()
 	c.PartitionService.start()
+	c.StatisticsService.start()
 	c.LifecycleService.fireLifecycleEvent(LifecycleStateStarted)
 	return nil
 }
@@ -174,6 +274,9 @@ func (c *HazelcastClient) Shutdown() {
 		c.InvocationService.shutdown()
 		c.HeartBeatService.shutdown()
 		c.ListenerService.shutdown()
+		c.EventExecutor.shutdown()
+		c.NearCacheManager.shutdown()
+		c.StatisticsService.shutdown()
 		c.LifecycleService.fireLifecycleEvent(LifecycleStateShutdown)
 	}
 }
@@ -16,7 +16,6 @@ package internal
 
 import (
 	"fmt"
-	"log"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -119,8 +118,10 @@ func (cs *clusterService) reconnect() {
 	err := cs.connectToCluster()
 	if err != nil {
 		cs.client.Shutdown()
-		log.Println("Client will shutdown since it could not reconnect.")
+		cs.client.logger.Error("Client will shutdown since it could not reconnect.")
+		return
 	}
+	cs.client.StatisticsService.incrementReconnects()
 
 }
 
@@ -132,14 +133,14 @@ func (cs *clusterService) connectToCluster() error {
 	for currentAttempt < attempLimit {
 		currentAttempt++
 		members := cs.members.Load().([]*proto.Member)
-		addresses := getPossibleAddresses(cs.config.NetworkConfig().Addresses(), members)
+		addresses := getPossibleAddresses(cs.loadAddresses(), members)
 		for _, address := range addresses {
 			if !cs.client.LifecycleService.isLive.Load().(bool) {
 				return core.NewHazelcastIllegalStateError("giving up on retrying to connect to cluster since client is shutdown.", nil)
 			}
 			err := cs.connectToAddress(&address)
 			if err != nil {
-				log.Println("The following error occurred while trying to connect to cluster. attempt ",
+				cs.client.logger.Warn("The following error occurred while trying to connect to cluster. attempt ",
 					currentAttempt, " of ", attempLimit, " error: ", err)
 				if _, ok := err.(*core.HazelcastAuthenticationError); ok {
 					return err
@@ -155,6 +156,26 @@ func (cs *clusterService) connectToCluster() error {
 	return core.NewHazelcastIllegalStateError("could not connect to any addresses", nil)
 }
 
+// loadAddresses returns the candidate addresses from the AddressProvider, or the addresses in the config
+// if there is not an AddressProvider.
+func (cs *clusterService) loadAddresses() []string {
+	addressProvider := cs.config.NetworkConfig().AddressProvider()
+	if addressProvider == nil {
+		return cs.config.NetworkConfig().Addresses()
+	}
+	addresses, err := addressProvider.LoadAddresses()
+	if err != nil {
+		cs.client.logger.Warn("Could not load the addresses from the address provider: ", err)
+		return nil
+	}
+	for _, address := range addresses {
+		if ip, _ := iputil.GetIPAndPort(address); !iputil.IsValidIPAddress(ip) {
+			cs.client.logger.Warn("Ignoring the address ", address, " from the address provider since it is not an IP address")
+		}
+	}
+	return addresses
+}
+
 func (cs *clusterService) connectToAddress(address *proto.Address) error {
 	connection, err := cs.client.ConnectionManager.getOrConnect(address, true)
 	if err != nil {
@@ -177,6 +198,7 @@ func (cs *clusterService) initMembershipListener(connection *Connection) error {
 	}
 	invocation := newInvocation(request, -1, nil, connection, cs.client)
 	invocation.eventHandler = eventHandler
+	invocation.internalEvent = true
 	response, err := cs.client.InvocationService.sendInvocation(invocation).Result()
 	if err != nil {
 		return err
@@ -184,7 +206,7 @@ func (cs *clusterService) initMembershipListener(connection *Connection) error {
 	registrationID := proto.ClientAddMembershipListenerDecodeResponse(response)()
 	wg.Wait() // Wait until the initial member list is fetched.
 	cs.logMembers()
-	log.Println("Registered membership listener with ID ", registrationID)
+	cs.client.logger.Info("Registered membership listener with ID ", registrationID)
 	return nil
 }
 
@@ -197,7 +219,7 @@ func (cs *clusterService) logMembers() {
 		membersInfo += memberInfo
 	}
 	membersInfo += "]\n"
-	log.Println(membersInfo)
+	cs.client.logger.Info(membersInfo)
 }
 
 func (cs *clusterService) AddListener(listener interface{}) string {
@@ -15,8 +15,13 @@
 package internal
 
 import (
+	"context"
+	"errors"
+	"net"
 	"testing"
 
+	"github.com/hazelcast/hazelcast-go-client/config"
+	"github.com/hazelcast/hazelcast-go-client/discovery"
 	"github.com/hazelcast/hazelcast-go-client/internal/iputil"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
 )
@@ -68,3 +73,73 @@ func Test_getPossibleAddressesWithEmptyParamters(t *testing.T) {
 	}
 
 }
+
+type fakeResolver struct {
+	hosts map[string][]string
+}
+
+func (r *fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if ips, found := r.hosts[host]; found {
+		return ips, nil
+	}
+	return nil, errors.New("no such host " + host)
+}
+
+func (r *fakeResolver) LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	return "", nil, errors.New("no such service")
+}
+
+type recordingLogger struct {
+	warnings []interface{}
+	errors   []interface{}
+}
+
+func (l *recordingLogger) Trace(args ...interface{}) {}
+func (l *recordingLogger) Debug(args ...interface{}) {}
+func (l *recordingLogger) Info(args ...interface{})  {}
+
+func (l *recordingLogger) Warn(args ...interface{}) {
+	l.warnings = append(l.warnings, args)
+}
+
+func (l *recordingLogger) Error(args ...interface{}) {
+	l.errors = append(l.errors, args)
+}
+
+func Test_getPossibleAddressesFromDNSProvider(t *testing.T) {
+	resolver := &fakeResolver{hosts: map[string][]string{"hazelcast": {"10.0.0.1", "::1"}}}
+	cfg := config.New()
+	cfg.NetworkConfig().SetAddressProvider(discovery.NewDNSAddressProvider("hazelcast", 5701, resolver))
+	logger := &recordingLogger{}
+	cs := &clusterService{client: &HazelcastClient{logger: logger}, config: cfg}
+	addresses := getPossibleAddresses(cs.loadAddresses(), nil)
+	expected := map[proto.Address]struct{}{
+		*proto.NewAddressWithParameters("10.0.0.1", 5701): {},
+		*proto.NewAddressWithParameters("::1", 5701):      {},
+	}
+	if len(addresses) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, addresses)
+	}
+	for _, address := range addresses {
+		if _, found := expected[address]; !found {
+			t.Fatalf("unexpected address %v", address)
+		}
+	}
+	if len(logger.warnings) != 0 {
+		t.Errorf("unexpected warnings: %v", logger.warnings)
+	}
+	if address := proto.NewAddressWithParameters("::1", 5701).String(); address != "[::1]:5701" {
+		t.Errorf("expected [::1]:5701, got %s", address)
+	}
+}
+
+func Test_loadAddressesLogsHostNames(t *testing.T) {
+	cfg := config.New()
+	cfg.NetworkConfig().SetAddressProvider(discovery.NewStaticAddressProvider("member1.example.com:5701", "10.0.0.1"))
+	logger := &recordingLogger{}
+	cs := &clusterService{client: &HazelcastClient{logger: logger}, config: cfg}
+	getPossibleAddresses(cs.loadAddresses(), nil)
+	if len(logger.warnings) != 1 {
+		t.Errorf("expected a warning for the host name, got %v", logger.warnings)
+	}
+}
@@ -15,12 +15,14 @@
 package internal
 
 import (
+	"crypto/tls"
 	"encoding/binary"
 	"fmt"
 	"net"
 	"sync/atomic"
 	"time"
 
+	"github.com/hazelcast/hazelcast-go-client/config"
 	"github.com/hazelcast/hazelcast-go-client/core"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
@@ -29,6 +31,10 @@ import (
 const BufferSize = 8192 * 2
 
 type Connection struct {
+	bytesSent              int64
+	bytesReceived          int64
+	messagesSent           int64
+	messagesReceived       int64
 	pending                chan *proto.ClientMessage
 	received               chan *proto.ClientMessage
 	socket                 net.Conn
@@ -47,10 +53,11 @@ type Connection struct {
 	readBuffer             []byte
 	connectionID           int64
 	connectionManager      connectionManager
+	startTime              time.Time
 }
 
 func newConnection(address core.Address, handleResponse func(interface{}),
-	connectionID int64, connectionManager connectionManager) *Connection {
+	connectionID int64, connectionManager connectionManager, networkConfig *config.NetworkConfig) (*Connection, error) {
 	builder := &clientMessageBuilder{handleResponse: handleResponse,
 		incompleteMessages: make(map[int64]*proto.ClientMessage)}
 	connection := Connection{pending: make(chan *proto.ClientMessage, 1),
@@ -62,11 +69,12 @@ func newConnection(address core.Address, handleResponse func(interface{}),
 		connectionID:         connectionID,
 		connectionManager:    connectionManager,
 	}
-	socket, err := net.Dial("tcp", address.String())
+	socket, err := dial(address, networkConfig)
 	if err != nil {
-		return nil
+		return nil, err
 	}
 	connection.socket = socket
+	connection.startTime = time.Now()
 	connection.lastRead.Store(time.Now())
 	connection.lastWrite.Store(time.Time{})              //initialization
 	connection.lastHeartbeatReceived.Store(time.Time{})  //initialization
@@ -75,7 +83,29 @@ func newConnection(address core.Address, handleResponse func(interface{}),
 	socket.Write([]byte("CB2"))
 	go connection.writePool()
 	go connection.read()
-	return &connection
+	return &connection, nil
+}
+
+func dial(address core.Address, networkConfig *config.NetworkConfig) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: networkConfig.ConnectionTimeout()}
+	socket, err := dialer.Dial("tcp", address.String())
+	if err != nil {
+		return nil, err
+	}
+	sslConfig := networkConfig.SSLConfig()
+	if sslConfig == nil || !sslConfig.Enabled() {
+		return socket, nil
+	}
+	tlsSocket := tls.Client(socket, sslConfig.TLSConfig(address.Host()))
+	if timeout := networkConfig.ConnectionTimeout(); timeout > 0 {
+		tlsSocket.SetDeadline(time.Now().Add(timeout))
+	}
+	if err = tlsSocket.Handshake(); err != nil {
+		socket.Close()
+		return nil, err
+	}
+	tlsSocket.SetDeadline(time.Time{})
+	return tlsSocket, nil
 }
 
 func (c *Connection) isAlive() bool {
@@ -116,20 +146,21 @@ func (c *Connection) write(clientMessage *proto.ClientMessage) error {
 	writeIndex := 0
 	for remainingLen > 0 {
 		writtenLen, err := c.socket.Write(clientMessage.Buffer[writeIndex:])
+		atomic.AddInt64(&c.bytesSent, int64(writtenLen))
 		if err != nil {
 			return err
 		}
 		remainingLen -= writtenLen
 		writeIndex += writtenLen
 	}
-
+	atomic.AddInt64(&c.messagesSent, 1)
 	return nil
 }
 
 func (c *Connection) read() {
 	buf := make([]byte, BufferSize)
 	for {
-		c.socket.SetDeadline(time.Now().Add(2 * time.Second))
+		c.socket.SetReadDeadline(time.Now().Add(2 * time.Second))
 		n, err := c.socket.Read(buf)
 
 		select {
@@ -152,6 +183,7 @@ func (c *Connection) read() {
 		if n == 0 {
 			continue
 		}
+		atomic.AddInt64(&c.bytesReceived, int64(n))
 		c.readBuffer = append(c.readBuffer, buf[:n]...)
 		c.receiveMessage()
 	}
@@ -166,6 +198,7 @@ func (c *Connection) receiveMessage() {
 		}
 		resp := proto.NewClientMessage(c.readBuffer[:frameLength], 0)
 		c.readBuffer = c.readBuffer[frameLength:]
+		atomic.AddInt64(&c.messagesReceived, 1)
 		c.clientMessageBuilder.onMessage(resp)
 	}
 }
@@ -179,6 +212,21 @@ func (c *Connection) close(err error) {
 	c.connectionManager.onConnectionClose(c, err)
 }
 
+func (c *Connection) statistics() core.ConnectionStatistics {
+	stats := core.ConnectionStatistics{
+		ConnectionID:     c.connectionID,
+		Owner:            c.isOwnerConnection,
+		BytesSent:        atomic.LoadInt64(&c.bytesSent),
+		BytesReceived:    atomic.LoadInt64(&c.bytesReceived),
+		MessagesSent:     atomic.LoadInt64(&c.messagesSent),
+		MessagesReceived: atomic.LoadInt64(&c.messagesReceived),
+	}
+	if address, ok := c.endpoint.Load().(core.Address); ok {
+		stats.Address = address.String()
+	}
+	return stats
+}
+
 func (c *Connection) String() string {
 	return fmt.Sprintf("ClientConnection{"+
 		"isAlive=%t"+
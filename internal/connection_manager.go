@@ -20,6 +20,7 @@ import (
 
 	"github.com/hazelcast/hazelcast-go-client/core"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/security"
 )
 
 const (
@@ -196,7 +197,7 @@ func (cm *connectionManagerImpl) getOrCreateConnectionInternal(address core.Addr
 	if conn.isOwnerConnection {
 		return conn, nil
 	}
-	err := cm.authenticate(conn, asOwner)
+	err := cm.authenticate(conn, address, asOwner)
 
 	if err == nil {
 		return conn, nil
@@ -209,13 +210,34 @@ func (cm *connectionManagerImpl) NextConnectionID() int64 {
 	return cm.nextConnectionID
 }
 
-func (cm *connectionManagerImpl) encodeAuthenticationRequest(asOwner bool) *proto.ClientMessage {
+func (cm *connectionManagerImpl) encodeAuthenticationRequest(address core.Address,
+	asOwner bool) (*proto.ClientMessage, error) {
 	uuid := cm.client.ClusterService.uuid.Load().(string)
 	ownerUUID := cm.client.ClusterService.ownerUUID.Load().(string)
 	clientType := proto.ClientType
+	clientVersion := "ALPHA" //TODO This should be replace with a build time version variable, BuildInfo etc.
+	if credentialsFactory := cm.client.ClientConfig.CredentialsFactory(); credentialsFactory != nil {
+		credentials, err := credentialsFactory.NewCredentials(address)
+		if err != nil {
+			return nil, core.NewHazelcastAuthenticationError("could not create the credentials", err)
+		}
+		credentialsData, err := cm.client.SerializationService.ToData(credentials)
+		if err != nil {
+			return nil, core.NewHazelcastAuthenticationError("could not serialize the credentials", err)
+		}
+		request := proto.ClientAuthenticationCustomEncodeRequest(
+			credentialsData,
+			uuid,
+			ownerUUID,
+			asOwner,
+			clientType,
+			1,
+			clientVersion,
+		)
+		return request, nil
+	}
 	name := cm.client.ClientConfig.GroupConfig().Name()
 	password := cm.client.ClientConfig.GroupConfig().Password()
-	clientVersion := "ALPHA" //TODO This should be replace with a build time version variable, BuildInfo etc.
 	request := proto.ClientAuthenticationEncodeRequest(
 		name,
 		password,
@@ -226,18 +248,27 @@ func (cm *connectionManagerImpl) encodeAuthenticationRequest(asOwner bool) *prot
 		1,
 		clientVersion,
 	)
-	return request
+	return request, nil
 }
 
-func (cm *connectionManagerImpl) authenticate(connection *Connection, asOwner bool) error {
-	request := cm.encodeAuthenticationRequest(asOwner)
+func (cm *connectionManagerImpl) authenticate(connection *Connection, target core.Address, asOwner bool) error {
+	request, err := cm.encodeAuthenticationRequest(target, asOwner)
+	if err != nil {
+		return err
+	}
 	invocationResult := cm.client.InvocationService.invokeOnConnection(request, connection)
 	result, err := invocationResult.ResultWithTimeout(cm.client.HeartBeatService.heartBeatTimeout)
-	if err != nil {
+	if authenticationError, ok := err.(*core.HazelcastAuthenticationError); ok {
+		return core.NewHazelcastAuthenticationError(authenticationError.Error(), security.ErrInvalidCredentials)
+	} else if err != nil {
 		return err
 	}
+	decodeResponse := proto.ClientAuthenticationDecodeResponse
+	if cm.client.ClientConfig.CredentialsFactory() != nil {
+		decodeResponse = proto.ClientAuthenticationCustomDecodeResponse
+	}
 	//status, address, uuid, ownerUUID, serializationVersion, serverHazelcastVersion , clientUnregisteredMembers
-	status, address, uuid, ownerUUID, _, serverHazelcastVersion, _ := proto.ClientAuthenticationDecodeResponse(result)()
+	status, address, uuid, ownerUUID, _, serverHazelcastVersion, _ := decodeResponse(result)()
 	switch status {
 	case authenticated:
 		connection.serverHazelcastVersion = serverHazelcastVersion
@@ -251,7 +282,7 @@ func (cm *connectionManagerImpl) authenticate(connection *Connection, asOwner bo
 			cm.client.ClusterService.uuid.Store(uuid)
 		}
 	case credentialsFailed:
-		return core.NewHazelcastAuthenticationError("invalid credentials!", nil)
+		return core.NewHazelcastAuthenticationError("invalid credentials!", security.ErrInvalidCredentials)
 	case serializationVersionMismatch:
 		return core.NewHazelcastAuthenticationError("serialization version mismatches with the server!", nil)
 	}
@@ -274,11 +305,12 @@ func (cm *connectionManagerImpl) createConnection(address core.Address, asOwner
 	}
 	invocationService := cm.client.InvocationService.(*invocationServiceImpl)
 	connectionID := cm.NextConnectionID()
-	con := newConnection(address, invocationService.handleResponse, connectionID, cm)
-	if con == nil {
-		return nil, core.NewHazelcastTargetDisconnectedError("target is disconnected", nil)
+	con, err := newConnection(address, invocationService.handleResponse, connectionID, cm,
+		cm.client.ClientConfig.NetworkConfig())
+	if err != nil {
+		return nil, core.NewHazelcastTargetDisconnectedError("target is disconnected", err)
 	}
-	err := cm.authenticate(con, asOwner)
+	err = cm.authenticate(con, address, asOwner)
 
 	if err != nil {
 		return nil, err
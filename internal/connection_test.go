@@ -0,0 +1,193 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License")
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/config"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+type closeRecordingConnectionManager struct {
+	connectionManager
+	closed chan error
+}
+
+func (cm *closeRecordingConnectionManager) onConnectionClose(connection *Connection, cause error) {
+	cm.closed <- cause
+}
+
+func newCloseRecordingConnectionManager() *closeRecordingConnectionManager {
+	return &closeRecordingConnectionManager{closed: make(chan error, 1)}
+}
+
+func newTestCertificate(t *testing.T, isCA bool, parent *x509.Certificate,
+	parentKey *ecdsa.PrivateKey) (tls.Certificate, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: "hazelcast-test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: certificate}, certificate
+}
+
+func startTLSServer(t *testing.T, serverConfig *tls.Config) (net.Listener, chan []byte) {
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	received := make(chan []byte, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		buf := make([]byte, 3)
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			close(received)
+			return
+		}
+		received <- buf
+	}()
+	return listener, received
+}
+
+func listenerAddress(t *testing.T, listener net.Listener) core.Address {
+	host, portStr, _ := net.SplitHostPort(listener.Addr().String())
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return proto.NewAddressWithParameters(host, int32(port))
+}
+
+func TestNewConnectionWithTLS(t *testing.T) {
+	serverCert, caCert := newTestCertificate(t, true, nil, nil)
+	listener, received := startTLSServer(t, &tls.Config{Certificates: []tls.Certificate{serverCert}})
+	defer listener.Close()
+
+	caPool := x509.NewCertPool()
+	caPool.AddCert(caCert)
+	networkConfig := config.NewNetworkConfig()
+	networkConfig.SSLConfig().SetEnabled(true)
+	networkConfig.SSLConfig().SetCAPool(caPool)
+
+	cm := newCloseRecordingConnectionManager()
+	connection, err := newConnection(listenerAddress(t, listener), func(interface{}) {}, 1, cm, networkConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer connection.close(nil)
+	if _, ok := connection.socket.(*tls.Conn); !ok {
+		t.Fatal("expected a TLS connection")
+	}
+	select {
+	case protocol := <-received:
+		if string(protocol) != "CB2" {
+			t.Fatalf("expected protocol header CB2, got %s", protocol)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("server did not receive the protocol header")
+	}
+}
+
+func TestNewConnectionWithMutualTLS(t *testing.T) {
+	caTLSCert, caCert := newTestCertificate(t, true, nil, nil)
+	caKey := caTLSCert.PrivateKey.(*ecdsa.PrivateKey)
+	serverCert, _ := newTestCertificate(t, false, caCert, caKey)
+	clientCert, _ := newTestCertificate(t, false, caCert, caKey)
+	caPool := x509.NewCertPool()
+	caPool.AddCert(caCert)
+	listener, received := startTLSServer(t, &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientCAs:    caPool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	})
+	defer listener.Close()
+
+	networkConfig := config.NewNetworkConfig()
+	sslConfig := networkConfig.SSLConfig()
+	sslConfig.SetEnabled(true)
+	sslConfig.SetCAPool(caPool)
+	sslConfig.AddCertificate(clientCert)
+	sslConfig.SetServerName("127.0.0.1")
+
+	cm := newCloseRecordingConnectionManager()
+	connection, err := newConnection(listenerAddress(t, listener), func(interface{}) {}, 1, cm, networkConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer connection.close(nil)
+	select {
+	case protocol, ok := <-received:
+		if !ok || string(protocol) != "CB2" {
+			t.Fatal("server did not accept the client certificate")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("server did not receive the protocol header")
+	}
+}
+
+func TestNewConnectionWithUntrustedServerCertificate(t *testing.T) {
+	serverCert, _ := newTestCertificate(t, true, nil, nil)
+	listener, _ := startTLSServer(t, &tls.Config{Certificates: []tls.Certificate{serverCert}})
+	defer listener.Close()
+
+	networkConfig := config.NewNetworkConfig()
+	networkConfig.SSLConfig().SetEnabled(true)
+	networkConfig.SSLConfig().SetCAPool(x509.NewCertPool())
+
+	cm := newCloseRecordingConnectionManager()
+	connection, err := newConnection(listenerAddress(t, listener), func(interface{}) {}, 1, cm, networkConfig)
+	if err == nil {
+		connection.close(nil)
+		t.Fatal("expected the handshake to fail with an untrusted server certificate")
+	}
+}
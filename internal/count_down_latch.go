@@ -0,0 +1,68 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/timeutil"
+)
+
+type countDownLatchProxy struct {
+	*partitionSpecificProxy
+}
+
+func newCountDownLatchProxy(client *HazelcastClient, serviceName string, name string) (*countDownLatchProxy, error) {
+	parSpecProxy, err := newPartitionSpecificProxy(client, serviceName, name)
+	if err != nil {
+		return nil, err
+	}
+	return &countDownLatchProxy{parSpecProxy}, nil
+}
+
+func (cdlp *countDownLatchProxy) TrySetCount(count int32) (set bool, err error) {
+	if count < 0 {
+		return false, core.NewHazelcastIllegalArgumentError("count cannot be negative", nil)
+	}
+	request := proto.CountDownLatchTrySetCountEncodeRequest(cdlp.name, count)
+	responseMessage, err := cdlp.invoke(request)
+	return cdlp.decodeToBoolAndError(responseMessage, err, proto.CountDownLatchTrySetCountDecodeResponse)
+}
+
+func (cdlp *countDownLatchProxy) CountDown() (err error) {
+	request := proto.CountDownLatchCountDownEncodeRequest(cdlp.name)
+	_, err = cdlp.invoke(request)
+	return
+}
+
+func (cdlp *countDownLatchProxy) GetCount() (count int32, err error) {
+	request := proto.CountDownLatchGetCountEncodeRequest(cdlp.name)
+	responseMessage, err := cdlp.invoke(request)
+	return cdlp.decodeToInt32AndError(responseMessage, err, proto.CountDownLatchGetCountDecodeResponse)
+}
+
+func (cdlp *countDownLatchProxy) Await(timeout time.Duration) (completed bool, err error) {
+	timeoutInMillis := timeutil.GetTimeInMilliSeconds(timeout)
+	request := proto.CountDownLatchAwaitEncodeRequest(cdlp.name, timeoutInMillis)
+	responseMessage, err := cdlp.invokeBlocking(request, timeout)
+	return cdlp.decodeToBoolAndError(responseMessage, err, proto.CountDownLatchAwaitDecodeResponse)
+}
+
+func (cdlp *countDownLatchProxy) WithContext(ctx context.Context) core.CountDownLatch {
+	return &countDownLatchProxy{cdlp.partitionSpecificProxy.withContext(ctx)}
+}
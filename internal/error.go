@@ -44,6 +44,16 @@ func createHazelcastError(err *proto.Error) core.HazelcastError {
 		return core.NewHazelcastUnsupportedOperationError(message, nil)
 	case bufutil.ErrorCodeConsistencyLostException:
 		return core.NewHazelcastConsistencyLostError(message, nil)
+	case bufutil.ErrorCodeCancellation:
+		return core.NewHazelcastCancellationError(message, nil)
+	case bufutil.ErrorCodeIllegalMonitorState:
+		return core.NewHazelcastIllegalMonitorStateError(message, nil)
+	case bufutil.ErrorCodeTransaction, bufutil.ErrorCodeTransactionNotActive, bufutil.ErrorCodeTransactionTimedOut:
+		return core.NewHazelcastTransactionError(message, nil)
+	case bufutil.ErrorCodeStaleSequence:
+		return core.NewHazelcastStaleSequenceError(message, nil)
+	case bufutil.ErrorCodeTopicOverload:
+		return core.NewHazelcastTopicOverloadError(message, nil)
 	}
 
 	return core.NewHazelcastErrorType(message, nil)
@@ -0,0 +1,174 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/config/property"
+)
+
+const (
+	overflowPolicyBlock      = "block"
+	overflowPolicyDropOldest = "drop_oldest"
+	overflowPolicyError      = "error"
+
+	// droppedEventsLogInterval is the minimum interval between the logs of the dropped events.
+	droppedEventsLogInterval = 10 * time.Second
+)
+
+// eventExecutor runs the event handlers of the listeners on a fixed number of goroutines, so that a slow
+// listener does not block the goroutine which reads the responses of the invocations.
+// Events are striped by partition ID, so the events of the same partition are handled in the order they are received.
+// Events without a partition are striped by the correlation ID of their listener registration.
+// The events of the client's own listeners, e.g. the membership and the Near Cache invalidation listeners,
+// are handled on a separate stripe which never drops events, since the client cannot recover from a lost one.
+type eventExecutor struct {
+	dropped        int64
+	lastDropLog    int64 // the time of the last log of the dropped events in nanoseconds
+	client         *HazelcastClient
+	stripes        []chan func()
+	internalStripe chan func()
+	overflowPolicy string
+	closed         chan struct{}
+}
+
+func newEventExecutor(client *HazelcastClient) *eventExecutor {
+	threadCount := client.properties.GetPositiveInt(property.EventThreadCount)
+	capacity := client.properties.GetPositiveInt(property.EventQueueCapacity) / threadCount
+	if capacity == 0 {
+		capacity = 1
+	}
+	overflowPolicy := client.properties.GetString(property.EventQueueOverflowPolicy)
+	switch overflowPolicy {
+	case overflowPolicyBlock, overflowPolicyDropOldest, overflowPolicyError:
+	default:
+		client.logger.Warn("Unknown event queue overflow policy ", overflowPolicy, ", ",
+			property.EventQueueOverflowPolicy.DefaultValue(), " is used instead")
+		overflowPolicy = property.EventQueueOverflowPolicy.DefaultValue()
+	}
+	executor := &eventExecutor{
+		client:         client,
+		stripes:        make([]chan func(), threadCount),
+		internalStripe: make(chan func(), capacity),
+		overflowPolicy: overflowPolicy,
+		closed:         make(chan struct{}),
+	}
+	for i := range executor.stripes {
+		executor.stripes[i] = make(chan func(), capacity)
+		go executor.process(executor.stripes[i])
+	}
+	go executor.process(executor.internalStripe)
+	return executor
+}
+
+// dispatch queues the event handler on the stripe of the given key according to the overflow policy.
+func (ee *eventExecutor) dispatch(key int64, handler func()) {
+	if key < 0 {
+		key = -key
+	}
+	stripe := ee.stripes[key%int64(len(ee.stripes))]
+	select {
+	case stripe <- handler:
+		return
+	default:
+	}
+	switch ee.overflowPolicy {
+	case overflowPolicyBlock:
+		select {
+		case stripe <- handler:
+		case <-ee.closed:
+		}
+	case overflowPolicyDropOldest:
+		for {
+			select {
+			case stripe <- handler:
+				return
+			case <-ee.closed:
+				return
+			default:
+			}
+			select {
+			case <-stripe:
+				atomic.AddInt64(&ee.dropped, 1)
+			default:
+			}
+		}
+	default:
+		atomic.AddInt64(&ee.dropped, 1)
+		ee.logDroppedEvents()
+	}
+}
+
+// dispatchInternal queues the event handler of an internal listener. The overflow policy is not applied,
+// it waits until there is room in the queue instead.
+func (ee *eventExecutor) dispatchInternal(handler func()) {
+	select {
+	case ee.internalStripe <- handler:
+	case <-ee.closed:
+	}
+}
+
+// logDroppedEvents logs the first dropped event, and then the number of dropped events at most once
+// per droppedEventsLogInterval.
+func (ee *eventExecutor) logDroppedEvents() {
+	now := time.Now().UnixNano()
+	last := atomic.LoadInt64(&ee.lastDropLog)
+	if last != 0 && now-last < int64(droppedEventsLogInterval) {
+		return
+	}
+	if !atomic.CompareAndSwapInt64(&ee.lastDropLog, last, now) {
+		return
+	}
+	ee.client.logger.Error("Event queue is full, the new events are dropped. Dropped event count: ",
+		atomic.LoadInt64(&ee.dropped))
+}
+
+func (ee *eventExecutor) process(stripe chan func()) {
+	for {
+		select {
+		case handler := <-stripe:
+			ee.run(handler)
+		case <-ee.closed:
+			return
+		}
+	}
+}
+
+func (ee *eventExecutor) run(handler func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			ee.client.logger.Error("Event listener panicked: ", r)
+		}
+	}()
+	handler()
+}
+
+func (ee *eventExecutor) droppedEventCount() int64 {
+	return atomic.LoadInt64(&ee.dropped)
+}
+
+func (ee *eventExecutor) pendingEventCount() int64 {
+	pending := len(ee.internalStripe)
+	for _, stripe := range ee.stripes {
+		pending += len(stripe)
+	}
+	return int64(pending)
+}
+
+func (ee *eventExecutor) shutdown() {
+	close(ee.closed)
+}
@@ -0,0 +1,248 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/config"
+	"github.com/hazelcast/hazelcast-go-client/config/property"
+	"github.com/hazelcast/hazelcast-go-client/logger"
+)
+
+func newTestEventExecutor(properties config.Properties) *eventExecutor {
+	offLogger, _ := logger.NewWithLevel(logger.OffLevel)
+	client := &HazelcastClient{
+		properties: property.NewHazelcastProperties(properties),
+		logger:     offLogger,
+	}
+	return newEventExecutor(client)
+}
+
+func TestEventExecutorKeepsOrderPerKey(t *testing.T) {
+	executor := newTestEventExecutor(config.Properties{property.EventThreadCount.Name(): "4"})
+	defer executor.shutdown()
+	const eventCount = 1000
+	var mu sync.Mutex
+	received := make(map[int64][]int)
+	var wg sync.WaitGroup
+	wg.Add(2 * eventCount)
+	for i := 0; i < eventCount; i++ {
+		for _, key := range []int64{1, 2} {
+			key, i := key, i
+			executor.dispatch(key, func() {
+				mu.Lock()
+				received[key] = append(received[key], i)
+				mu.Unlock()
+				wg.Done()
+			})
+		}
+	}
+	wg.Wait()
+	for key, events := range received {
+		for i, event := range events {
+			if event != i {
+				t.Fatalf("events of key %d are out of order: %v", key, events)
+			}
+		}
+	}
+}
+
+func TestEventExecutorDoesNotBlockOtherStripes(t *testing.T) {
+	executor := newTestEventExecutor(config.Properties{property.EventThreadCount.Name(): "2"})
+	defer executor.shutdown()
+	release := make(chan struct{})
+	defer close(release)
+	executor.dispatch(0, func() {
+		<-release
+	})
+	done := make(chan struct{})
+	executor.dispatch(1, func() {
+		close(done)
+	})
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("a slow listener should not block the events of the other stripes")
+	}
+}
+
+func blockStripe(executor *eventExecutor) (release chan struct{}) {
+	release = make(chan struct{})
+	started := make(chan struct{})
+	executor.dispatch(0, func() {
+		close(started)
+		<-release
+	})
+	<-started
+	return release
+}
+
+func TestEventExecutorOverflowPolicyError(t *testing.T) {
+	executor := newTestEventExecutor(config.Properties{
+		property.EventThreadCount.Name():   "1",
+		property.EventQueueCapacity.Name(): "2",
+	})
+	defer executor.shutdown()
+	release := blockStripe(executor)
+	ran := make(chan int, 3)
+	for i := 0; i < 3; i++ {
+		i := i
+		executor.dispatch(0, func() {
+			ran <- i
+		})
+	}
+	if dropped := executor.droppedEventCount(); dropped != 1 {
+		t.Fatalf("expected 1 dropped event, got %d", dropped)
+	}
+	if pending := executor.pendingEventCount(); pending != 2 {
+		t.Fatalf("expected 2 pending events, got %d", pending)
+	}
+	close(release)
+	if first, second := receiveEvent(t, ran), receiveEvent(t, ran); first != 0 || second != 1 {
+		t.Fatalf("expected the new event to be dropped, ran %d and %d", first, second)
+	}
+}
+
+func TestEventExecutorLogsDroppedEventsOnce(t *testing.T) {
+	executor := newTestEventExecutor(config.Properties{
+		property.EventThreadCount.Name():   "1",
+		property.EventQueueCapacity.Name(): "1",
+	})
+	defer executor.shutdown()
+	logger := &recordingLogger{}
+	executor.client.logger = logger
+	release := blockStripe(executor)
+	defer close(release)
+	for i := 0; i < 100; i++ {
+		executor.dispatch(0, func() {})
+	}
+	if dropped := executor.droppedEventCount(); dropped != 99 {
+		t.Fatalf("expected 99 dropped events, got %d", dropped)
+	}
+	if len(logger.errors) != 1 {
+		t.Errorf("expected only the first dropped event to be logged, got %v", logger.errors)
+	}
+}
+
+func TestEventExecutorOverflowPolicyDropOldest(t *testing.T) {
+	executor := newTestEventExecutor(config.Properties{
+		property.EventThreadCount.Name():         "1",
+		property.EventQueueCapacity.Name():       "2",
+		property.EventQueueOverflowPolicy.Name(): "drop_oldest",
+	})
+	defer executor.shutdown()
+	release := blockStripe(executor)
+	ran := make(chan int, 3)
+	for i := 0; i < 3; i++ {
+		i := i
+		executor.dispatch(0, func() {
+			ran <- i
+		})
+	}
+	if dropped := executor.droppedEventCount(); dropped != 1 {
+		t.Fatalf("expected 1 dropped event, got %d", dropped)
+	}
+	close(release)
+	if first, second := receiveEvent(t, ran), receiveEvent(t, ran); first != 1 || second != 2 {
+		t.Fatalf("expected the oldest event to be dropped, ran %d and %d", first, second)
+	}
+}
+
+func TestEventExecutorOverflowPolicyBlock(t *testing.T) {
+	executor := newTestEventExecutor(config.Properties{
+		property.EventThreadCount.Name():         "1",
+		property.EventQueueCapacity.Name():       "1",
+		property.EventQueueOverflowPolicy.Name(): "block",
+	})
+	defer executor.shutdown()
+	release := blockStripe(executor)
+	executor.dispatch(0, func() {})
+	dispatched := make(chan struct{})
+	go func() {
+		executor.dispatch(0, func() {})
+		close(dispatched)
+	}()
+	select {
+	case <-dispatched:
+		t.Fatal("dispatch should block while the queue is full")
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(release)
+	select {
+	case <-dispatched:
+	case <-time.After(5 * time.Second):
+		t.Fatal("dispatch should continue when there is room in the queue")
+	}
+	if dropped := executor.droppedEventCount(); dropped != 0 {
+		t.Fatalf("expected no dropped events, got %d", dropped)
+	}
+}
+
+func TestEventExecutorRecoversListenerPanic(t *testing.T) {
+	executor := newTestEventExecutor(nil)
+	defer executor.shutdown()
+	executor.dispatch(0, func() {
+		panic("listener failed")
+	})
+	done := make(chan struct{})
+	executor.dispatch(0, func() {
+		close(done)
+	})
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the events after a panicking listener should be handled")
+	}
+}
+
+func receiveEvent(t *testing.T, ran chan int) int {
+	select {
+	case i := <-ran:
+		return i
+	case <-time.After(5 * time.Second):
+		t.Fatal("pending events are not handled")
+		return -1
+	}
+}
+
+func TestEventExecutorDoesNotDropInternalEvents(t *testing.T) {
+	executor := newTestEventExecutor(config.Properties{
+		property.EventThreadCount.Name():   "1",
+		property.EventQueueCapacity.Name(): "1",
+	})
+	defer executor.shutdown()
+	release := blockStripe(executor)
+	defer close(release)
+	executor.dispatch(0, func() {})
+	const eventCount = 10
+	ran := make(chan int, eventCount)
+	for i := 0; i < eventCount; i++ {
+		i := i
+		executor.dispatchInternal(func() {
+			ran <- i
+		})
+	}
+	for i := 0; i < eventCount; i++ {
+		if event := receiveEvent(t, ran); event != i {
+			t.Fatalf("expected internal event %d, got %d", i, event)
+		}
+	}
+	if dropped := executor.droppedEventCount(); dropped != 0 {
+		t.Fatalf("expected no dropped events, got %d", dropped)
+	}
+}
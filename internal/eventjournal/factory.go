@@ -0,0 +1,42 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventjournal
+
+import "github.com/hazelcast/hazelcast-go-client/serialization"
+
+// MapFactoryID is the factory ID of the map events that are read from the event journal of a map.
+const MapFactoryID = -10
+
+// The class IDs of the map events in the data serializer hook of the map service on the members.
+const (
+	deserializingMapEventClassID = 141
+	internalMapEventClassID      = 142
+)
+
+type MapFactory struct {
+}
+
+func NewMapFactory() *MapFactory {
+	return &MapFactory{}
+}
+
+func (*MapFactory) Create(id int32) serialization.IdentifiedDataSerializable {
+	switch id {
+	case internalMapEventClassID, deserializingMapEventClassID:
+		return &MapEvent{classID: id}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventjournal
+
+import "github.com/hazelcast/hazelcast-go-client/serialization"
+
+// MapEvent is a map event in the form that it is stored in the event journal of a map.
+// The key and the values are kept in serialized form.
+type MapEvent struct {
+	classID   int32
+	eventType int32
+	key       serialization.Data
+	newValue  serialization.Data
+	oldValue  serialization.Data
+}
+
+// EventType returns the type of the event, see core.EntryEvent.
+func (e *MapEvent) EventType() int32 {
+	return e.eventType
+}
+
+// Key returns the serialized key of the entry.
+func (e *MapEvent) Key() serialization.Data {
+	return e.key
+}
+
+// NewValue returns the serialized value of the entry after the event, or nil if the entry is removed.
+func (e *MapEvent) NewValue() serialization.Data {
+	return e.newValue
+}
+
+// OldValue returns the serialized value of the entry before the event, or nil if the entry is added.
+func (e *MapEvent) OldValue() serialization.Data {
+	return e.oldValue
+}
+
+func (*MapEvent) FactoryID() (factoryID int32) {
+	return MapFactoryID
+}
+
+func (e *MapEvent) ClassID() (classID int32) {
+	if e.classID == 0 {
+		return internalMapEventClassID
+	}
+	return e.classID
+}
+
+func (e *MapEvent) WriteData(output serialization.DataOutput) (err error) {
+	output.WriteInt32(e.eventType)
+	output.WriteData(e.key)
+	output.WriteData(e.newValue)
+	output.WriteData(e.oldValue)
+	return
+}
+
+func (e *MapEvent) ReadData(input serialization.DataInput) (err error) {
+	if e.eventType, err = input.ReadInt32(); err != nil {
+		return
+	}
+	if e.key, err = input.ReadData(); err != nil {
+		return
+	}
+	if e.newValue, err = input.ReadData(); err != nil {
+		return
+	}
+	e.oldValue, err = input.ReadData()
+	return
+}
@@ -0,0 +1,266 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/iputil"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+type executorServiceProxy struct {
+	*proxy
+}
+
+func newExecutorServiceProxy(client *HazelcastClient, serviceName string, name string) (*executorServiceProxy, error) {
+	return &executorServiceProxy{newProxy(client, serviceName, name)}, nil
+}
+
+func (esp *executorServiceProxy) Submit(task interface{}) core.CancellableFuture {
+	taskData, err := esp.validateAndSerialize(task)
+	if err != nil {
+		return newCompletedExecutionFuture(err)
+	}
+	var partitionID int32
+	if partitionCount := esp.client.PartitionService.getPartitionCount(); partitionCount > 0 {
+		partitionID = rand.Int31n(partitionCount)
+	}
+	return esp.submitToPartition(taskData, partitionID, func(request *proto.ClientMessage) invocationResult {
+		return esp.client.InvocationService.invokeOnPartitionOwner(request, partitionID)
+	})
+}
+
+func (esp *executorServiceProxy) SubmitToMember(task interface{}, member core.Member) core.CancellableFuture {
+	if member == nil {
+		return newCompletedExecutionFuture(core.NewHazelcastNilPointerError("member cannot be nil", nil))
+	}
+	taskData, err := esp.validateAndSerialize(task)
+	if err != nil {
+		return newCompletedExecutionFuture(err)
+	}
+	return esp.submitToAddress(taskData, member.Address().(*proto.Address))
+}
+
+func (esp *executorServiceProxy) SubmitToKeyOwner(task interface{}, key interface{}) core.CancellableFuture {
+	taskData, keyData, err := esp.validateAndSerialize2(task, key)
+	if err != nil {
+		return newCompletedExecutionFuture(err)
+	}
+	partitionID := esp.client.PartitionService.GetPartitionID(keyData)
+	return esp.submitToPartition(taskData, partitionID, func(request *proto.ClientMessage) invocationResult {
+		return esp.invokeOnKeyOwner(request, keyData)
+	})
+}
+
+func (esp *executorServiceProxy) SubmitToMembers(task interface{},
+	selector core.MemberSelector) (futures map[core.Member]core.CancellableFuture, err error) {
+	if selector == nil {
+		return nil, core.NewHazelcastNilPointerError("member selector cannot be nil", nil)
+	}
+	return esp.submitToMembers(task, esp.client.ClusterService.GetMembersWithSelector(selector))
+}
+
+func (esp *executorServiceProxy) SubmitToAllMembers(task interface{}) (
+	futures map[core.Member]core.CancellableFuture, err error) {
+	return esp.submitToMembers(task, esp.client.ClusterService.GetMembers())
+}
+
+func (esp *executorServiceProxy) Shutdown() (err error) {
+	request := proto.ExecutorServiceShutdownEncodeRequest(esp.name)
+	_, err = esp.invokeOnRandomTarget(request)
+	return
+}
+
+func (esp *executorServiceProxy) IsShutdown() (isShutdown bool, err error) {
+	request := proto.ExecutorServiceIsShutdownEncodeRequest(esp.name)
+	responseMessage, err := esp.invokeOnRandomTarget(request)
+	return esp.decodeToBoolAndError(responseMessage, err, proto.ExecutorServiceIsShutdownDecodeResponse)
+}
+
+func (esp *executorServiceProxy) submitToMembers(task interface{},
+	members []core.Member) (map[core.Member]core.CancellableFuture, error) {
+	taskData, err := esp.validateAndSerialize(task)
+	if err != nil {
+		return nil, err
+	}
+	if len(members) == 0 {
+		return nil, core.NewHazelcastIllegalStateError("no member is selected to execute the task", nil)
+	}
+	futures := make(map[core.Member]core.CancellableFuture, len(members))
+	for _, member := range members {
+		futures[member] = esp.submitToAddress(taskData, member.Address().(*proto.Address))
+	}
+	return futures, nil
+}
+
+func (esp *executorServiceProxy) submitToPartition(taskData *serialization.Data, partitionID int32,
+	invoke func(request *proto.ClientMessage) invocationResult) core.CancellableFuture {
+	uuid, err := iputil.NewUUID()
+	if err != nil {
+		return newCompletedExecutionFuture(err)
+	}
+	request := proto.ExecutorServiceSubmitToPartitionEncodeRequest(esp.name, uuid, taskData, partitionID)
+	return esp.newExecutionFuture(invoke(request), esp.objectDecoder(proto.ExecutorServiceSubmitToPartitionDecodeResponse),
+		func(mayInterrupt bool) (bool, error) {
+			request := proto.ExecutorServiceCancelOnPartitionEncodeRequest(uuid, partitionID, mayInterrupt)
+			responseMessage, err := esp.invokeOnPartition(request, partitionID)
+			return esp.decodeToBoolAndError(responseMessage, err, proto.ExecutorServiceCancelOnPartitionDecodeResponse)
+		})
+}
+
+func (esp *executorServiceProxy) submitToAddress(taskData *serialization.Data,
+	address *proto.Address) core.CancellableFuture {
+	uuid, err := iputil.NewUUID()
+	if err != nil {
+		return newCompletedExecutionFuture(err)
+	}
+	request := proto.ExecutorServiceSubmitToAddressEncodeRequest(esp.name, uuid, taskData, address)
+	invocation := esp.client.InvocationService.invokeOnTarget(request, address)
+	return esp.newExecutionFuture(invocation, esp.objectDecoder(proto.ExecutorServiceSubmitToAddressDecodeResponse),
+		func(mayInterrupt bool) (bool, error) {
+			request := proto.ExecutorServiceCancelOnAddressEncodeRequest(uuid, address, mayInterrupt)
+			responseMessage, err := esp.invokeOnAddress(request, address)
+			return esp.decodeToBoolAndError(responseMessage, err, proto.ExecutorServiceCancelOnAddressDecodeResponse)
+		})
+}
+
+// executionFuture is a core.CancellableFuture of a submitted task. It is completed either when
+// the submission invocation is completed or when the task is cancelled, whichever happens first.
+type executionFuture struct {
+	*future
+	cancel    func(mayInterrupt bool) (bool, error)
+	mu        sync.Mutex
+	completed bool
+	cancelled bool
+	callbacks []func(value interface{}, err error)
+	done      chan struct{}
+}
+
+func (esp *executorServiceProxy) newExecutionFuture(invocation invocationResult,
+	decode func(responseMessage *proto.ClientMessage) (interface{}, error),
+	cancel func(mayInterrupt bool) (bool, error)) *executionFuture {
+	f := &executionFuture{
+		future: esp.newFuture(invocation, decode),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	invocation.AndThen(func(*proto.ClientMessage, error) {
+		f.complete(false)
+	})
+	return f
+}
+
+// complete completes the future once. It returns false if the future has already been completed.
+func (f *executionFuture) complete(cancelled bool) bool {
+	f.mu.Lock()
+	if f.completed {
+		f.mu.Unlock()
+		return false
+	}
+	f.completed = true
+	f.cancelled = cancelled
+	close(f.done)
+	callbacks := f.callbacks
+	f.callbacks = nil
+	f.mu.Unlock()
+	for _, callback := range callbacks {
+		callback(f.result())
+	}
+	return true
+}
+
+// result returns the result of a completed future.
+func (f *executionFuture) result() (interface{}, error) {
+	if f.cancelled {
+		return nil, core.NewHazelcastCancellationError("task is cancelled", nil)
+	}
+	return f.future.Get()
+}
+
+func (f *executionFuture) Get() (value interface{}, err error) {
+	<-f.done
+	return f.result()
+}
+
+func (f *executionFuture) GetWithTimeout(timeout time.Duration) (value interface{}, err error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-f.done:
+		return f.result()
+	case <-timer.C:
+		return nil, core.NewHazelcastTimeoutError("future is not completed after "+timeout.String(), nil)
+	}
+}
+
+func (f *executionFuture) Done() <-chan struct{} {
+	return f.done
+}
+
+func (f *executionFuture) AndThen(callback func(value interface{}, err error)) {
+	f.mu.Lock()
+	if !f.completed {
+		f.callbacks = append(f.callbacks, callback)
+		f.mu.Unlock()
+		return
+	}
+	f.mu.Unlock()
+	callback(f.result())
+}
+
+func (f *executionFuture) Cancel(mayInterrupt bool) (cancelled bool, err error) {
+	select {
+	case <-f.done:
+		return false, nil
+	case <-f.future.Done():
+		// The submission is completed, but its callback may not have completed the future yet.
+		f.complete(false)
+		return false, nil
+	default:
+	}
+	cancelled, err = f.cancel(mayInterrupt)
+	if err != nil || !cancelled {
+		return false, err
+	}
+	return f.complete(true), nil
+}
+
+func (f *executionFuture) IsCancelled() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.cancelled
+}
+
+// completedExecutionFuture is a core.CancellableFuture of a task that could not be submitted.
+type completedExecutionFuture struct {
+	*completedFuture
+}
+
+func newCompletedExecutionFuture(err error) *completedExecutionFuture {
+	return &completedExecutionFuture{newCompletedFuture(nil, err)}
+}
+
+func (f *completedExecutionFuture) Cancel(mayInterrupt bool) (cancelled bool, err error) {
+	return false, nil
+}
+
+func (f *completedExecutionFuture) IsCancelled() bool {
+	return false
+}
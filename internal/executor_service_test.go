@@ -0,0 +1,94 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+func newTestExecutionFuture(service *invocationServiceImpl, cancelResult bool) (*executionFuture, *invocation) {
+	invocation := newRegisteredTestInvocation(service)
+	esp := &executorServiceProxy{&proxy{client: service.client}}
+	future := esp.newExecutionFuture(invocation, func(responseMessage *proto.ClientMessage) (interface{}, error) {
+		return responseMessage, nil
+	}, func(mayInterrupt bool) (bool, error) {
+		return cancelResult, nil
+	})
+	return future, invocation
+}
+
+func TestExecutionFutureCancel(t *testing.T) {
+	future, _ := newTestExecutionFuture(newTestInvocationService(), true)
+	called := make(chan error, 1)
+	future.AndThen(func(value interface{}, err error) {
+		called <- err
+	})
+	cancelled, err := future.Cancel(true)
+	if err != nil || !cancelled {
+		t.Fatalf("expected the task to be cancelled, got %v, %v", cancelled, err)
+	}
+	if !future.IsCancelled() {
+		t.Fatal("IsCancelled should return true after a successful Cancel")
+	}
+	if _, err := future.Get(); err == nil {
+		t.Fatal("Get should return an error after the task is cancelled")
+	} else if _, ok := err.(*core.HazelcastCancellationError); !ok {
+		t.Fatalf("expected HazelcastCancellationError, got %v", err)
+	}
+	if _, ok := (<-called).(*core.HazelcastCancellationError); !ok {
+		t.Fatal("the callback should be called with HazelcastCancellationError")
+	}
+}
+
+func TestExecutionFutureCancelAfterCompletion(t *testing.T) {
+	future, invocation := newTestExecutionFuture(newTestInvocationService(), true)
+	response := proto.MapSizeEncodeRequest("response")
+	invocation.complete(response)
+	cancelled, err := future.Cancel(true)
+	if err != nil || cancelled {
+		t.Fatal("Cancel should return false after the task is completed")
+	}
+	value, err := future.Get()
+	if err != nil || value != response {
+		t.Fatalf("expected the response of the invocation, got %v, %v", value, err)
+	}
+}
+
+func TestExecutionFutureCancelRejected(t *testing.T) {
+	future, _ := newTestExecutionFuture(newTestInvocationService(), false)
+	cancelled, err := future.Cancel(false)
+	if err != nil || cancelled || future.IsCancelled() {
+		t.Fatal("the future should not be cancelled when the cluster rejects the cancellation")
+	}
+	select {
+	case <-future.Done():
+		t.Fatal("the future should not be completed when the cancellation is rejected")
+	default:
+	}
+}
+
+func TestCompletedExecutionFuture(t *testing.T) {
+	expectedErr := core.NewHazelcastNilPointerError("nil task", nil)
+	future := newCompletedExecutionFuture(expectedErr)
+	if _, err := future.Get(); err != expectedErr {
+		t.Fatalf("expected %v, got %v", expectedErr, err)
+	}
+	if cancelled, _ := future.Cancel(true); cancelled {
+		t.Fatal("a completed future cannot be cancelled")
+	}
+}
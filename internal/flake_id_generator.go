@@ -15,6 +15,9 @@
 package internal
 
 import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
 	"github.com/hazelcast/hazelcast-go-client/internal/flakeid"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
 )
@@ -25,7 +28,7 @@ type flakeIDGeneratorProxy struct {
 }
 
 func (fp *flakeIDGeneratorProxy) NewID() (id int64, err error) {
-	return fp.batcher.NewID()
+	return fp.batcher.NewIDFrom(fp)
 }
 
 func (fp *flakeIDGeneratorProxy) NewIDBatch(batchSize int32) (*flakeid.IDBatch, error) {
@@ -38,10 +41,14 @@ func (fp *flakeIDGeneratorProxy) NewIDBatch(batchSize int32) (*flakeid.IDBatch,
 	return flakeid.NewIDBatch(base, increment, newBatchSize), nil
 }
 
+func (fp *flakeIDGeneratorProxy) WithContext(ctx context.Context) core.FlakeIDGenerator {
+	return &flakeIDGeneratorProxy{proxy: fp.proxy.withContext(ctx), batcher: fp.batcher}
+}
+
 func newFlakeIDGenerator(client *HazelcastClient, serviceName string, name string) (*flakeIDGeneratorProxy, error) {
 	config := client.ClientConfig.GetFlakeIDGeneratorConfig(name)
 	flakeIDGenerator := &flakeIDGeneratorProxy{}
-	flakeIDGenerator.proxy = &proxy{client: client, serviceName: serviceName, name: name}
+	flakeIDGenerator.proxy = newProxy(client, serviceName, name)
 	flakeIDGenerator.batcher = flakeid.NewAutoBatcher(config.PrefetchCount(), config.PrefetchValidityMillis(), flakeIDGenerator)
 	return flakeIDGenerator, nil
 }
@@ -45,6 +45,12 @@ func NewAutoBatcher(batchSize int32, validity int64, supplier IDBatchSupplier) *
 }
 
 func (b *AutoBatcher) NewID() (int64, error) {
+	return b.NewIDFrom(b.batchIDSupplier)
+}
+
+// NewIDFrom returns a new ID like NewID, but fetches the next batch from the given supplier if the
+// current batch is used up or expired.
+func (b *AutoBatcher) NewIDFrom(supplier IDBatchSupplier) (int64, error) {
 	for {
 		block := b.block.Load().(*Block)
 		res := block.next()
@@ -56,7 +62,7 @@ func (b *AutoBatcher) NewID() (int64, error) {
 			b.mu.Unlock()
 			continue
 		}
-		idBatch, err := b.batchIDSupplier.NewIDBatch(b.batchSize)
+		idBatch, err := supplier.NewIDBatch(b.batchSize)
 		if err != nil {
 			b.mu.Unlock()
 			return 0, err
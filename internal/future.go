@@ -0,0 +1,107 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+// future is a core.Future that decodes the response of an invocation once it is available.
+type future struct {
+	proxy      *proxy
+	invocation invocationResult
+	decode     func(responseMessage *proto.ClientMessage) (interface{}, error)
+	decodeOnce sync.Once
+	value      interface{}
+	err        error
+}
+
+func (p *proxy) newFuture(invocation invocationResult,
+	decode func(responseMessage *proto.ClientMessage) (interface{}, error)) *future {
+	return &future{proxy: p, invocation: invocation, decode: decode}
+}
+
+func (f *future) resolve(responseMessage *proto.ClientMessage, err error) (interface{}, error) {
+	f.decodeOnce.Do(func() {
+		if err != nil {
+			f.err = err
+			return
+		}
+		f.value, f.err = f.decode(responseMessage)
+	})
+	return f.value, f.err
+}
+
+func (f *future) Get() (value interface{}, err error) {
+	return f.resolve(f.proxy.result(f.invocation))
+}
+
+func (f *future) GetWithTimeout(timeout time.Duration) (value interface{}, err error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-f.invocation.Done():
+		return f.Get()
+	case <-timer.C:
+		return nil, core.NewHazelcastTimeoutError("future is not completed after "+timeout.String(), nil)
+	}
+}
+
+func (f *future) Done() <-chan struct{} {
+	return f.invocation.Done()
+}
+
+func (f *future) AndThen(callback func(value interface{}, err error)) {
+	f.invocation.AndThen(func(responseMessage *proto.ClientMessage, err error) {
+		callback(f.resolve(responseMessage, err))
+	})
+}
+
+var closedChannel = make(chan struct{})
+
+func init() {
+	close(closedChannel)
+}
+
+// completedFuture is a core.Future whose result is known when it is created,
+// e.g. a value found in the Near Cache or an invalid argument.
+type completedFuture struct {
+	value interface{}
+	err   error
+}
+
+func newCompletedFuture(value interface{}, err error) *completedFuture {
+	return &completedFuture{value: value, err: err}
+}
+
+func (f *completedFuture) Get() (value interface{}, err error) {
+	return f.value, f.err
+}
+
+func (f *completedFuture) GetWithTimeout(timeout time.Duration) (value interface{}, err error) {
+	return f.value, f.err
+}
+
+func (f *completedFuture) Done() <-chan struct{} {
+	return closedChannel
+}
+
+func (f *completedFuture) AndThen(callback func(value interface{}, err error)) {
+	callback(f.value, f.err)
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+func newTestFuture(service *invocationServiceImpl, decodeCount *int) (*future, *invocation) {
+	invocation := newRegisteredTestInvocation(service)
+	future := (&proxy{client: service.client}).newFuture(invocation,
+		func(responseMessage *proto.ClientMessage) (interface{}, error) {
+			*decodeCount++
+			return responseMessage, nil
+		})
+	return future, invocation
+}
+
+func TestFutureDecodesResponseOnce(t *testing.T) {
+	decodeCount := 0
+	future, invocation := newTestFuture(newTestInvocationService(), &decodeCount)
+	response := proto.MapSizeEncodeRequest("response")
+	invocation.complete(response)
+	for i := 0; i < 3; i++ {
+		value, err := future.Get()
+		if err != nil || value != response {
+			t.Fatalf("expected the response of the invocation, got %v, %v", value, err)
+		}
+	}
+	if decodeCount != 1 {
+		t.Fatalf("expected the response to be decoded once, decoded %d times", decodeCount)
+	}
+}
+
+func TestFutureGetWithTimeout(t *testing.T) {
+	decodeCount := 0
+	future, invocation := newTestFuture(newTestInvocationService(), &decodeCount)
+	_, err := future.GetWithTimeout(10 * time.Millisecond)
+	if _, ok := err.(*core.HazelcastTimeoutError); !ok {
+		t.Fatalf("expected HazelcastTimeoutError, got %v", err)
+	}
+	response := proto.MapSizeEncodeRequest("response")
+	invocation.complete(response)
+	value, err := future.GetWithTimeout(10 * time.Millisecond)
+	if err != nil || value != response {
+		t.Fatal("the result should be available after a timed out GetWithTimeout")
+	}
+}
+
+func TestFutureAndThen(t *testing.T) {
+	decodeCount := 0
+	future, invocation := newTestFuture(newTestInvocationService(), &decodeCount)
+	called := make(chan interface{}, 2)
+	future.AndThen(func(value interface{}, err error) {
+		called <- value
+	})
+	select {
+	case <-called:
+		t.Fatal("callback is called before the future is completed")
+	default:
+	}
+	response := proto.MapSizeEncodeRequest("response")
+	invocation.complete(response)
+	if value := <-called; value != response {
+		t.Fatal("callback is not called with the result of the future")
+	}
+	future.AndThen(func(value interface{}, err error) {
+		called <- value
+	})
+	select {
+	case value := <-called:
+		if value != response {
+			t.Fatal("callback is not called with the result of the future")
+		}
+	default:
+		t.Fatal("callback registered after completion should be called immediately")
+	}
+	select {
+	case <-future.Done():
+	default:
+		t.Fatal("done channel should be closed after completion")
+	}
+}
+
+func TestCompletedFuture(t *testing.T) {
+	cause := core.NewHazelcastNilPointerError("nil arg", nil)
+	future := newCompletedFuture(nil, cause)
+	if _, err := future.Get(); err != cause {
+		t.Fatal("completed future should return its error")
+	}
+	select {
+	case <-future.Done():
+	default:
+		t.Fatal("done channel of a completed future should be closed")
+	}
+}
@@ -15,7 +15,6 @@
 package internal
 
 import (
-	"log"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -89,7 +88,7 @@ func (hbs *heartBeatService) heartBeat() {
 			go func() {
 				_, err := sentInvocation.Result()
 				if err != nil {
-					log.Println("Error when receiving heartbeat for connection, ", copyConnection)
+					hbs.client.logger.Warn("Error when receiving heartbeat for connection, ", copyConnection)
 				} else {
 					copyConnection.lastHeartbeatReceived.Store(time.Now())
 				}
@@ -103,7 +102,7 @@ func (hbs *heartBeatService) heartBeat() {
 }
 
 func (hbs *heartBeatService) HeartbeatResumed(connection *Connection) {
-	log.Println("Heartbeat restored for a connection ", connection)
+	hbs.client.logger.Info("Heartbeat restored for a connection ", connection)
 	connection.heartBeating = true
 	listeners := hbs.listeners.Load().([]interface{})
 	for _, listener := range listeners {
@@ -114,8 +113,9 @@ func (hbs *heartBeatService) HeartbeatResumed(connection *Connection) {
 }
 
 func (hbs *heartBeatService) HeartbeatStopped(connection *Connection) {
-	log.Println("Heartbeat stopped for a connection ", connection)
+	hbs.client.logger.Warn("Heartbeat stopped for a connection ", connection)
 	connection.heartBeating = false
+	hbs.client.StatisticsService.incrementHeartbeatFailures()
 	listeners := hbs.listeners.Load().([]interface{})
 	for _, listener := range listeners {
 		if _, ok := listener.(ConnectionHeartbeatListener); ok {
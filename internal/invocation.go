@@ -15,8 +15,8 @@
 package internal
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"sync/atomic"
 	"time"
 
@@ -30,32 +30,43 @@ import (
 )
 
 type invocation struct {
+	client          *HazelcastClient
 	request         atomic.Value
-	response        chan interface{}
+	response        interface{}
+	done            chan struct{}
 	isComplete      int32
+	callbacksLock   sync.Mutex
+	callbacks       []func(response *proto.ClientMessage, err error)
 	boundConnection *Connection
 	address         core.Address
 	partitionID     int32
 	sentConnection  atomic.Value
 	eventHandler    func(clientMessage *proto.ClientMessage)
+	internalEvent   bool // the events are handled by the client itself and must not be dropped
 	deadline        time.Time
+	startTime       time.Time
 }
 
 type invocationResult interface {
 	Result() (*proto.ClientMessage, error)
 	ResultWithTimeout(duration time.Duration) (*proto.ClientMessage, error)
+	ResultWithContext(ctx context.Context) (*proto.ClientMessage, error)
+	Done() <-chan struct{}
+	AndThen(callback func(response *proto.ClientMessage, err error))
 }
 
 func newInvocation(request *proto.ClientMessage, partitionID int32, address core.Address,
 	connection *Connection, client *HazelcastClient) *invocation {
 	invocationTimeout := client.properties.GetPositiveDuration(property.InvocationTimeoutSeconds)
 	invocation := &invocation{
+		client:          client,
 		partitionID:     partitionID,
 		address:         address,
 		boundConnection: connection,
-		response:        make(chan interface{}, 1),
+		done:            make(chan struct{}),
 		isComplete:      0,
 		deadline:        time.Now().Add(invocationTimeout),
+		startTime:       time.Now(),
 	}
 	invocation.request.Store(request)
 	return invocation
@@ -66,16 +77,63 @@ func (i *invocation) isBoundToSingleConnection() bool {
 }
 
 func (i *invocation) Result() (*proto.ClientMessage, error) {
-	response := <-i.response
-	return i.unwrapResponse(response)
+	<-i.done
+	return i.unwrapResponse(i.response)
+}
+
+// Done returns a channel that is closed when the invocation is completed.
+func (i *invocation) Done() <-chan struct{} {
+	return i.done
+}
+
+// AndThen registers a callback that is called with the result of the invocation once it is completed.
+// If the invocation is already completed, the callback is called immediately.
+// Otherwise it is called on a separate goroutine, so that a slow callback does not delay the responses
+// of the other invocations.
+func (i *invocation) AndThen(callback func(response *proto.ClientMessage, err error)) {
+	i.callbacksLock.Lock()
+	if !i.isCompleted() {
+		i.callbacks = append(i.callbacks, callback)
+		i.callbacksLock.Unlock()
+		return
+	}
+	i.callbacksLock.Unlock()
+	callback(i.unwrapResponse(i.response))
 }
 
 func (i *invocation) complete(response interface{}) {
-	if atomic.CompareAndSwapInt32(&i.isComplete, 0, 1) {
-		i.response <- response
+	i.callbacksLock.Lock()
+	if i.isCompleted() {
+		i.callbacksLock.Unlock()
+		return
+	}
+	i.response = response
+	atomic.StoreInt32(&i.isComplete, 1)
+	close(i.done)
+	callbacks := i.callbacks
+	i.callbacks = nil
+	i.callbacksLock.Unlock()
+	if len(callbacks) > 0 {
+		// complete is called on the goroutine that reads the responses, it should not wait for the callbacks.
+		go i.runCallbacks(callbacks)
 	}
 }
 
+func (i *invocation) runCallbacks(callbacks []func(response *proto.ClientMessage, err error)) {
+	for _, callback := range callbacks {
+		i.runCallback(callback)
+	}
+}
+
+func (i *invocation) runCallback(callback func(response *proto.ClientMessage, err error)) {
+	defer func() {
+		if r := recover(); r != nil {
+			i.client.logger.Error("Future callback panicked: ", r)
+		}
+	}()
+	callback(i.unwrapResponse(i.response))
+}
+
 func (i *invocation) unwrapResponse(response interface{}) (*proto.ClientMessage, error) {
 	switch res := response.(type) {
 	case *proto.ClientMessage:
@@ -88,14 +146,35 @@ func (i *invocation) unwrapResponse(response interface{}) (*proto.ClientMessage,
 }
 
 func (i *invocation) ResultWithTimeout(duration time.Duration) (*proto.ClientMessage, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	defer cancel()
+	return i.ResultWithContext(ctx)
+}
+
+// ResultWithContext waits for the response until the given context is done.
+// If the context is done first, the invocation is removed from the invocation service and
+// completed with a HazelcastTimeoutError or a HazelcastCancellationError, so that it is not retried anymore.
+func (i *invocation) ResultWithContext(ctx context.Context) (*proto.ClientMessage, error) {
 	select {
-	case response := <-i.response:
-		return i.unwrapResponse(response)
-	case <-time.After(duration):
-		return nil, core.NewHazelcastTimeoutError("invocation timed out after "+duration.String(), nil)
+	case <-i.done:
+		return i.unwrapResponse(i.response)
+	case <-ctx.Done():
+		var cause error
+		if ctx.Err() == context.DeadlineExceeded {
+			cause = core.NewHazelcastTimeoutError("invocation timed out", ctx.Err())
+		} else {
+			cause = core.NewHazelcastCancellationError("invocation is cancelled", ctx.Err())
+		}
+		i.client.InvocationService.cancelInvocation(i, cause)
+		// The response might have completed the invocation before it is cancelled.
+		return i.Result()
 	}
 }
 
+func (i *invocation) isCompleted() bool {
+	return atomic.LoadInt32(&i.isComplete) == 1
+}
+
 type invocationService interface {
 	invokeOnPartitionOwner(message *proto.ClientMessage, partitionID int32) invocationResult
 	invokeOnRandomTarget(message *proto.ClientMessage) invocationResult
@@ -105,7 +184,9 @@ type invocationService interface {
 	cleanupConnection(connection *Connection, e error)
 	removeEventHandler(correlationID int64)
 	sendInvocation(invocation *invocation) invocationResult
+	cancelInvocation(invocation *invocation, cause error)
 	handleResponse(response interface{})
+	pendingInvocationCount() int64
 	shutdown()
 }
 
@@ -164,17 +245,33 @@ func (is *invocationServiceImpl) sendInvocation(invocation *invocation) invocati
 	return invocation
 }
 
+func (is *invocationServiceImpl) cancelInvocation(invocation *invocation, cause error) {
+	is.unRegisterInvocation(invocation.request.Load().(*proto.ClientMessage).CorrelationID())
+	invocation.complete(cause)
+}
+
 func (is *invocationServiceImpl) retryInvocation(invocation *invocation, cause error) {
+	if invocation.isCompleted() {
+		// The invocation is cancelled while waiting for the retry.
+		return
+	}
 	if is.isShutdown.Load() == true {
 		invocation.complete(core.NewHazelcastClientNotActiveError("client is shut down", cause))
 	}
 	// retryInvocation modifies the client message and should not reuse the client message.
 	// It could be the case that it is in write queue of the connection.
 	invocation.request.Store(invocation.request.Load().(*proto.ClientMessage).CloneMessage())
+	is.client.StatisticsService.incrementRetries()
 	is.registerInvocation(invocation)
 	is.invoke(invocation)
 }
 
+func (is *invocationServiceImpl) pendingInvocationCount() int64 {
+	is.invocationsLock.RLock()
+	defer is.invocationsLock.RUnlock()
+	return int64(len(is.invocations))
+}
+
 func (is *invocationServiceImpl) shutdown() {
 	is.responseChannel <- struct{}{}
 	is.isShutdown.Store(true)
@@ -244,7 +341,7 @@ func (is *invocationServiceImpl) process() {
 		case struct{}:
 			return
 		default:
-			log.Fatalf("unexpected command from response channel %s", command)
+			is.client.logger.Error("unexpected command from response channel ", command)
 		}
 	}
 }
@@ -255,7 +352,7 @@ func (is *invocationServiceImpl) nextCorrelationID() int64 {
 }
 
 func (is *invocationServiceImpl) sendToRandomAddress(invocation *invocation) {
-	var target = is.client.LoadBalancer.nextAddress()
+	var target = nextAddress(is.client.LoadBalancer)
 	if target == nil {
 		is.handleNotSentInvocation(invocation.request.Load().(*proto.ClientMessage).CorrelationID(),
 			core.NewHazelcastIOError("no address found to invoke", nil))
@@ -309,7 +406,7 @@ func (is *invocationServiceImpl) sendToConnection(invocation *invocation, connec
 func (is *invocationServiceImpl) sendToAddress(invocation *invocation, address core.Address) {
 	connection, err := is.client.ConnectionManager.getOrTriggerConnect(address)
 	if err != nil {
-		log.Println("the following error occurred while trying to send the invocation ", err)
+		is.client.logger.Debug("the following error occurred while trying to send the invocation ", err)
 		is.handleNotSentInvocation(invocation.request.Load().(*proto.ClientMessage).CorrelationID(), err)
 		return
 	}
@@ -350,7 +447,7 @@ func (is *invocationServiceImpl) handleNotSentInvocation(correlationID int64, ca
 	if invocation, ok := is.unRegisterInvocation(correlationID); ok {
 		is.handleError(invocation, cause)
 	} else {
-		log.Println("No invocation has been found with the correlation id: ", correlationID)
+		is.client.logger.Warn("No invocation has been found with the correlation id: ", correlationID)
 	}
 }
 
@@ -361,14 +458,27 @@ func (is *invocationServiceImpl) handleClientMessage(response *proto.ClientMessa
 		invocation, found := is.eventHandlers[correlationID]
 		is.eventHandlersLock.RUnlock()
 		if !found {
-			log.Println("Got an event message with unknown correlation id.")
-		} else {
+			is.client.logger.Warn("Got an event message with unknown correlation id: ", correlationID)
+			return
+		}
+		key := int64(response.PartitionID())
+		if key < 0 {
+			key = correlationID
+		}
+		handler := func() {
 			invocation.eventHandler(response)
 		}
+		if invocation.internalEvent {
+			is.client.EventExecutor.dispatchInternal(handler)
+		} else {
+			is.client.EventExecutor.dispatch(key, handler)
+		}
 		return
 	}
 
 	if invocation, ok := is.unRegisterInvocation(correlationID); ok {
+		request := invocation.request.Load().(*proto.ClientMessage)
+		is.client.StatisticsService.recordLatency(request.MessageType(), time.Since(invocation.startTime))
 		if response.MessageType() == bufutil.MessageTypeException {
 			err := createHazelcastError(convertToError(response))
 			is.handleError(invocation, err)
@@ -376,7 +486,7 @@ func (is *invocationServiceImpl) handleClientMessage(response *proto.ClientMessa
 			invocation.complete(response)
 		}
 	} else {
-		log.Println("handleClientMessage No invocation has been found with the correlation id: ", correlationID)
+		is.client.logger.Warn("handleClientMessage No invocation has been found with the correlation id: ", correlationID)
 	}
 }
 
@@ -396,7 +506,7 @@ func (is *invocationServiceImpl) handleError(invocation *invocation, err error)
 
 	if time.Now().After(invocation.deadline) {
 		timeSinceDeadline := time.Since(invocation.deadline)
-		log.Println("Invocation will not be retried because it timed out by ", timeSinceDeadline.String())
+		is.client.logger.Debug("Invocation will not be retried because it timed out by ", timeSinceDeadline.String())
 		invocation.complete(core.NewHazelcastTimeoutError("invocation timed out by"+timeSinceDeadline.String(), nil))
 		return
 	}
@@ -0,0 +1,127 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+func newTestInvocationService() *invocationServiceImpl {
+	client := &HazelcastClient{}
+	service := &invocationServiceImpl{
+		client:        client,
+		invocations:   make(map[int64]*invocation),
+		eventHandlers: make(map[int64]*invocation),
+	}
+	client.InvocationService = service
+	return service
+}
+
+func newRegisteredTestInvocation(service *invocationServiceImpl) *invocation {
+	invocation := &invocation{
+		client: service.client,
+		done:   make(chan struct{}),
+	}
+	invocation.request.Store(proto.MapSizeEncodeRequest("test"))
+	service.registerInvocation(invocation)
+	return invocation
+}
+
+func TestInvocationResultWithContextCancelled(t *testing.T) {
+	service := newTestInvocationService()
+	invocation := newRegisteredTestInvocation(service)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := invocation.ResultWithContext(ctx)
+	if _, ok := err.(*core.HazelcastCancellationError); !ok {
+		t.Fatalf("expected HazelcastCancellationError, got %v", err)
+	}
+	if len(service.invocations) != 0 {
+		t.Fatal("cancelled invocation should be removed from the invocations")
+	}
+}
+
+func TestInvocationResultWithContextDeadline(t *testing.T) {
+	service := newTestInvocationService()
+	invocation := newRegisteredTestInvocation(service)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := invocation.ResultWithContext(ctx)
+	if _, ok := err.(*core.HazelcastTimeoutError); !ok {
+		t.Fatalf("expected HazelcastTimeoutError, got %v", err)
+	}
+	if len(service.invocations) != 0 {
+		t.Fatal("timed out invocation should be removed from the invocations")
+	}
+}
+
+func TestInvocationResultWithContextCompleted(t *testing.T) {
+	service := newTestInvocationService()
+	invocation := newRegisteredTestInvocation(service)
+	response := proto.MapSizeEncodeRequest("response")
+	invocation.complete(response)
+	result, err := invocation.ResultWithContext(context.Background())
+	if err != nil || result != response {
+		t.Fatalf("expected the response of the invocation, got %v, %v", result, err)
+	}
+}
+
+func TestInvocationIsNotRetriedAfterCancel(t *testing.T) {
+	service := newTestInvocationService()
+	invocation := newRegisteredTestInvocation(service)
+	service.cancelInvocation(invocation, core.NewHazelcastCancellationError("invocation is cancelled", nil))
+	service.retryInvocation(invocation, core.NewHazelcastIOError("packet is not sent", nil))
+	if len(service.invocations) != 0 {
+		t.Fatal("cancelled invocation should not be registered again by a retry")
+	}
+}
+
+func TestInvocationBlockingCallbackDoesNotDelayOtherResponses(t *testing.T) {
+	service := newTestInvocationService()
+	blocked := newRegisteredTestInvocation(service)
+	release := make(chan struct{})
+	defer close(release)
+	called := make(chan struct{})
+	blocked.AndThen(func(response *proto.ClientMessage, err error) {
+		close(called)
+		<-release
+	})
+	other := newRegisteredTestInvocation(service)
+	responded := make(chan struct{})
+	go func() {
+		// The responses are completed one after another, as on the goroutine that reads them.
+		blocked.complete(proto.MapSizeEncodeRequest("blocked"))
+		other.complete(proto.MapSizeEncodeRequest("other"))
+		close(responded)
+	}()
+	select {
+	case <-called:
+	case <-time.After(5 * time.Second):
+		t.Fatal("callback is not called after the invocation is completed")
+	}
+	select {
+	case <-responded:
+	case <-time.After(5 * time.Second):
+		t.Fatal("a blocking callback should not delay the responses of the other invocations")
+	}
+	if _, err := other.ResultWithTimeout(time.Second); err != nil {
+		t.Fatalf("expected the response of the other invocation, got %v", err)
+	}
+}
@@ -27,7 +27,20 @@ func IsValidIPAddress(addr string) bool {
 	return net.ParseIP(addr) != nil
 }
 
+// GetIPAndPort splits the given address to its host and port. The port is -1 if the address does not have one.
+// IPv6 addresses should be in brackets when they have a port, e.g. "[::1]:5701".
 func GetIPAndPort(addr string) (string, int32) {
+	if host, portStr, err := net.SplitHostPort(addr); err == nil {
+		port, err := strconv.Atoi(portStr)
+		if err != nil {
+			port = 5701 // Default port
+		}
+		return host, int32(port)
+	}
+	host := strings.TrimSuffix(strings.TrimPrefix(addr, "["), "]")
+	if strings.Contains(host, ":") && IsValidIPAddress(host) {
+		return host, -1
+	}
 	var port int
 	var err error
 	parts := strings.Split(addr, ":")
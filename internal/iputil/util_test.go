@@ -42,3 +42,21 @@ func TestGetIpWithoutPort(t *testing.T) {
 		t.Fatal("GetIPAndPort failed.")
 	}
 }
+
+func TestGetIPAndPort_IPv6(t *testing.T) {
+	testCases := []struct {
+		address string
+		ip      string
+		port    int32
+	}{
+		{"[::1]:5701", "::1", 5701},
+		{"[fe80::1]:5702", "fe80::1", 5702},
+		{"::1", "::1", -1},
+		{"[::1]", "::1", -1},
+	}
+	for _, tc := range testCases {
+		if ip, port := GetIPAndPort(tc.address); ip != tc.ip || port != tc.port {
+			t.Errorf("GetIPAndPort(%s) returns %s, %d expected %s, %d", tc.address, ip, port, tc.ip, tc.port)
+		}
+	}
+}
@@ -15,11 +15,9 @@
 package internal
 
 import (
-	"log"
 	"sync"
 	"sync/atomic"
 
-	"github.com/hazelcast/hazelcast-go-client/config"
 	"github.com/hazelcast/hazelcast-go-client/core"
 	"github.com/hazelcast/hazelcast-go-client/internal/iputil"
 )
@@ -34,16 +32,17 @@ const (
 )
 
 type lifecycleService struct {
+	client    *HazelcastClient
 	isLive    atomic.Value
 	listeners atomic.Value
 	mu        sync.Mutex
 }
 
-func newLifecycleService(config *config.Config) *lifecycleService {
-	newLifecycle := &lifecycleService{}
+func newLifecycleService(client *HazelcastClient) *lifecycleService {
+	newLifecycle := &lifecycleService{client: client}
 	newLifecycle.isLive.Store(true)
 	newLifecycle.listeners.Store(make(map[string]interface{})) //Initialize
-	for _, listener := range config.LifecycleListeners() {
+	for _, listener := range client.ClientConfig.LifecycleListeners() {
 		if _, ok := listener.(core.LifecycleListener); ok {
 			newLifecycle.AddListener(listener)
 		}
@@ -92,5 +91,5 @@ func (ls *lifecycleService) fireLifecycleEvent(newState string) {
 			listener.(core.LifecycleListener).LifecycleStateChanged(newState)
 		}
 	}
-	log.Println("New State : ", newState)
+	ls.client.logger.Info("New State : ", newState)
 }
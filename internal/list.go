@@ -15,6 +15,9 @@
 package internal
 
 import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
 	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
 )
@@ -223,3 +226,7 @@ func (lp *listProxy) createEventHandler(listener interface{}) func(clientMessage
 		})
 	}
 }
+
+func (lp *listProxy) WithContext(ctx context.Context) core.List {
+	return &listProxy{lp.partitionSpecificProxy.withContext(ctx)}
+}
@@ -15,7 +15,6 @@
 package internal
 
 import (
-	"log"
 	"time"
 
 	"github.com/hazelcast/hazelcast-go-client/config/property"
@@ -68,6 +67,7 @@ type listenerRegistrationKey struct {
 	request             *proto.ClientMessage
 	responseDecoder     proto.DecodeListenerResponse
 	eventHandler        func(clientMessage *proto.ClientMessage)
+	internal            bool
 }
 
 func newListenerService(client *HazelcastClient) *listenerService {
@@ -159,6 +159,22 @@ func (ls *listenerService) registerListener(request *proto.ClientMessage,
 	eventHandler func(clientMessage *proto.ClientMessage),
 	encodeListenerRemoveRequest proto.EncodeListenerRemoveRequest,
 	responseDecoder proto.DecodeListenerResponse) (string, error) {
+	return ls.registerListenerWithKind(request, eventHandler, encodeListenerRemoveRequest, responseDecoder, false)
+}
+
+// registerInternalListener registers a listener whose events are handled by the client itself,
+// e.g. the Near Cache invalidations. Its events are never dropped by the event queue overflow policy.
+func (ls *listenerService) registerInternalListener(request *proto.ClientMessage,
+	eventHandler func(clientMessage *proto.ClientMessage),
+	encodeListenerRemoveRequest proto.EncodeListenerRemoveRequest,
+	responseDecoder proto.DecodeListenerResponse) (string, error) {
+	return ls.registerListenerWithKind(request, eventHandler, encodeListenerRemoveRequest, responseDecoder, true)
+}
+
+func (ls *listenerService) registerListenerWithKind(request *proto.ClientMessage,
+	eventHandler func(clientMessage *proto.ClientMessage),
+	encodeListenerRemoveRequest proto.EncodeListenerRemoveRequest,
+	responseDecoder proto.DecodeListenerResponse, internal bool) (string, error) {
 	err := ls.trySyncConnectToAllConnections()
 	if err != nil {
 		return "", err
@@ -169,6 +185,7 @@ func (ls *listenerService) registerListener(request *proto.ClientMessage,
 		request:             request,
 		responseDecoder:     responseDecoder,
 		eventHandler:        eventHandler,
+		internal:            internal,
 	}
 	ls.registerListenerInitChannel <- &registrationKey
 	connections := ls.client.ConnectionManager.getActiveConnections()
@@ -200,6 +217,7 @@ func (ls *listenerService) registerListenerOnConnection(registrationID string, c
 	registrationKey := ls.registrationIDToListenerRegistration[registrationID]
 	invocation := newInvocation(registrationKey.request, -1, nil, connection, ls.client)
 	invocation.eventHandler = registrationKey.eventHandler
+	invocation.internalEvent = registrationKey.internal
 	responseMessage, err := ls.client.InvocationService.sendInvocation(invocation).Result()
 	if err != nil {
 		return err
@@ -243,7 +261,7 @@ func (ls *listenerService) deregisterListenerInternal(registrationID string,
 		if err != nil {
 			if connection.isAlive() {
 				successful = false
-				log.Println("Deregistration of listener with ID ", registrationID, " has failed to connection ", connection)
+				ls.client.logger.Warn("Deregistration of listener with ID ", registrationID, " has failed to connection ", connection)
 				continue
 			}
 		}
@@ -268,7 +286,7 @@ func (ls *listenerService) registerListenerFromInternal(registrationID string, c
 		if _, ok := err.(*core.HazelcastIOError); ok {
 			ls.registerListenerInternalHandleErrorChannel <- registrationIDConnection
 		} else {
-			log.Println("Listener ", registrationID, " cannot be added to a new Connection ", connection, ", reason :", err)
+			ls.client.logger.Warn("Listener ", registrationID, " cannot be added to a new Connection ", connection, ", reason: ", err)
 		}
 	}
 
@@ -15,28 +15,27 @@
 package internal
 
 import (
-	"math/rand"
-	"time"
-
+	"github.com/hazelcast/hazelcast-go-client/core"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
 )
 
-type randomLoadBalancer struct {
-	clusterService *clusterService
-}
-
-func newRandomLoadBalancer(clusterService *clusterService) *randomLoadBalancer {
-	rand.Seed(time.Now().Unix())
-	return &randomLoadBalancer{clusterService: clusterService}
+func newLoadBalancer(loadBalancer core.LoadBalancer, clusterService *clusterService) core.LoadBalancer {
+	if loadBalancer == nil {
+		loadBalancer = core.NewRandomLoadBalancer()
+	}
+	loadBalancer.Init(clusterService)
+	return loadBalancer
 }
 
-func (b *randomLoadBalancer) nextAddress() *proto.Address {
-	membersList := b.clusterService.GetMembers()
-	size := len(membersList)
-	if size > 0 {
-		randomIndex := rand.Intn(size)
-		member := membersList[randomIndex]
-		return member.Address().(*proto.Address)
+// nextAddress returns the address of the next member given by the load balancer, nil if there is no member.
+func nextAddress(loadBalancer core.LoadBalancer) *proto.Address {
+	member := loadBalancer.Next()
+	if member == nil {
+		return nil
+	}
+	address, ok := member.Address().(*proto.Address)
+	if !ok {
+		return proto.NewAddressWithParameters(member.Address().Host(), int32(member.Address().Port()))
 	}
-	return nil
+	return address
 }
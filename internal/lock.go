@@ -0,0 +1,108 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/timeutil"
+)
+
+type lockProxy struct {
+	*partitionSpecificProxy
+}
+
+func newLockProxy(client *HazelcastClient, serviceName string, name string) (*lockProxy, error) {
+	parSpecProxy, err := newPartitionSpecificProxy(client, serviceName, name)
+	if err != nil {
+		return nil, err
+	}
+	return &lockProxy{parSpecProxy}, nil
+}
+
+func (lp *lockProxy) Lock() (err error) {
+	return lp.LockWithLease(-1)
+}
+
+func (lp *lockProxy) LockWithLease(lease time.Duration) (err error) {
+	leaseInMillis := timeutil.GetTimeInMilliSeconds(lease)
+	request := proto.LockLockEncodeRequest(lp.name, leaseInMillis, lp.ownerID(), lp.client.ProxyManager.nextReferenceID())
+	_, err = lp.invoke(request)
+	return
+}
+
+func (lp *lockProxy) TryLock() (locked bool, err error) {
+	return lp.TryLockWithTimeout(0)
+}
+
+func (lp *lockProxy) TryLockWithTimeout(timeout time.Duration) (locked bool, err error) {
+	return lp.TryLockWithTimeoutAndLease(timeout, -1)
+}
+
+func (lp *lockProxy) TryLockWithTimeoutAndLease(timeout time.Duration, lease time.Duration) (locked bool, err error) {
+	timeoutInMillis := timeutil.GetTimeInMilliSeconds(timeout)
+	leaseInMillis := timeutil.GetTimeInMilliSeconds(lease)
+	request := proto.LockTryLockEncodeRequest(lp.name, lp.ownerID(), leaseInMillis, timeoutInMillis,
+		lp.client.ProxyManager.nextReferenceID())
+	responseMessage, err := lp.invoke(request)
+	return lp.decodeToBoolAndError(responseMessage, err, proto.LockTryLockDecodeResponse)
+}
+
+func (lp *lockProxy) Unlock() (err error) {
+	request := proto.LockUnlockEncodeRequest(lp.name, lp.ownerID(), lp.client.ProxyManager.nextReferenceID())
+	_, err = lp.invoke(request)
+	return
+}
+
+func (lp *lockProxy) ForceUnlock() (err error) {
+	request := proto.LockForceUnlockEncodeRequest(lp.name, lp.client.ProxyManager.nextReferenceID())
+	_, err = lp.invoke(request)
+	return
+}
+
+func (lp *lockProxy) IsLocked() (locked bool, err error) {
+	request := proto.LockIsLockedEncodeRequest(lp.name)
+	responseMessage, err := lp.invoke(request)
+	return lp.decodeToBoolAndError(responseMessage, err, proto.LockIsLockedDecodeResponse)
+}
+
+func (lp *lockProxy) IsLockedByCurrentThread() (locked bool, err error) {
+	request := proto.LockIsLockedByCurrentThreadEncodeRequest(lp.name, lp.ownerID())
+	responseMessage, err := lp.invoke(request)
+	return lp.decodeToBoolAndError(responseMessage, err, proto.LockIsLockedByCurrentThreadDecodeResponse)
+}
+
+func (lp *lockProxy) GetLockCount() (count int32, err error) {
+	request := proto.LockGetLockCountEncodeRequest(lp.name)
+	responseMessage, err := lp.invoke(request)
+	return lp.decodeToInt32AndError(responseMessage, err, proto.LockGetLockCountDecodeResponse)
+}
+
+func (lp *lockProxy) GetRemainingLeaseTime() (remaining time.Duration, err error) {
+	request := proto.LockGetRemainingLeaseTimeEncodeRequest(lp.name)
+	responseMessage, err := lp.invoke(request)
+	remainingInMillis, err := lp.decodeToInt64AndError(responseMessage, err, proto.LockGetRemainingLeaseTimeDecodeResponse)
+	if err != nil {
+		return 0, err
+	}
+	return timeutil.ConvertMillisToDuration(remainingInMillis), nil
+}
+
+func (lp *lockProxy) WithContext(ctx context.Context) core.Lock {
+	return &lockProxy{lp.partitionSpecificProxy.withContext(ctx)}
+}
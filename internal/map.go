@@ -15,6 +15,7 @@
 package internal
 
 import (
+	"context"
 	"time"
 
 	"github.com/hazelcast/hazelcast-go-client/core"
@@ -28,8 +29,12 @@ type mapProxy struct {
 	*proxy
 }
 
-func newMapProxy(client *HazelcastClient, serviceName string, name string) (*mapProxy, error) {
-	return &mapProxy{&proxy{client, serviceName, name}}, nil
+func newMapProxy(client *HazelcastClient, serviceName string, name string) (core.Map, error) {
+	mp := &mapProxy{newProxy(client, serviceName, name)}
+	if nearCacheConfig := client.ClientConfig.GetNearCacheConfig(name); nearCacheConfig != nil {
+		return newNearCachedMapProxy(mp, nearCacheConfig)
+	}
+	return mp, nil
 }
 
 func (mp *mapProxy) Put(key interface{}, value interface{}) (oldValue interface{}, err error) {
@@ -37,7 +42,7 @@ func (mp *mapProxy) Put(key interface{}, value interface{}) (oldValue interface{
 	if err != nil {
 		return nil, err
 	}
-	request := proto.MapPutEncodeRequest(mp.name, keyData, valueData, threadID, ttlUnlimited)
+	request := proto.MapPutEncodeRequest(mp.name, keyData, valueData, mp.ownerID(), ttlUnlimited)
 	responseMessage, err := mp.invokeOnKey(request, keyData)
 	return mp.decodeToObjectAndError(responseMessage, err, proto.MapPutDecodeResponse)
 }
@@ -47,7 +52,7 @@ func (mp *mapProxy) TryPut(key interface{}, value interface{}) (ok bool, err err
 	if err != nil {
 		return false, err
 	}
-	request := proto.MapTryPutEncodeRequest(mp.name, keyData, valueData, threadID, ttlUnlimited)
+	request := proto.MapTryPutEncodeRequest(mp.name, keyData, valueData, mp.ownerID(), ttlUnlimited)
 	responseMessage, err := mp.invokeOnKey(request, keyData)
 	return mp.decodeToBoolAndError(responseMessage, err, proto.MapTryPutDecodeResponse)
 }
@@ -58,7 +63,7 @@ func (mp *mapProxy) PutTransient(key interface{}, value interface{}, ttl time.Du
 		return err
 	}
 	ttlInMillis := timeutil.GetTimeInMilliSeconds(ttl)
-	request := proto.MapPutTransientEncodeRequest(mp.name, keyData, valueData, threadID, ttlInMillis)
+	request := proto.MapPutTransientEncodeRequest(mp.name, keyData, valueData, mp.ownerID(), ttlInMillis)
 	_, err = mp.invokeOnKey(request, keyData)
 	return err
 }
@@ -68,7 +73,7 @@ func (mp *mapProxy) Get(key interface{}) (value interface{}, err error) {
 	if err != nil {
 		return nil, err
 	}
-	request := proto.MapGetEncodeRequest(mp.name, keyData, threadID)
+	request := proto.MapGetEncodeRequest(mp.name, keyData, mp.ownerID())
 	responseMessage, err := mp.invokeOnKey(request, keyData)
 	return mp.decodeToObjectAndError(responseMessage, err, proto.MapGetDecodeResponse)
 }
@@ -78,7 +83,7 @@ func (mp *mapProxy) Remove(key interface{}) (value interface{}, err error) {
 	if err != nil {
 		return nil, err
 	}
-	request := proto.MapRemoveEncodeRequest(mp.name, keyData, threadID)
+	request := proto.MapRemoveEncodeRequest(mp.name, keyData, mp.ownerID())
 	responseMessage, err := mp.invokeOnKey(request, keyData)
 	return mp.decodeToObjectAndError(responseMessage, err, proto.MapRemoveDecodeResponse)
 }
@@ -88,7 +93,7 @@ func (mp *mapProxy) RemoveIfSame(key interface{}, value interface{}) (ok bool, e
 	if err != nil {
 		return false, err
 	}
-	request := proto.MapRemoveIfSameEncodeRequest(mp.name, keyData, valueData, threadID)
+	request := proto.MapRemoveIfSameEncodeRequest(mp.name, keyData, valueData, mp.ownerID())
 	responseMessage, err := mp.invokeOnKey(request, keyData)
 	return mp.decodeToBoolAndError(responseMessage, err, proto.MapRemoveIfSameDecodeResponse)
 }
@@ -109,7 +114,7 @@ func (mp *mapProxy) TryRemove(key interface{}, timeout time.Duration) (ok bool,
 		return false, err
 	}
 	timeoutInMillis := timeutil.GetTimeInMilliSeconds(timeout)
-	request := proto.MapTryRemoveEncodeRequest(mp.name, keyData, threadID, timeoutInMillis)
+	request := proto.MapTryRemoveEncodeRequest(mp.name, keyData, mp.ownerID(), timeoutInMillis)
 	responseMessage, err := mp.invokeOnKey(request, keyData)
 	return mp.decodeToBoolAndError(responseMessage, err, proto.MapTryRemoveDecodeResponse)
 }
@@ -171,7 +176,7 @@ func (mp *mapProxy) ContainsKey(key interface{}) (found bool, err error) {
 	if err != nil {
 		return false, err
 	}
-	request := proto.MapContainsKeyEncodeRequest(mp.name, keyData, threadID)
+	request := proto.MapContainsKeyEncodeRequest(mp.name, keyData, mp.ownerID())
 	responseMessage, err := mp.invokeOnKey(request, keyData)
 	return mp.decodeToBoolAndError(responseMessage, err, proto.MapContainsKeyDecodeResponse)
 }
@@ -197,7 +202,7 @@ func (mp *mapProxy) Delete(key interface{}) (err error) {
 	if err != nil {
 		return err
 	}
-	request := proto.MapDeleteEncodeRequest(mp.name, keyData, threadID)
+	request := proto.MapDeleteEncodeRequest(mp.name, keyData, mp.ownerID())
 	_, err = mp.invokeOnKey(request, keyData)
 	return
 }
@@ -219,7 +224,7 @@ func (mp *mapProxy) Evict(key interface{}) (evicted bool, err error) {
 	if err != nil {
 		return false, err
 	}
-	request := proto.MapEvictEncodeRequest(mp.name, keyData, threadID)
+	request := proto.MapEvictEncodeRequest(mp.name, keyData, mp.ownerID())
 	responseMessage, err := mp.invokeOnKey(request, keyData)
 	return mp.decodeToBoolAndError(responseMessage, err, proto.MapEvictDecodeResponse)
 }
@@ -246,7 +251,7 @@ func (mp *mapProxy) LockWithLeaseTime(key interface{}, lease time.Duration) (err
 		return err
 	}
 	leaseInMillis := timeutil.GetTimeInMilliSeconds(lease)
-	request := proto.MapLockEncodeRequest(mp.name, keyData, threadID, leaseInMillis, mp.client.ProxyManager.nextReferenceID())
+	request := proto.MapLockEncodeRequest(mp.name, keyData, mp.ownerID(), leaseInMillis, mp.client.ProxyManager.nextReferenceID())
 	_, err = mp.invokeOnKey(request, keyData)
 	return
 }
@@ -267,7 +272,7 @@ func (mp *mapProxy) TryLockWithTimeoutAndLease(key interface{}, timeout time.Dur
 	}
 	timeoutInMillis := timeutil.GetTimeInMilliSeconds(timeout)
 	leaseInMillis := timeutil.GetTimeInMilliSeconds(lease)
-	request := proto.MapTryLockEncodeRequest(mp.name, keyData, threadID, leaseInMillis, timeoutInMillis,
+	request := proto.MapTryLockEncodeRequest(mp.name, keyData, mp.ownerID(), leaseInMillis, timeoutInMillis,
 		mp.client.ProxyManager.nextReferenceID())
 	responseMessage, err := mp.invokeOnKey(request, keyData)
 	return mp.decodeToBoolAndError(responseMessage, err, proto.MapTryLockDecodeResponse)
@@ -278,7 +283,7 @@ func (mp *mapProxy) Unlock(key interface{}) (err error) {
 	if err != nil {
 		return err
 	}
-	request := proto.MapUnlockEncodeRequest(mp.name, keyData, threadID, mp.client.ProxyManager.nextReferenceID())
+	request := proto.MapUnlockEncodeRequest(mp.name, keyData, mp.ownerID(), mp.client.ProxyManager.nextReferenceID())
 	_, err = mp.invokeOnKey(request, keyData)
 	return
 }
@@ -309,7 +314,7 @@ func (mp *mapProxy) Replace(key interface{}, value interface{}) (oldValue interf
 	if err != nil {
 		return nil, err
 	}
-	request := proto.MapReplaceEncodeRequest(mp.name, keyData, valueData, threadID)
+	request := proto.MapReplaceEncodeRequest(mp.name, keyData, valueData, mp.ownerID())
 	responseMessage, err := mp.invokeOnKey(request, keyData)
 	return mp.decodeToObjectAndError(responseMessage, err, proto.MapReplaceDecodeResponse)
 
@@ -320,7 +325,7 @@ func (mp *mapProxy) ReplaceIfSame(key interface{}, oldValue interface{}, newValu
 	if err != nil {
 		return false, err
 	}
-	request := proto.MapReplaceIfSameEncodeRequest(mp.name, keyData, oldValueData, newValueData, threadID)
+	request := proto.MapReplaceIfSameEncodeRequest(mp.name, keyData, oldValueData, newValueData, mp.ownerID())
 	responseMessage, err := mp.invokeOnKey(request, keyData)
 	return mp.decodeToBoolAndError(responseMessage, err, proto.MapReplaceIfSameDecodeResponse)
 
@@ -336,7 +341,7 @@ func (mp *mapProxy) SetWithTTL(key interface{}, value interface{}, ttl time.Dura
 		return err
 	}
 	ttlInMillis := timeutil.GetTimeInMilliSeconds(ttl)
-	request := proto.MapSetEncodeRequest(mp.name, keyData, valueData, threadID, ttlInMillis)
+	request := proto.MapSetEncodeRequest(mp.name, keyData, valueData, mp.ownerID(), ttlInMillis)
 	_, err = mp.invokeOnKey(request, keyData)
 	return
 }
@@ -346,7 +351,7 @@ func (mp *mapProxy) PutIfAbsent(key interface{}, value interface{}) (oldValue in
 	if err != nil {
 		return nil, err
 	}
-	request := proto.MapPutIfAbsentEncodeRequest(mp.name, keyData, valueData, threadID, ttlUnlimited)
+	request := proto.MapPutIfAbsentEncodeRequest(mp.name, keyData, valueData, mp.ownerID(), ttlUnlimited)
 	responseMessage, err := mp.invokeOnKey(request, keyData)
 	return mp.decodeToObjectAndError(responseMessage, err, proto.MapPutIfAbsentDecodeResponse)
 
@@ -377,6 +382,9 @@ func (mp *mapProxy) KeySet() (keySet []interface{}, err error) {
 }
 
 func (mp *mapProxy) KeySetWithPredicate(predicate interface{}) (keySet []interface{}, err error) {
+	if paging, ok := asPagingPredicate(predicate); ok {
+		return mp.keySetWithPagingPredicate(paging)
+	}
 	predicateData, err := mp.validateAndSerializePredicate(predicate)
 	if err != nil {
 		return nil, err
@@ -393,6 +401,9 @@ func (mp *mapProxy) Values() (values []interface{}, err error) {
 }
 
 func (mp *mapProxy) ValuesWithPredicate(predicate interface{}) (values []interface{}, err error) {
+	if paging, ok := asPagingPredicate(predicate); ok {
+		return mp.valuesWithPagingPredicate(paging)
+	}
 	predicateData, err := mp.validateAndSerializePredicate(predicate)
 	if err != nil {
 		return nil, err
@@ -409,6 +420,9 @@ func (mp *mapProxy) EntrySet() (resultPairs []core.Pair, err error) {
 }
 
 func (mp *mapProxy) EntrySetWithPredicate(predicate interface{}) (resultPairs []core.Pair, err error) {
+	if paging, ok := asPagingPredicate(predicate); ok {
+		return mp.entrySetWithPagingPredicate(paging)
+	}
 	predicateData, err := mp.validateAndSerializePredicate(predicate)
 	if err != nil {
 		return nil, err
@@ -432,26 +446,42 @@ func (mp *mapProxy) GetAll(keys []interface{}) (entryMap map[interface{}]interfa
 		partitionID := mp.client.PartitionService.GetPartitionID(keyData)
 		partitions[partitionID] = append(partitions[partitionID], keyData)
 	}
+	err = mp.getAllInternal(partitions, entryMap, nil)
+	if err != nil {
+		return nil, err
+	}
+	return entryMap, nil
+}
+
+// getAllInternal fetches the given keys grouped by their partitions and puts the entries into entryMap.
+// If onEntry is not nil, it is called with the serialized form of each entry.
+func (mp *mapProxy) getAllInternal(partitions map[int32][]*serialization.Data, entryMap map[interface{}]interface{},
+	onEntry func(keyData *serialization.Data, valueData *serialization.Data)) error {
 	for partitionID, keyList := range partitions {
 		request := proto.MapGetAllEncodeRequest(mp.name, keyList)
 		responseMessage, err := mp.invokeOnPartition(request, partitionID)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		response := proto.MapGetAllDecodeResponse(responseMessage)()
 		for _, pairData := range response {
-			key, err := mp.toObject(pairData.Key().(*serialization.Data))
+			keyData := pairData.Key().(*serialization.Data)
+			valueData := pairData.Value().(*serialization.Data)
+			if onEntry != nil {
+				onEntry(keyData, valueData)
+			}
+			key, err := mp.toObject(keyData)
 			if err != nil {
-				return nil, err
+				return err
 			}
-			value, err := mp.toObject(pairData.Value().(*serialization.Data))
+			value, err := mp.toObject(valueData)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			entryMap[key] = value
 		}
 	}
-	return entryMap, nil
+	return nil
 }
 
 func (mp *mapProxy) GetEntryView(key interface{}) (entryView core.EntryView, err error) {
@@ -459,7 +489,7 @@ func (mp *mapProxy) GetEntryView(key interface{}) (entryView core.EntryView, err
 	if err != nil {
 		return nil, err
 	}
-	request := proto.MapGetEntryViewEncodeRequest(mp.name, keyData, threadID)
+	request := proto.MapGetEntryViewEncodeRequest(mp.name, keyData, mp.ownerID())
 	responseMessage, err := mp.invokeOnKey(request, keyData)
 	if err != nil {
 		return nil, err
@@ -614,12 +644,20 @@ func (mp *mapProxy) RemoveEntryListener(registrationID string) (bool, error) {
 	})
 }
 
+func (mp *mapProxy) GetQueryCache(name string, predicate interface{}, includeValue bool) (core.QueryCache, error) {
+	return mp.client.QueryCacheManager.getOrCreateQueryCache(mp.name, name, predicate, includeValue)
+}
+
+func (mp *mapProxy) NearCacheStats() core.NearCacheStats {
+	return nil
+}
+
 func (mp *mapProxy) ExecuteOnKey(key interface{}, entryProcessor interface{}) (result interface{}, err error) {
 	keyData, entryProcessorData, err := mp.validateAndSerialize2(key, entryProcessor)
 	if err != nil {
 		return nil, err
 	}
-	request := proto.MapExecuteOnKeyEncodeRequest(mp.name, entryProcessorData, keyData, threadID)
+	request := proto.MapExecuteOnKeyEncodeRequest(mp.name, entryProcessorData, keyData, mp.ownerID())
 	responseMessage, err := mp.invokeOnKey(request, keyData)
 	return mp.decodeToObjectAndError(responseMessage, err, proto.MapExecuteOnKeyDecodeResponse)
 }
@@ -666,3 +704,65 @@ func (mp *mapProxy) ExecuteOnEntriesWithPredicate(entryProcessor interface{},
 	responseMessage, err := mp.invokeOnRandomTarget(request)
 	return mp.decodeToPairSliceAndError(responseMessage, err, proto.MapExecuteWithPredicateDecodeResponse)
 }
+
+func (mp *mapProxy) GetAsync(key interface{}) core.Future {
+	keyData, err := mp.validateAndSerialize(key)
+	if err != nil {
+		return newCompletedFuture(nil, err)
+	}
+	request := proto.MapGetEncodeRequest(mp.name, keyData, mp.ownerID())
+	return mp.newFuture(mp.invokeOnKeyOwner(request, keyData),
+		mp.objectDecoder(proto.MapGetDecodeResponse))
+}
+
+func (mp *mapProxy) PutAsync(key interface{}, value interface{}) core.Future {
+	keyData, valueData, err := mp.validateAndSerialize2(key, value)
+	if err != nil {
+		return newCompletedFuture(nil, err)
+	}
+	request := proto.MapPutEncodeRequest(mp.name, keyData, valueData, mp.ownerID(), ttlUnlimited)
+	return mp.newFuture(mp.invokeOnKeyOwner(request, keyData),
+		mp.objectDecoder(proto.MapPutDecodeResponse))
+}
+
+func (mp *mapProxy) SetAsync(key interface{}, value interface{}) core.Future {
+	keyData, valueData, err := mp.validateAndSerialize2(key, value)
+	if err != nil {
+		return newCompletedFuture(nil, err)
+	}
+	request := proto.MapSetEncodeRequest(mp.name, keyData, valueData, mp.ownerID(), ttlUnlimited)
+	return mp.newFuture(mp.invokeOnKeyOwner(request, keyData), decodeToNil)
+}
+
+func (mp *mapProxy) RemoveAsync(key interface{}) core.Future {
+	keyData, err := mp.validateAndSerialize(key)
+	if err != nil {
+		return newCompletedFuture(nil, err)
+	}
+	request := proto.MapRemoveEncodeRequest(mp.name, keyData, mp.ownerID())
+	return mp.newFuture(mp.invokeOnKeyOwner(request, keyData),
+		mp.objectDecoder(proto.MapRemoveDecodeResponse))
+}
+
+func (mp *mapProxy) DeleteAsync(key interface{}) core.Future {
+	keyData, err := mp.validateAndSerialize(key)
+	if err != nil {
+		return newCompletedFuture(nil, err)
+	}
+	request := proto.MapDeleteEncodeRequest(mp.name, keyData, mp.ownerID())
+	return mp.newFuture(mp.invokeOnKeyOwner(request, keyData), decodeToNil)
+}
+
+func (mp *mapProxy) ExecuteOnKeyAsync(key interface{}, entryProcessor interface{}) core.Future {
+	keyData, entryProcessorData, err := mp.validateAndSerialize2(key, entryProcessor)
+	if err != nil {
+		return newCompletedFuture(nil, err)
+	}
+	request := proto.MapExecuteOnKeyEncodeRequest(mp.name, entryProcessorData, keyData, mp.ownerID())
+	return mp.newFuture(mp.invokeOnKeyOwner(request, keyData),
+		mp.objectDecoder(proto.MapExecuteOnKeyDecodeResponse))
+}
+
+func (mp *mapProxy) WithContext(ctx context.Context) core.Map {
+	return &mapProxy{mp.proxy.withContext(ctx)}
+}
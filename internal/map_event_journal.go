@@ -0,0 +1,339 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/eventjournal"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+const (
+	// eventJournalReadBatchSize is the maximum number of events that a subscription reads from a partition at once.
+	eventJournalReadBatchSize = 100
+
+	eventJournalInitialBackoff = 100 * time.Millisecond
+	eventJournalMaxBackoff     = 2 * time.Second
+)
+
+func (mp *mapProxy) ReadFromEventJournal(startSequence int64, minSize int32, maxSize int32, partitionID int32,
+	predicate interface{}, projection interface{}) (core.EventJournalReadResultSet, error) {
+	if startSequence < 0 {
+		return nil, core.NewHazelcastIllegalArgumentError("start sequence can't be smaller than 0", nil)
+	}
+	if minSize < 0 || maxSize < minSize {
+		return nil, core.NewHazelcastIllegalArgumentError("minSize should be positive and not larger than maxSize", nil)
+	}
+	predicateData, err := mp.toNullableData(predicate)
+	if err != nil {
+		return nil, err
+	}
+	projectionData, err := mp.toNullableData(projection)
+	if err != nil {
+		return nil, err
+	}
+	return mp.readFromEventJournal(mp.proxy, startSequence, minSize, maxSize, partitionID, predicateData, projectionData)
+}
+
+func (mp *mapProxy) SubscribeToEventJournal(startSequences map[int32]int64,
+	predicate interface{}) (core.EventJournalSubscription, error) {
+	predicateData, err := mp.toNullableData(predicate)
+	if err != nil {
+		return nil, err
+	}
+	partitionCount := mp.client.PartitionService.getPartitionCount()
+	results := make([]invocationResult, partitionCount)
+	for partitionID := int32(0); partitionID < partitionCount; partitionID++ {
+		request := proto.MapEventJournalSubscribeEncodeRequest(mp.name)
+		results[partitionID] = mp.client.InvocationService.invokeOnPartitionOwner(request, partitionID)
+	}
+	initialStates := make(map[int32]core.EventJournalState, partitionCount)
+	offsets := make(map[int32]int64, partitionCount)
+	for partitionID, result := range results {
+		responseMessage, err := mp.result(result)
+		if err != nil {
+			return nil, err
+		}
+		oldestSequence, newestSequence := proto.MapEventJournalSubscribeDecodeResponse(responseMessage)()
+		initialStates[int32(partitionID)] = core.EventJournalState{
+			OldestSequence: oldestSequence,
+			NewestSequence: newestSequence,
+		}
+		if sequence, found := startSequences[int32(partitionID)]; found {
+			offsets[int32(partitionID)] = sequence
+		} else {
+			offsets[int32(partitionID)] = oldestSequence
+		}
+	}
+	return newEventJournalSubscription(mp, predicateData, initialStates, offsets), nil
+}
+
+// readFromEventJournal reads the events of the partition, the invocation is bound to the context of the given proxy.
+func (mp *mapProxy) readFromEventJournal(invoker *proxy, startSequence int64, minSize int32, maxSize int32,
+	partitionID int32, predicateData *serialization.Data,
+	projectionData *serialization.Data) (*eventJournalReadResultSet, error) {
+	request := proto.MapEventJournalReadEncodeRequest(mp.name, startSequence, minSize, maxSize, predicateData,
+		projectionData)
+	responseMessage, err := invoker.invokeOnPartition(request, partitionID)
+	if err != nil {
+		return nil, err
+	}
+	readCount, itemsData, itemSeqs, nextSeq := proto.MapEventJournalReadDecodeResponse(responseMessage)()
+	return &eventJournalReadResultSet{
+		LazyReadResultSet: NewLazyReadResultSet(readCount, itemsData, itemSeqs, mp.client.SerializationService),
+		mp:                mp,
+		partitionID:       partitionID,
+		nextSequence:      nextSeq,
+	}, nil
+}
+
+type eventJournalReadResultSet struct {
+	*LazyReadResultSet
+	mp           *mapProxy
+	partitionID  int32
+	nextSequence int64
+}
+
+func (rs *eventJournalReadResultSet) Get(index int32) (interface{}, error) {
+	item, err := rs.LazyReadResultSet.Get(index)
+	if err != nil {
+		return nil, err
+	}
+	event, ok := item.(*eventjournal.MapEvent)
+	if !ok {
+		// The item is the projection of an event.
+		return item, nil
+	}
+	sequence, err := rs.Sequence(index)
+	if err != nil {
+		return nil, err
+	}
+	return rs.mp.toEventJournalMapEvent(event, rs.partitionID, sequence)
+}
+
+func (rs *eventJournalReadResultSet) NextSequence() int64 {
+	return rs.nextSequence
+}
+
+func (mp *mapProxy) toEventJournalMapEvent(event *eventjournal.MapEvent, partitionID int32,
+	sequence int64) (*eventJournalMapEvent, error) {
+	key, err := mp.toObjectOrNil(event.Key())
+	if err != nil {
+		return nil, err
+	}
+	newValue, err := mp.toObjectOrNil(event.NewValue())
+	if err != nil {
+		return nil, err
+	}
+	oldValue, err := mp.toObjectOrNil(event.OldValue())
+	if err != nil {
+		return nil, err
+	}
+	return &eventJournalMapEvent{
+		key:         key,
+		newValue:    newValue,
+		oldValue:    oldValue,
+		eventType:   event.EventType(),
+		partitionID: partitionID,
+		sequence:    sequence,
+	}, nil
+}
+
+func (mp *mapProxy) toObjectOrNil(data interface{}) (interface{}, error) {
+	if data, ok := data.(*serialization.Data); ok && data != nil {
+		return mp.toObject(data)
+	}
+	return nil, nil
+}
+
+type eventJournalMapEvent struct {
+	key         interface{}
+	newValue    interface{}
+	oldValue    interface{}
+	eventType   int32
+	partitionID int32
+	sequence    int64
+}
+
+func (e *eventJournalMapEvent) Key() interface{} {
+	return e.key
+}
+
+func (e *eventJournalMapEvent) NewValue() interface{} {
+	return e.newValue
+}
+
+func (e *eventJournalMapEvent) OldValue() interface{} {
+	return e.oldValue
+}
+
+func (e *eventJournalMapEvent) EventType() int32 {
+	return e.eventType
+}
+
+func (e *eventJournalMapEvent) PartitionID() int32 {
+	return e.partitionID
+}
+
+func (e *eventJournalMapEvent) Sequence() int64 {
+	return e.sequence
+}
+
+// eventJournalSubscription reads each partition in a separate goroutine and sends the events to a shared channel.
+type eventJournalSubscription struct {
+	mp            *mapProxy
+	invoker       *proxy
+	predicateData *serialization.Data
+	initialStates map[int32]core.EventJournalState
+	events        chan core.EventJournalMapEvent
+	ctx           context.Context
+	cancel        context.CancelFunc
+	wg            sync.WaitGroup
+	mu            sync.Mutex // guards offsets and err
+	offsets       map[int32]int64
+	err           error
+}
+
+func newEventJournalSubscription(mp *mapProxy, predicateData *serialization.Data,
+	initialStates map[int32]core.EventJournalState, offsets map[int32]int64) *eventJournalSubscription {
+	parent := mp.ctx
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithCancel(parent)
+	s := &eventJournalSubscription{
+		mp:            mp,
+		invoker:       mp.proxy.withContext(ctx),
+		predicateData: predicateData,
+		initialStates: initialStates,
+		events:        make(chan core.EventJournalMapEvent),
+		ctx:           ctx,
+		cancel:        cancel,
+		offsets:       offsets,
+	}
+	// The goroutines update the offsets, so the start sequences are collected before they are started.
+	type start struct {
+		partitionID int32
+		sequence    int64
+	}
+	starts := make([]start, 0, len(offsets))
+	for partitionID, sequence := range offsets {
+		starts = append(starts, start{partitionID, sequence})
+	}
+	s.wg.Add(len(starts))
+	for _, start := range starts {
+		go s.readPartition(start.partitionID, start.sequence)
+	}
+	go func() {
+		s.wg.Wait()
+		close(s.events)
+	}()
+	return s
+}
+
+func (s *eventJournalSubscription) Events() <-chan core.EventJournalMapEvent {
+	return s.events
+}
+
+func (s *eventJournalSubscription) InitialStates() map[int32]core.EventJournalState {
+	return s.initialStates
+}
+
+func (s *eventJournalSubscription) Offsets() map[int32]int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	offsets := make(map[int32]int64, len(s.offsets))
+	for partitionID, sequence := range s.offsets {
+		offsets[partitionID] = sequence
+	}
+	return offsets
+}
+
+func (s *eventJournalSubscription) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+func (s *eventJournalSubscription) Close() {
+	s.cancel()
+}
+
+func (s *eventJournalSubscription) readPartition(partitionID int32, sequence int64) {
+	defer s.wg.Done()
+	backoff := eventJournalInitialBackoff
+	for s.ctx.Err() == nil {
+		resultSet, err := s.mp.readFromEventJournal(s.invoker, sequence, 1, eventJournalReadBatchSize, partitionID,
+			s.predicateData, nil)
+		if err != nil {
+			switch err.(type) {
+			case *core.HazelcastTimeoutError:
+				continue
+			case *core.HazelcastTargetDisconnectedError, *core.HazelcastIOError, *core.HazelcastTargetNotMemberError:
+				// The connection will be restored, read the partition again from the same sequence.
+				select {
+				case <-time.After(backoff):
+				case <-s.ctx.Done():
+				}
+				if backoff *= 2; backoff > eventJournalMaxBackoff {
+					backoff = eventJournalMaxBackoff
+				}
+				continue
+			}
+			if s.ctx.Err() == nil {
+				s.fail(err)
+			}
+			return
+		}
+		backoff = eventJournalInitialBackoff
+		for i := int32(0); i < resultSet.Size(); i++ {
+			item, err := resultSet.Get(i)
+			if err != nil {
+				s.fail(err)
+				return
+			}
+			event := item.(core.EventJournalMapEvent)
+			select {
+			case s.events <- event:
+				s.setOffset(partitionID, event.Sequence()+1)
+			case <-s.ctx.Done():
+				return
+			}
+		}
+		sequence = resultSet.NextSequence()
+		s.setOffset(partitionID, sequence)
+	}
+}
+
+func (s *eventJournalSubscription) setOffset(partitionID int32, sequence int64) {
+	s.mu.Lock()
+	s.offsets[partitionID] = sequence
+	s.mu.Unlock()
+}
+
+// fail closes the subscription with the given error.
+func (s *eventJournalSubscription) fail(err error) {
+	s.mu.Lock()
+	if s.err == nil {
+		s.err = err
+	}
+	s.mu.Unlock()
+	s.cancel()
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/config"
+	"github.com/hazelcast/hazelcast-go-client/config/property"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+)
+
+// failingInvocationService fails the first disconnects invocations of each partition with a
+// HazelcastTargetDisconnectedError, and the later ones with a HazelcastIllegalArgumentError.
+type failingInvocationService struct {
+	invocationService
+	client      *HazelcastClient
+	disconnects int
+	mu          sync.Mutex
+	calls       map[int32]int
+}
+
+func (s *failingInvocationService) invokeOnPartitionOwner(request *proto.ClientMessage,
+	partitionID int32) invocationResult {
+	s.mu.Lock()
+	s.calls[partitionID]++
+	calls := s.calls[partitionID]
+	s.mu.Unlock()
+	invocation := newInvocation(request, partitionID, nil, nil, s.client)
+	if calls <= s.disconnects {
+		invocation.complete(core.NewHazelcastTargetDisconnectedError("disconnected", nil))
+	} else {
+		invocation.complete(core.NewHazelcastIllegalArgumentError("failed", nil))
+	}
+	return invocation
+}
+
+func TestEventJournalSubscription_RetriesTransientErrors(t *testing.T) {
+	client := &HazelcastClient{
+		properties:        property.NewHazelcastProperties(config.Properties{}),
+		StatisticsService: &statisticsService{maps: make(map[string]map[bufutil.MessageType]int64)},
+	}
+	service := &failingInvocationService{client: client, disconnects: 2, calls: make(map[int32]int)}
+	client.InvocationService = service
+	mp := &mapProxy{newProxy(client, bufutil.ServiceNameMap, "map")}
+	s := newEventJournalSubscription(mp, nil, nil, map[int32]int64{0: 0, 1: 5, 2: 10})
+	select {
+	case _, ok := <-s.Events():
+		if ok {
+			t.Fatal("expected no events")
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected the subscription to fail")
+	}
+	if _, ok := s.Err().(*core.HazelcastIllegalArgumentError); !ok {
+		t.Errorf("expected the subscription to fail with the non-transient error, got %v", s.Err())
+	}
+	// The first partition that fails closes the subscription, the others stop retrying.
+	retried := false
+	for _, calls := range service.calls {
+		retried = retried || calls == service.disconnects+1
+	}
+	if !retried {
+		t.Errorf("expected a partition to be read again after the disconnects, got %v", service.calls)
+	}
+	if offsets := s.Offsets(); offsets[1] != 5 {
+		t.Errorf("expected the offsets to be kept, got %v", offsets)
+	}
+}
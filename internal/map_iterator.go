@@ -0,0 +1,165 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"math"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+// defaultMapIteratorBatchSize is the batch size of the iterators used by Map.Range.
+const defaultMapIteratorBatchSize = 100
+
+// mapIterator is a core.MapIterator. It reads the partitions in order; each partition is read in batches
+// starting from the end of its record table, and every batch returns the table index to continue from.
+// A negative table index or an empty batch means that the partition is exhausted.
+type mapIterator struct {
+	mp             *mapProxy
+	batchSize      int32
+	includeValue   bool
+	partitionCount int32
+	partitionID    int32
+	tableIndex     int32
+	batch          []*proto.Pair
+	index          int
+	key            interface{}
+	value          interface{}
+	err            error
+}
+
+func newMapIterator(mp *mapProxy, batchSize int32, includeValue bool) (*mapIterator, error) {
+	if batchSize <= 0 {
+		return nil, core.NewHazelcastIllegalArgumentError("batchSize should be positive", nil)
+	}
+	return &mapIterator{
+		mp:             mp,
+		batchSize:      batchSize,
+		includeValue:   includeValue,
+		partitionCount: mp.client.PartitionService.getPartitionCount(),
+		tableIndex:     math.MaxInt32,
+	}, nil
+}
+
+func (it *mapIterator) Next() bool {
+	for it.err == nil {
+		if it.index < len(it.batch) {
+			return it.advance()
+		}
+		if !it.fetch() {
+			break
+		}
+	}
+	it.key, it.value = nil, nil
+	return false
+}
+
+func (it *mapIterator) advance() bool {
+	pair := it.batch[it.index]
+	it.batch[it.index] = nil
+	it.index++
+	if it.key, it.err = it.mp.toObject(pair.Key().(*serialization.Data)); it.err != nil {
+		return false
+	}
+	if it.includeValue {
+		if it.value, it.err = it.mp.toObject(pair.Value().(*serialization.Data)); it.err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// fetch fetches the next non-empty batch. It returns false if all the partitions are exhausted or an error occurs.
+func (it *mapIterator) fetch() bool {
+	for it.partitionID < it.partitionCount {
+		if it.tableIndex < 0 {
+			it.partitionID++
+			it.tableIndex = math.MaxInt32
+			continue
+		}
+		tableIndex, batch, err := it.fetchPartition()
+		if err != nil {
+			it.err = err
+			return false
+		}
+		if len(batch) == 0 {
+			it.tableIndex = -1
+			continue
+		}
+		it.tableIndex = tableIndex
+		it.batch = batch
+		it.index = 0
+		return true
+	}
+	return false
+}
+
+func (it *mapIterator) fetchPartition() (int32, []*proto.Pair, error) {
+	if it.includeValue {
+		request := proto.MapFetchEntriesEncodeRequest(it.mp.name, it.partitionID, it.tableIndex, it.batchSize)
+		responseMessage, err := it.mp.invokeOnPartition(request, it.partitionID)
+		if err != nil {
+			return 0, nil, err
+		}
+		tableIndex, entries := proto.MapFetchEntriesDecodeResponse(responseMessage)()
+		return tableIndex, entries, nil
+	}
+	request := proto.MapFetchKeysEncodeRequest(it.mp.name, it.partitionID, it.tableIndex, it.batchSize)
+	responseMessage, err := it.mp.invokeOnPartition(request, it.partitionID)
+	if err != nil {
+		return 0, nil, err
+	}
+	tableIndex, keys := proto.MapFetchKeysDecodeResponse(responseMessage)()
+	batch := make([]*proto.Pair, len(keys))
+	for i, keyData := range keys {
+		batch[i] = proto.NewPair(keyData, nil)
+	}
+	return tableIndex, batch, nil
+}
+
+func (it *mapIterator) Key() interface{} {
+	return it.key
+}
+
+func (it *mapIterator) Value() interface{} {
+	return it.value
+}
+
+func (it *mapIterator) Err() error {
+	return it.err
+}
+
+func (mp *mapProxy) Iterator(batchSize int32) (core.MapIterator, error) {
+	return newMapIterator(mp, batchSize, true)
+}
+
+func (mp *mapProxy) KeyIterator(batchSize int32) (core.MapIterator, error) {
+	return newMapIterator(mp, batchSize, false)
+}
+
+func (mp *mapProxy) Range(f func(key interface{}, value interface{}) bool) error {
+	it, err := newMapIterator(mp, defaultMapIteratorBatchSize, true)
+	if err != nil {
+		return err
+	}
+	for it.Next() {
+		if !f(it.Key(), it.Value()) {
+			return nil
+		}
+	}
+	return it.Err()
+}
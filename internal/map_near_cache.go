@@ -0,0 +1,356 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/config"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/nearcache"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+// nearCachedMapProxy is a map proxy that serves the reads from a local Near Cache when possible.
+// The entries changed through this proxy are invalidated locally, the entries changed by other
+// clients and members are invalidated through an invalidation listener.
+type nearCachedMapProxy struct {
+	*mapProxy
+	nearCache              *nearcache.NearCache
+	invalidationListenerID string
+}
+
+func newNearCachedMapProxy(mp *mapProxy, nearCacheConfig *config.NearCacheConfig) (*nearCachedMapProxy, error) {
+	ncmp := &nearCachedMapProxy{
+		mapProxy:  mp,
+		nearCache: mp.client.NearCacheManager.getOrCreateNearCache(mp.name, nearCacheConfig),
+	}
+	if nearCacheConfig.InvalidateOnChange() {
+		err := ncmp.addInvalidationListener()
+		if err != nil {
+			mp.client.NearCacheManager.destroyNearCache(mp.name)
+			return nil, err
+		}
+	}
+	return ncmp, nil
+}
+
+func (ncmp *nearCachedMapProxy) addInvalidationListener() (err error) {
+	request := proto.MapAddNearCacheInvalidationListenerEncodeRequest(ncmp.name, bufutil.EntryEventInvalidation,
+		ncmp.isSmart())
+	eventHandler := func(clientMessage *proto.ClientMessage) {
+		proto.MapAddNearCacheInvalidationListenerHandle(clientMessage, ncmp.onInvalidation, ncmp.onBatchInvalidation)
+	}
+	ncmp.invalidationListenerID, err = ncmp.client.ListenerService.registerInternalListener(request, eventHandler,
+		func(registrationID string) *proto.ClientMessage {
+			return proto.MapRemoveEntryListenerEncodeRequest(ncmp.name, registrationID)
+		}, func(clientMessage *proto.ClientMessage) string {
+			return proto.MapAddNearCacheInvalidationListenerDecodeResponse(clientMessage)()
+		})
+	return
+}
+
+func (ncmp *nearCachedMapProxy) onInvalidation(keyData *serialization.Data, sourceUUID string,
+	partitionUUID *proto.UUID, sequence int64) {
+	// A nil key means that all the entries of the map are changed, e.g. the map is cleared.
+	if keyData == nil {
+		ncmp.nearCache.Clear()
+		return
+	}
+	ncmp.nearCache.Invalidate(keyData)
+}
+
+func (ncmp *nearCachedMapProxy) onBatchInvalidation(keys []*serialization.Data, sourceUUIDs []string,
+	partitionUUIDs []*proto.UUID, sequences []int64) {
+	for _, keyData := range keys {
+		ncmp.nearCache.Invalidate(keyData)
+	}
+}
+
+func (ncmp *nearCachedMapProxy) invalidate(key interface{}) {
+	keyData, err := ncmp.toData(key)
+	if err == nil && keyData != nil {
+		ncmp.nearCache.Invalidate(keyData)
+	}
+}
+
+func (ncmp *nearCachedMapProxy) Destroy() (bool, error) {
+	if ncmp.invalidationListenerID != "" {
+		ncmp.RemoveEntryListener(ncmp.invalidationListenerID)
+	}
+	ncmp.client.NearCacheManager.destroyNearCache(ncmp.name)
+	return ncmp.mapProxy.Destroy()
+}
+
+func (ncmp *nearCachedMapProxy) NearCacheStats() core.NearCacheStats {
+	return ncmp.nearCache.Stats()
+}
+
+func (ncmp *nearCachedMapProxy) Get(key interface{}) (value interface{}, err error) {
+	keyData, err := ncmp.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	value, found, err := ncmp.nearCache.Get(keyData)
+	if found || err != nil {
+		return value, err
+	}
+	reservationID := ncmp.nearCache.TryReserve(keyData)
+	request := proto.MapGetEncodeRequest(ncmp.name, keyData, ncmp.ownerID())
+	responseMessage, err := ncmp.invokeOnKey(request, keyData)
+	if err != nil {
+		ncmp.nearCache.Publish(keyData, reservationID, nil)
+		return nil, err
+	}
+	valueData := proto.MapGetDecodeResponse(responseMessage)()
+	ncmp.nearCache.Publish(keyData, reservationID, valueData)
+	return ncmp.toObject(valueData)
+}
+
+func (ncmp *nearCachedMapProxy) GetAll(keys []interface{}) (entryMap map[interface{}]interface{}, err error) {
+	if keys == nil {
+		return nil, core.NewHazelcastNilPointerError(bufutil.NilKeysAreNotAllowed, nil)
+	}
+	partitions := make(map[int32][]*serialization.Data)
+	reservations := make(map[string]int64)
+	entryMap = make(map[interface{}]interface{})
+	for _, key := range keys {
+		keyData, err := ncmp.validateAndSerialize(key)
+		if err != nil {
+			return nil, err
+		}
+		value, found, err := ncmp.nearCache.Get(keyData)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			entryMap[key] = value
+			continue
+		}
+		reservations[string(keyData.Buffer())] = ncmp.nearCache.TryReserve(keyData)
+		partitionID := ncmp.client.PartitionService.GetPartitionID(keyData)
+		partitions[partitionID] = append(partitions[partitionID], keyData)
+	}
+	err = ncmp.getAllInternal(partitions, entryMap, func(keyData *serialization.Data, valueData *serialization.Data) {
+		key := string(keyData.Buffer())
+		ncmp.nearCache.Publish(keyData, reservations[key], valueData)
+		delete(reservations, key)
+	})
+	// Release the reservations of the keys that are not found.
+	for _, keyList := range partitions {
+		for _, keyData := range keyList {
+			if reservationID, found := reservations[string(keyData.Buffer())]; found {
+				ncmp.nearCache.Publish(keyData, reservationID, nil)
+			}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entryMap, nil
+}
+
+func (ncmp *nearCachedMapProxy) ContainsKey(key interface{}) (found bool, err error) {
+	keyData, err := ncmp.validateAndSerialize(key)
+	if err != nil {
+		return false, err
+	}
+	if _, found, _ := ncmp.nearCache.Get(keyData); found {
+		return true, nil
+	}
+	return ncmp.mapProxy.ContainsKey(key)
+}
+
+func (ncmp *nearCachedMapProxy) Put(key interface{}, value interface{}) (oldValue interface{}, err error) {
+	defer ncmp.invalidate(key)
+	return ncmp.mapProxy.Put(key, value)
+}
+
+func (ncmp *nearCachedMapProxy) TryPut(key interface{}, value interface{}) (ok bool, err error) {
+	defer ncmp.invalidate(key)
+	return ncmp.mapProxy.TryPut(key, value)
+}
+
+func (ncmp *nearCachedMapProxy) PutTransient(key interface{}, value interface{}, ttl time.Duration) (err error) {
+	defer ncmp.invalidate(key)
+	return ncmp.mapProxy.PutTransient(key, value, ttl)
+}
+
+func (ncmp *nearCachedMapProxy) PutIfAbsent(key interface{}, value interface{}) (oldValue interface{}, err error) {
+	defer ncmp.invalidate(key)
+	return ncmp.mapProxy.PutIfAbsent(key, value)
+}
+
+func (ncmp *nearCachedMapProxy) PutAll(entries map[interface{}]interface{}) (err error) {
+	defer func() {
+		for key := range entries {
+			ncmp.invalidate(key)
+		}
+	}()
+	return ncmp.mapProxy.PutAll(entries)
+}
+
+func (ncmp *nearCachedMapProxy) Set(key interface{}, value interface{}) (err error) {
+	return ncmp.SetWithTTL(key, value, ttlUnlimited)
+}
+
+func (ncmp *nearCachedMapProxy) SetWithTTL(key interface{}, value interface{}, ttl time.Duration) (err error) {
+	defer ncmp.invalidate(key)
+	return ncmp.mapProxy.SetWithTTL(key, value, ttl)
+}
+
+func (ncmp *nearCachedMapProxy) Replace(key interface{}, value interface{}) (oldValue interface{}, err error) {
+	defer ncmp.invalidate(key)
+	return ncmp.mapProxy.Replace(key, value)
+}
+
+func (ncmp *nearCachedMapProxy) ReplaceIfSame(key interface{}, oldValue interface{}, newValue interface{}) (
+	replaced bool, err error) {
+	defer ncmp.invalidate(key)
+	return ncmp.mapProxy.ReplaceIfSame(key, oldValue, newValue)
+}
+
+func (ncmp *nearCachedMapProxy) Remove(key interface{}) (value interface{}, err error) {
+	defer ncmp.invalidate(key)
+	return ncmp.mapProxy.Remove(key)
+}
+
+func (ncmp *nearCachedMapProxy) RemoveIfSame(key interface{}, value interface{}) (ok bool, err error) {
+	defer ncmp.invalidate(key)
+	return ncmp.mapProxy.RemoveIfSame(key, value)
+}
+
+func (ncmp *nearCachedMapProxy) TryRemove(key interface{}, timeout time.Duration) (ok bool, err error) {
+	defer ncmp.invalidate(key)
+	return ncmp.mapProxy.TryRemove(key, timeout)
+}
+
+func (ncmp *nearCachedMapProxy) RemoveAll(predicate interface{}) (err error) {
+	defer ncmp.nearCache.Clear()
+	return ncmp.mapProxy.RemoveAll(predicate)
+}
+
+func (ncmp *nearCachedMapProxy) Delete(key interface{}) (err error) {
+	defer ncmp.invalidate(key)
+	return ncmp.mapProxy.Delete(key)
+}
+
+func (ncmp *nearCachedMapProxy) Evict(key interface{}) (evicted bool, err error) {
+	defer ncmp.invalidate(key)
+	return ncmp.mapProxy.Evict(key)
+}
+
+func (ncmp *nearCachedMapProxy) EvictAll() (err error) {
+	defer ncmp.nearCache.Clear()
+	return ncmp.mapProxy.EvictAll()
+}
+
+func (ncmp *nearCachedMapProxy) Clear() (err error) {
+	defer ncmp.nearCache.Clear()
+	return ncmp.mapProxy.Clear()
+}
+
+func (ncmp *nearCachedMapProxy) ExecuteOnKey(key interface{}, entryProcessor interface{}) (result interface{}, err error) {
+	defer ncmp.invalidate(key)
+	return ncmp.mapProxy.ExecuteOnKey(key, entryProcessor)
+}
+
+func (ncmp *nearCachedMapProxy) ExecuteOnKeys(keys []interface{}, entryProcessor interface{}) (
+	keyToResultPairs []core.Pair, err error) {
+	defer func() {
+		for _, key := range keys {
+			ncmp.invalidate(key)
+		}
+	}()
+	return ncmp.mapProxy.ExecuteOnKeys(keys, entryProcessor)
+}
+
+func (ncmp *nearCachedMapProxy) ExecuteOnEntries(entryProcessor interface{}) (keyToResultPairs []core.Pair, err error) {
+	defer ncmp.nearCache.Clear()
+	return ncmp.mapProxy.ExecuteOnEntries(entryProcessor)
+}
+
+func (ncmp *nearCachedMapProxy) ExecuteOnEntriesWithPredicate(entryProcessor interface{},
+	predicate interface{}) (keyToResultPairs []core.Pair, err error) {
+	defer ncmp.nearCache.Clear()
+	return ncmp.mapProxy.ExecuteOnEntriesWithPredicate(entryProcessor, predicate)
+}
+
+func (ncmp *nearCachedMapProxy) GetAsync(key interface{}) core.Future {
+	keyData, err := ncmp.validateAndSerialize(key)
+	if err != nil {
+		return newCompletedFuture(nil, err)
+	}
+	value, found, err := ncmp.nearCache.Get(keyData)
+	if found || err != nil {
+		return newCompletedFuture(value, err)
+	}
+	reservationID := ncmp.nearCache.TryReserve(keyData)
+	request := proto.MapGetEncodeRequest(ncmp.name, keyData, ncmp.ownerID())
+	invocation := ncmp.invokeOnKeyOwner(request, keyData)
+	future := ncmp.newFuture(invocation, func(responseMessage *proto.ClientMessage) (interface{}, error) {
+		valueData := proto.MapGetDecodeResponse(responseMessage)()
+		ncmp.nearCache.Publish(keyData, reservationID, valueData)
+		return ncmp.toObject(valueData)
+	})
+	// The response is decoded and published to the Near Cache as soon as it arrives,
+	// even if the result of the future is never retrieved.
+	invocation.AndThen(func(responseMessage *proto.ClientMessage, err error) {
+		if err != nil {
+			ncmp.nearCache.Publish(keyData, reservationID, nil)
+			return
+		}
+		future.resolve(responseMessage, nil)
+	})
+	return future
+}
+
+func (ncmp *nearCachedMapProxy) PutAsync(key interface{}, value interface{}) core.Future {
+	return ncmp.invalidateOnCompletion(key, ncmp.mapProxy.PutAsync(key, value))
+}
+
+func (ncmp *nearCachedMapProxy) SetAsync(key interface{}, value interface{}) core.Future {
+	return ncmp.invalidateOnCompletion(key, ncmp.mapProxy.SetAsync(key, value))
+}
+
+func (ncmp *nearCachedMapProxy) RemoveAsync(key interface{}) core.Future {
+	return ncmp.invalidateOnCompletion(key, ncmp.mapProxy.RemoveAsync(key))
+}
+
+func (ncmp *nearCachedMapProxy) DeleteAsync(key interface{}) core.Future {
+	return ncmp.invalidateOnCompletion(key, ncmp.mapProxy.DeleteAsync(key))
+}
+
+func (ncmp *nearCachedMapProxy) ExecuteOnKeyAsync(key interface{}, entryProcessor interface{}) core.Future {
+	return ncmp.invalidateOnCompletion(key, ncmp.mapProxy.ExecuteOnKeyAsync(key, entryProcessor))
+}
+
+func (ncmp *nearCachedMapProxy) invalidateOnCompletion(key interface{}, future core.Future) core.Future {
+	future.AndThen(func(interface{}, error) {
+		ncmp.invalidate(key)
+	})
+	return future
+}
+
+func (ncmp *nearCachedMapProxy) WithContext(ctx context.Context) core.Map {
+	return &nearCachedMapProxy{
+		mapProxy:               &mapProxy{ncmp.proxy.withContext(ctx)},
+		nearCache:              ncmp.nearCache,
+		invalidationListenerID: ncmp.invalidationListenerID,
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/predicate"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+// asPagingPredicate returns the given predicate as a paging predicate if it is one.
+func asPagingPredicate(pred interface{}) (*predicate.Paging, bool) {
+	paging, ok := pred.(*predicate.Paging)
+	return paging, ok && paging != nil
+}
+
+// The members return all the results after the nearest anchor of a paging predicate.
+// They are sorted and cut to the current page on the client, which also records the anchors of the
+// pages in between on the predicate.
+
+func (mp *mapProxy) keySetWithPagingPredicate(paging *predicate.Paging) (keySet []interface{}, err error) {
+	if err = paging.Validate(); err != nil {
+		return nil, err
+	}
+	paging.SetIterationType(predicate.IterationTypeKey)
+	pagingData, err := mp.toData(paging)
+	if err != nil {
+		return nil, err
+	}
+	request := proto.MapKeySetWithPagingPredicateEncodeRequest(mp.name, pagingData)
+	responseMessage, err := mp.invokeOnRandomTarget(request)
+	keys, err := mp.decodeToInterfaceSliceAndError(responseMessage, err, proto.MapKeySetWithPagingPredicateDecodeResponse)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]core.Pair, len(keys))
+	for i, key := range keys {
+		entries[i] = proto.NewPair(key, nil)
+	}
+	entries = paging.SortedPage(entries)
+	keySet = make([]interface{}, len(entries))
+	for i, entry := range entries {
+		keySet[i] = entry.Key()
+	}
+	return keySet, nil
+}
+
+func (mp *mapProxy) valuesWithPagingPredicate(paging *predicate.Paging) (values []interface{}, err error) {
+	if err = paging.Validate(); err != nil {
+		return nil, err
+	}
+	paging.SetIterationType(predicate.IterationTypeValue)
+	pagingData, err := mp.toData(paging)
+	if err != nil {
+		return nil, err
+	}
+	request := proto.MapValuesWithPagingPredicateEncodeRequest(mp.name, pagingData)
+	responseMessage, err := mp.invokeOnRandomTarget(request)
+	entries, err := mp.decodeToPairSliceAndError(responseMessage, err, proto.MapValuesWithPagingPredicateDecodeResponse)
+	if err != nil {
+		return nil, err
+	}
+	entries = paging.SortedPage(entries)
+	values = make([]interface{}, len(entries))
+	for i, entry := range entries {
+		values[i] = entry.Value()
+	}
+	return values, nil
+}
+
+func (mp *mapProxy) entrySetWithPagingPredicate(paging *predicate.Paging) (resultPairs []core.Pair, err error) {
+	if err = paging.Validate(); err != nil {
+		return nil, err
+	}
+	paging.SetIterationType(predicate.IterationTypeEntry)
+	pagingData, err := mp.toData(paging)
+	if err != nil {
+		return nil, err
+	}
+	request := proto.MapEntriesWithPagingPredicateEncodeRequest(mp.name, pagingData)
+	responseMessage, err := mp.invokeOnRandomTarget(request)
+	entries, err := mp.decodeToPairSliceAndError(responseMessage, err, proto.MapEntriesWithPagingPredicateDecodeResponse)
+	if err != nil {
+		return nil, err
+	}
+	return paging.SortedPage(entries), nil
+}
@@ -15,6 +15,7 @@
 package internal
 
 import (
+	"context"
 	"time"
 
 	"github.com/hazelcast/hazelcast-go-client/core"
@@ -29,7 +30,7 @@ type multiMapProxy struct {
 }
 
 func newMultiMapProxy(client *HazelcastClient, serviceName string, name string) (*multiMapProxy, error) {
-	return &multiMapProxy{&proxy{client, serviceName, name}}, nil
+	return &multiMapProxy{newProxy(client, serviceName, name)}, nil
 }
 
 func (mmp *multiMapProxy) Put(key interface{}, value interface{}) (increased bool, err error) {
@@ -37,7 +38,7 @@ func (mmp *multiMapProxy) Put(key interface{}, value interface{}) (increased boo
 	if err != nil {
 		return
 	}
-	request := proto.MultiMapPutEncodeRequest(mmp.name, keyData, valueData, threadID)
+	request := proto.MultiMapPutEncodeRequest(mmp.name, keyData, valueData, mmp.ownerID())
 	responseMessage, err := mmp.invokeOnKey(request, keyData)
 	return mmp.decodeToBoolAndError(responseMessage, err, proto.MultiMapPutDecodeResponse)
 }
@@ -47,7 +48,7 @@ func (mmp *multiMapProxy) Get(key interface{}) (values []interface{}, err error)
 	if err != nil {
 		return
 	}
-	request := proto.MultiMapGetEncodeRequest(mmp.name, keyData, threadID)
+	request := proto.MultiMapGetEncodeRequest(mmp.name, keyData, mmp.ownerID())
 	responseMessage, err := mmp.invokeOnKey(request, keyData)
 	return mmp.decodeToInterfaceSliceAndError(responseMessage, err, proto.MultiMapGetDecodeResponse)
 }
@@ -57,7 +58,7 @@ func (mmp *multiMapProxy) Remove(key interface{}, value interface{}) (removed bo
 	if err != nil {
 		return
 	}
-	request := proto.MultiMapRemoveEntryEncodeRequest(mmp.name, keyData, valueData, threadID)
+	request := proto.MultiMapRemoveEntryEncodeRequest(mmp.name, keyData, valueData, mmp.ownerID())
 	responseMessage, err := mmp.invokeOnKey(request, keyData)
 	return mmp.decodeToBoolAndError(responseMessage, err, proto.MultiMapRemoveEntryDecodeResponse)
 
@@ -68,7 +69,7 @@ func (mmp *multiMapProxy) RemoveAll(key interface{}) (oldValues []interface{}, e
 	if err != nil {
 		return
 	}
-	request := proto.MultiMapRemoveEncodeRequest(mmp.name, keyData, threadID)
+	request := proto.MultiMapRemoveEncodeRequest(mmp.name, keyData, mmp.ownerID())
 	responseMessage, err := mmp.invokeOnKey(request, keyData)
 	return mmp.decodeToInterfaceSliceAndError(responseMessage, err, proto.MultiMapRemoveDecodeResponse)
 }
@@ -78,7 +79,7 @@ func (mmp *multiMapProxy) ContainsKey(key interface{}) (found bool, err error) {
 	if err != nil {
 		return
 	}
-	request := proto.MultiMapContainsKeyEncodeRequest(mmp.name, keyData, threadID)
+	request := proto.MultiMapContainsKeyEncodeRequest(mmp.name, keyData, mmp.ownerID())
 	responseMessage, err := mmp.invokeOnKey(request, keyData)
 	return mmp.decodeToBoolAndError(responseMessage, err, proto.MultiMapContainsKeyDecodeResponse)
 }
@@ -98,7 +99,7 @@ func (mmp *multiMapProxy) ContainsEntry(key interface{}, value interface{}) (fou
 	if err != nil {
 		return
 	}
-	request := proto.MultiMapContainsEntryEncodeRequest(mmp.name, keyData, valueData, threadID)
+	request := proto.MultiMapContainsEntryEncodeRequest(mmp.name, keyData, valueData, mmp.ownerID())
 	responseMessage, err := mmp.invokeOnKey(request, keyData)
 	return mmp.decodeToBoolAndError(responseMessage, err, proto.MultiMapContainsEntryDecodeResponse)
 }
@@ -120,7 +121,7 @@ func (mmp *multiMapProxy) ValueCount(key interface{}) (valueCount int32, err err
 	if err != nil {
 		return
 	}
-	request := proto.MultiMapValueCountEncodeRequest(mmp.name, keyData, threadID)
+	request := proto.MultiMapValueCountEncodeRequest(mmp.name, keyData, mmp.ownerID())
 	responseMessage, err := mmp.invokeOnKey(request, keyData)
 	return mmp.decodeToInt32AndError(responseMessage, err, proto.MultiMapValueCountDecodeResponse)
 }
@@ -192,7 +193,7 @@ func (mmp *multiMapProxy) LockWithLeaseTime(key interface{}, lease time.Duration
 		return err
 	}
 	leaseInMillis := timeutil.GetTimeInMilliSeconds(lease)
-	request := proto.MultiMapLockEncodeRequest(mmp.name, keyData, threadID, leaseInMillis,
+	request := proto.MultiMapLockEncodeRequest(mmp.name, keyData, mmp.ownerID(), leaseInMillis,
 		mmp.client.ProxyManager.nextReferenceID())
 	_, err = mmp.invokeOnKey(request, keyData)
 	return
@@ -224,7 +225,7 @@ func (mmp *multiMapProxy) TryLockWithTimeoutAndLease(key interface{}, timeout ti
 	}
 	timeoutInMillis := timeutil.GetTimeInMilliSeconds(timeout)
 	leaseInMillis := timeutil.GetTimeInMilliSeconds(lease)
-	request := proto.MultiMapTryLockEncodeRequest(mmp.name, keyData, threadID, leaseInMillis, timeoutInMillis,
+	request := proto.MultiMapTryLockEncodeRequest(mmp.name, keyData, mmp.ownerID(), leaseInMillis, timeoutInMillis,
 		mmp.client.ProxyManager.nextReferenceID())
 	responseMessage, err := mmp.invokeOnKey(request, keyData)
 	return mmp.decodeToBoolAndError(responseMessage, err, proto.MultiMapTryLockDecodeResponse)
@@ -235,7 +236,7 @@ func (mmp *multiMapProxy) Unlock(key interface{}) (err error) {
 	if err != nil {
 		return err
 	}
-	request := proto.MultiMapUnlockEncodeRequest(mmp.name, keyData, threadID, mmp.client.ProxyManager.nextReferenceID())
+	request := proto.MultiMapUnlockEncodeRequest(mmp.name, keyData, mmp.ownerID(), mmp.client.ProxyManager.nextReferenceID())
 	_, err = mmp.invokeOnKey(request, keyData)
 	return
 }
@@ -287,3 +288,7 @@ func (mmp *multiMapProxy) createEventHandlerToKey(listener interface{}) func(cli
 		})
 	}
 }
+
+func (mmp *multiMapProxy) WithContext(ctx context.Context) core.MultiMap {
+	return &multiMapProxy{mmp.proxy.withContext(ctx)}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"sync"
+
+	"github.com/hazelcast/hazelcast-go-client/config"
+	"github.com/hazelcast/hazelcast-go-client/internal/nearcache"
+)
+
+// nearCacheManager keeps the Near Caches of the client.
+// Since invalidation events can be lost while a connection is down, all the Near Caches are
+// cleared when a connection is closed.
+type nearCacheManager struct {
+	client     *HazelcastClient
+	mu         sync.Mutex // guards nearCaches
+	nearCaches map[string]*nearcache.NearCache
+}
+
+func newNearCacheManager(client *HazelcastClient) *nearCacheManager {
+	manager := &nearCacheManager{
+		client:     client,
+		nearCaches: make(map[string]*nearcache.NearCache),
+	}
+	client.ConnectionManager.addListener(manager)
+	return manager
+}
+
+func (ncm *nearCacheManager) getOrCreateNearCache(name string, config *config.NearCacheConfig) *nearcache.NearCache {
+	ncm.mu.Lock()
+	defer ncm.mu.Unlock()
+	if nearCache, found := ncm.nearCaches[name]; found {
+		return nearCache
+	}
+	nearCache := nearcache.New(name, config, ncm.client.SerializationService)
+	ncm.nearCaches[name] = nearCache
+	return nearCache
+}
+
+func (ncm *nearCacheManager) nearCacheList() []*nearcache.NearCache {
+	ncm.mu.Lock()
+	defer ncm.mu.Unlock()
+	nearCaches := make([]*nearcache.NearCache, 0, len(ncm.nearCaches))
+	for _, nearCache := range ncm.nearCaches {
+		nearCaches = append(nearCaches, nearCache)
+	}
+	return nearCaches
+}
+
+func (ncm *nearCacheManager) destroyNearCache(name string) {
+	ncm.mu.Lock()
+	nearCache, found := ncm.nearCaches[name]
+	delete(ncm.nearCaches, name)
+	ncm.mu.Unlock()
+	if found {
+		nearCache.Clear()
+	}
+}
+
+func (ncm *nearCacheManager) clearAll() {
+	ncm.mu.Lock()
+	defer ncm.mu.Unlock()
+	for _, nearCache := range ncm.nearCaches {
+		nearCache.Clear()
+	}
+}
+
+func (ncm *nearCacheManager) onConnectionClosed(connection *Connection, cause error) {
+	ncm.clearAll()
+}
+
+func (ncm *nearCacheManager) onConnectionOpened(connection *Connection) {
+}
+
+func (ncm *nearCacheManager) shutdown() {
+	ncm.clearAll()
+}
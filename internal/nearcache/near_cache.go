@@ -0,0 +1,278 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nearcache
+
+import (
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/config"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+// evictionSampleCount is the number of entries that are compared to select the entry to evict.
+const evictionSampleCount = 15
+
+// NotReserved is returned by TryReserve when the key could not be reserved.
+const NotReserved int64 = 0
+
+type record struct {
+	value          interface{}
+	reservationID  int64
+	creationTime   time.Time
+	lastAccessTime time.Time
+	hits           int64
+}
+
+func (r *record) isReserved() bool {
+	return r.reservationID != NotReserved
+}
+
+// NearCache is a local cache of the map entries that are read by the client.
+// Values are put into the cache in two steps to avoid caching stale values: a key is first reserved before
+// the value is fetched from the cluster, and the fetched value is only published if the reservation has not been
+// invalidated in the meantime.
+type NearCache struct {
+	name                 string
+	config               *config.NearCacheConfig
+	serializationService *serialization.Service
+	mu                   sync.Mutex // guards records
+	records              map[string]*record
+	nextReservationID    int64
+	stats                *stats
+	now                  func() time.Time
+}
+
+// New returns a new NearCache for the map with the given name.
+func New(name string, config *config.NearCacheConfig, serializationService *serialization.Service) *NearCache {
+	return &NearCache{
+		name:                 name,
+		config:               config,
+		serializationService: serializationService,
+		records:              make(map[string]*record),
+		stats:                newStats(time.Now()),
+		now:                  time.Now,
+	}
+}
+
+// Name returns the name of the map that this NearCache belongs to.
+func (nc *NearCache) Name() string {
+	return nc.name
+}
+
+// Get returns the cached value for the given key.
+// Get returns found as false if the key is not cached or its entry is expired.
+func (nc *NearCache) Get(keyData *serialization.Data) (value interface{}, found bool, err error) {
+	key := string(keyData.Buffer())
+	now := nc.now()
+	nc.mu.Lock()
+	record, ok := nc.records[key]
+	if !ok || record.isReserved() {
+		nc.mu.Unlock()
+		atomic.AddInt64(&nc.stats.misses, 1)
+		return nil, false, nil
+	}
+	if nc.isExpired(record, now) {
+		delete(nc.records, key)
+		nc.mu.Unlock()
+		atomic.AddInt64(&nc.stats.expirations, 1)
+		atomic.AddInt64(&nc.stats.misses, 1)
+		return nil, false, nil
+	}
+	record.lastAccessTime = now
+	record.hits++
+	value = record.value
+	nc.mu.Unlock()
+	atomic.AddInt64(&nc.stats.hits, 1)
+	if valueData, ok := value.(*serialization.Data); ok {
+		value, err = nc.serializationService.ToObject(valueData)
+	}
+	return value, true, err
+}
+
+// TryReserve reserves the given key before its value is fetched from the cluster.
+// TryReserve returns NotReserved if the key is already cached or reserved, or if there is no room for it.
+func (nc *NearCache) TryReserve(keyData *serialization.Data) int64 {
+	key := string(keyData.Buffer())
+	now := nc.now()
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+	if record, ok := nc.records[key]; ok {
+		if !nc.isExpired(record, now) {
+			return NotReserved
+		}
+		delete(nc.records, key)
+		atomic.AddInt64(&nc.stats.expirations, 1)
+	}
+	if int32(len(nc.records)) >= nc.config.MaxEntryCount() && !nc.evict(now) {
+		return NotReserved
+	}
+	nc.nextReservationID++
+	nc.records[key] = &record{reservationID: nc.nextReservationID}
+	return nc.nextReservationID
+}
+
+// Publish caches the given value for a key reserved with the given reservationID.
+// If the reservation has been invalidated since, the value is not cached.
+// A nil value releases the reservation without caching anything.
+func (nc *NearCache) Publish(keyData *serialization.Data, reservationID int64,
+	valueData *serialization.Data) error {
+	if reservationID == NotReserved {
+		return nil
+	}
+	var value interface{} = valueData
+	if valueData != nil && nc.config.InMemoryFormat() == config.InMemoryFormatObject {
+		object, err := nc.serializationService.ToObject(valueData)
+		if err != nil {
+			nc.release(keyData, reservationID)
+			return err
+		}
+		value = object
+	}
+	key := string(keyData.Buffer())
+	now := nc.now()
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+	record, ok := nc.records[key]
+	if !ok || record.reservationID != reservationID {
+		return nil
+	}
+	if valueData == nil {
+		delete(nc.records, key)
+		return nil
+	}
+	record.value = value
+	record.reservationID = NotReserved
+	record.creationTime = now
+	record.lastAccessTime = now
+	return nil
+}
+
+func (nc *NearCache) release(keyData *serialization.Data, reservationID int64) {
+	key := string(keyData.Buffer())
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+	if record, ok := nc.records[key]; ok && record.reservationID == reservationID {
+		delete(nc.records, key)
+	}
+}
+
+// Invalidate removes the given key from the cache, cancelling its reservation if there is any.
+func (nc *NearCache) Invalidate(keyData *serialization.Data) {
+	key := string(keyData.Buffer())
+	nc.mu.Lock()
+	record, ok := nc.records[key]
+	if ok {
+		delete(nc.records, key)
+	}
+	nc.mu.Unlock()
+	if ok && !record.isReserved() {
+		atomic.AddInt64(&nc.stats.invalidations, 1)
+	}
+}
+
+// Clear removes all the entries from the cache.
+func (nc *NearCache) Clear() {
+	nc.mu.Lock()
+	var invalidated int64
+	for _, record := range nc.records {
+		if !record.isReserved() {
+			invalidated++
+		}
+	}
+	nc.records = make(map[string]*record)
+	nc.mu.Unlock()
+	atomic.AddInt64(&nc.stats.invalidations, invalidated)
+}
+
+// Size returns the number of cached entries.
+func (nc *NearCache) Size() int {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+	size := 0
+	for _, record := range nc.records {
+		if !record.isReserved() {
+			size++
+		}
+	}
+	return size
+}
+
+// Stats returns a snapshot of the statistics of the cache.
+func (nc *NearCache) Stats() *Stats {
+	return nc.stats.snapshot(int64(nc.Size()))
+}
+
+func (nc *NearCache) isExpired(record *record, now time.Time) bool {
+	if record.isReserved() {
+		return false
+	}
+	if ttl := nc.config.TimeToLive(); ttl > 0 && now.Sub(record.creationTime) > ttl {
+		return true
+	}
+	if maxIdle := nc.config.MaxIdle(); maxIdle > 0 && now.Sub(record.lastAccessTime) > maxIdle {
+		return true
+	}
+	return false
+}
+
+// evict removes one entry to make room for a new one. It should be called under the lock.
+// evict returns false if no entry could be removed.
+func (nc *NearCache) evict(now time.Time) bool {
+	policy := nc.config.EvictionPolicy()
+	if policy == config.EvictionPolicyNone {
+		return false
+	}
+	var candidateKey string
+	var candidate *record
+	sampled := 0
+	for key, record := range nc.records {
+		if record.isReserved() {
+			continue
+		}
+		if nc.isExpired(record, now) {
+			delete(nc.records, key)
+			atomic.AddInt64(&nc.stats.expirations, 1)
+			return true
+		}
+		if candidate == nil || isBetterCandidate(policy, record, candidate) {
+			candidateKey, candidate = key, record
+		}
+		sampled++
+		if sampled == evictionSampleCount {
+			break
+		}
+	}
+	if candidate == nil {
+		return false
+	}
+	delete(nc.records, candidateKey)
+	atomic.AddInt64(&nc.stats.evictions, 1)
+	return true
+}
+
+func isBetterCandidate(policy config.EvictionPolicy, record *record, candidate *record) bool {
+	switch policy {
+	case config.EvictionPolicyLRU:
+		return record.lastAccessTime.Before(candidate.lastAccessTime)
+	case config.EvictionPolicyLFU:
+		return record.hits < candidate.hits
+	case config.EvictionPolicyRandom:
+		return rand.Intn(2) == 0
+	}
+	return false
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nearcache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/config"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func (c *testClock) advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestNearCache(t *testing.T, nearCacheConfig *config.NearCacheConfig) (*NearCache, *serialization.Service,
+	*testClock) {
+	service, err := serialization.NewSerializationService(config.NewSerializationConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	clock := &testClock{now: time.Now()}
+	nearCache := New("test", nearCacheConfig, service)
+	nearCache.now = clock.Now
+	return nearCache, service, clock
+}
+
+func toData(t *testing.T, service *serialization.Service, object interface{}) *serialization.Data {
+	data, err := service.ToData(object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func cache(t *testing.T, nearCache *NearCache, service *serialization.Service, key interface{}, value interface{}) {
+	keyData := toData(t, service, key)
+	reservationID := nearCache.TryReserve(keyData)
+	if reservationID == NotReserved {
+		t.Fatalf("key %v could not be reserved", key)
+	}
+	if err := nearCache.Publish(keyData, reservationID, toData(t, service, value)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func assertCached(t *testing.T, nearCache *NearCache, service *serialization.Service, key interface{},
+	expected interface{}) {
+	value, found, err := nearCache.Get(toData(t, service, key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !found || value != expected {
+		t.Fatalf("expected %v to be cached with value %v, got %v found: %t", key, expected, value, found)
+	}
+}
+
+func assertNotCached(t *testing.T, nearCache *NearCache, service *serialization.Service, key interface{}) {
+	if _, found, _ := nearCache.Get(toData(t, service, key)); found {
+		t.Fatalf("expected %v not to be cached", key)
+	}
+}
+
+func TestNearCache_GetAfterPublish(t *testing.T) {
+	nearCache, service, _ := newTestNearCache(t, config.NewNearCacheConfig("test"))
+	assertNotCached(t, nearCache, service, "key")
+	cache(t, nearCache, service, "key", "value")
+	assertCached(t, nearCache, service, "key", "value")
+	stats := nearCache.Stats()
+	if stats.Hits() != 1 || stats.Misses() != 1 || stats.OwnedEntryCount() != 1 {
+		t.Fatalf("unexpected stats hits: %d misses: %d owned: %d", stats.Hits(), stats.Misses(),
+			stats.OwnedEntryCount())
+	}
+}
+
+func TestNearCache_ObjectInMemoryFormat(t *testing.T) {
+	nearCacheConfig := config.NewNearCacheConfig("test")
+	nearCacheConfig.SetInMemoryFormat(config.InMemoryFormatObject)
+	nearCache, service, _ := newTestNearCache(t, nearCacheConfig)
+	cache(t, nearCache, service, int64(1), "value")
+	assertCached(t, nearCache, service, int64(1), "value")
+}
+
+func TestNearCache_InvalidationCancelsReservation(t *testing.T) {
+	nearCache, service, _ := newTestNearCache(t, config.NewNearCacheConfig("test"))
+	keyData := toData(t, service, "key")
+	reservationID := nearCache.TryReserve(keyData)
+	if nearCache.TryReserve(keyData) != NotReserved {
+		t.Fatal("a reserved key should not be reserved again")
+	}
+	nearCache.Invalidate(keyData)
+	nearCache.Publish(keyData, reservationID, toData(t, service, "stale"))
+	assertNotCached(t, nearCache, service, "key")
+}
+
+func TestNearCache_PublishNilReleasesReservation(t *testing.T) {
+	nearCache, service, _ := newTestNearCache(t, config.NewNearCacheConfig("test"))
+	keyData := toData(t, service, "key")
+	nearCache.Publish(keyData, nearCache.TryReserve(keyData), nil)
+	if nearCache.TryReserve(keyData) == NotReserved {
+		t.Fatal("the reservation should be released when the value is nil")
+	}
+}
+
+func TestNearCache_Invalidate(t *testing.T) {
+	nearCache, service, _ := newTestNearCache(t, config.NewNearCacheConfig("test"))
+	cache(t, nearCache, service, "key1", "value1")
+	cache(t, nearCache, service, "key2", "value2")
+	nearCache.Invalidate(toData(t, service, "key1"))
+	assertNotCached(t, nearCache, service, "key1")
+	assertCached(t, nearCache, service, "key2", "value2")
+	nearCache.Clear()
+	assertNotCached(t, nearCache, service, "key2")
+	if invalidations := nearCache.Stats().Invalidations(); invalidations != 2 {
+		t.Fatalf("expected 2 invalidations, got %d", invalidations)
+	}
+}
+
+func TestNearCache_TimeToLive(t *testing.T) {
+	nearCacheConfig := config.NewNearCacheConfig("test")
+	nearCacheConfig.SetTimeToLive(time.Minute)
+	nearCache, service, clock := newTestNearCache(t, nearCacheConfig)
+	cache(t, nearCache, service, "key", "value")
+	clock.advance(30 * time.Second)
+	assertCached(t, nearCache, service, "key", "value")
+	clock.advance(31 * time.Second)
+	assertNotCached(t, nearCache, service, "key")
+	if expirations := nearCache.Stats().Expirations(); expirations != 1 {
+		t.Fatalf("expected 1 expiration, got %d", expirations)
+	}
+}
+
+func TestNearCache_MaxIdle(t *testing.T) {
+	nearCacheConfig := config.NewNearCacheConfig("test")
+	nearCacheConfig.SetMaxIdle(time.Minute)
+	nearCache, service, clock := newTestNearCache(t, nearCacheConfig)
+	cache(t, nearCache, service, "key", "value")
+	for i := 0; i < 3; i++ {
+		clock.advance(50 * time.Second)
+		assertCached(t, nearCache, service, "key", "value")
+	}
+	clock.advance(61 * time.Second)
+	assertNotCached(t, nearCache, service, "key")
+}
+
+func TestNearCache_EvictionLRU(t *testing.T) {
+	nearCacheConfig := config.NewNearCacheConfig("test")
+	nearCacheConfig.SetMaxEntryCount(2)
+	nearCache, service, clock := newTestNearCache(t, nearCacheConfig)
+	cache(t, nearCache, service, "key1", "value1")
+	clock.advance(time.Second)
+	cache(t, nearCache, service, "key2", "value2")
+	clock.advance(time.Second)
+	assertCached(t, nearCache, service, "key1", "value1")
+	cache(t, nearCache, service, "key3", "value3")
+	assertNotCached(t, nearCache, service, "key2")
+	assertCached(t, nearCache, service, "key1", "value1")
+	assertCached(t, nearCache, service, "key3", "value3")
+	if evictions := nearCache.Stats().Evictions(); evictions != 1 {
+		t.Fatalf("expected 1 eviction, got %d", evictions)
+	}
+}
+
+func TestNearCache_EvictionLFU(t *testing.T) {
+	nearCacheConfig := config.NewNearCacheConfig("test")
+	nearCacheConfig.SetMaxEntryCount(2)
+	nearCacheConfig.SetEvictionPolicy(config.EvictionPolicyLFU)
+	nearCache, service, _ := newTestNearCache(t, nearCacheConfig)
+	cache(t, nearCache, service, "key1", "value1")
+	cache(t, nearCache, service, "key2", "value2")
+	assertCached(t, nearCache, service, "key2", "value2")
+	assertCached(t, nearCache, service, "key2", "value2")
+	assertCached(t, nearCache, service, "key1", "value1")
+	cache(t, nearCache, service, "key3", "value3")
+	assertNotCached(t, nearCache, service, "key1")
+	assertCached(t, nearCache, service, "key2", "value2")
+}
+
+func TestNearCache_EvictionNone(t *testing.T) {
+	nearCacheConfig := config.NewNearCacheConfig("test")
+	nearCacheConfig.SetMaxEntryCount(1)
+	nearCacheConfig.SetEvictionPolicy(config.EvictionPolicyNone)
+	nearCache, service, _ := newTestNearCache(t, nearCacheConfig)
+	cache(t, nearCache, service, "key1", "value1")
+	if nearCache.TryReserve(toData(t, service, "key2")) != NotReserved {
+		t.Fatal("a full Near Cache without eviction should not accept new keys")
+	}
+	assertCached(t, nearCache, service, "key1", "value1")
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nearcache
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+type stats struct {
+	creationTime  time.Time
+	hits          int64
+	misses        int64
+	evictions     int64
+	expirations   int64
+	invalidations int64
+}
+
+func newStats(creationTime time.Time) *stats {
+	return &stats{creationTime: creationTime}
+}
+
+func (s *stats) snapshot(ownedEntryCount int64) *Stats {
+	return &Stats{
+		creationTime:    s.creationTime,
+		ownedEntryCount: ownedEntryCount,
+		hits:            atomic.LoadInt64(&s.hits),
+		misses:          atomic.LoadInt64(&s.misses),
+		evictions:       atomic.LoadInt64(&s.evictions),
+		expirations:     atomic.LoadInt64(&s.expirations),
+		invalidations:   atomic.LoadInt64(&s.invalidations),
+	}
+}
+
+// Stats is a snapshot of the statistics of a NearCache. It implements core.NearCacheStats.
+type Stats struct {
+	creationTime    time.Time
+	ownedEntryCount int64
+	hits            int64
+	misses          int64
+	evictions       int64
+	expirations     int64
+	invalidations   int64
+}
+
+func (s *Stats) CreationTime() time.Time {
+	return s.creationTime
+}
+
+func (s *Stats) OwnedEntryCount() int64 {
+	return s.ownedEntryCount
+}
+
+func (s *Stats) Hits() int64 {
+	return s.hits
+}
+
+func (s *Stats) Misses() int64 {
+	return s.misses
+}
+
+func (s *Stats) Evictions() int64 {
+	return s.evictions
+}
+
+func (s *Stats) Expirations() int64 {
+	return s.expirations
+}
+
+func (s *Stats) Invalidations() int64 {
+	return s.invalidations
+}
@@ -15,7 +15,6 @@
 package internal
 
 import (
-	"log"
 	"sync/atomic"
 	"time"
 
@@ -86,13 +85,13 @@ func (ps *partitionService) GetPartitionIDWithKey(key interface{}) (int32, error
 func (ps *partitionService) doRefresh() {
 	connection := ps.client.ConnectionManager.getOwnerConnection()
 	if connection == nil {
-		log.Println("Error while fetching cluster partition table!")
+		ps.client.logger.Warn("Error while fetching cluster partition table!")
 		return
 	}
 	request := proto.ClientGetPartitionsEncodeRequest()
 	result, err := ps.client.InvocationService.invokeOnConnection(request, connection).Result()
 	if err != nil {
-		log.Println("Error while fetching cluster partition table! ", err)
+		ps.client.logger.Warn("Error while fetching cluster partition table! ", err)
 		return
 	}
 	ps.processPartitionResponse(result)
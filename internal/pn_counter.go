@@ -15,7 +15,7 @@
 package internal
 
 import (
-	"log"
+	"context"
 	"math"
 	"math/rand"
 	"sync"
@@ -29,6 +29,11 @@ import (
 
 type pnCounterProxy struct {
 	*proxy
+	*pnCounterSession
+}
+
+// pnCounterSession is the state of a PN counter that is shared by its views returned by WithContext.
+type pnCounterSession struct {
 	targetSelectionMutex        sync.RWMutex // guards currentTargetReplicaAddress
 	currentTargetReplicaAddress core.Address
 	maxConfiguredReplicaCount   int32
@@ -39,8 +44,8 @@ type pnCounterProxy struct {
 
 func newPNCounterProxy(client *HazelcastClient, serviceName string, name string) (*pnCounterProxy, error) {
 	pn := &pnCounterProxy{
-		proxy:          &proxy{client, serviceName, name},
-		emptyAddresses: make(map[core.Address]struct{}),
+		proxy:            newProxy(client, serviceName, name),
+		pnCounterSession: &pnCounterSession{emptyAddresses: make(map[core.Address]struct{})},
 	}
 	atomic.StorePointer(&pn.observedClock, unsafe.Pointer(newVectorClock()))
 	pn.random = rand.New(rand.NewSource(time.Now().UnixNano()))
@@ -121,6 +126,10 @@ func (pn *pnCounterProxy) Reset() {
 	atomic.StorePointer(&pn.observedClock, unsafe.Pointer(newVectorClock()))
 }
 
+func (pn *pnCounterProxy) WithContext(ctx context.Context) core.PNCounter {
+	return &pnCounterProxy{proxy: pn.proxy.withContext(ctx), pnCounterSession: pn.pnCounterSession}
+}
+
 func (pn *pnCounterProxy) getCRDTOperationTarget(excludedAddresses map[core.Address]struct{}) (core.Address, error) {
 	pn.targetSelectionMutex.RLock()
 	localCurrentTargetReplicaAddress := pn.currentTargetReplicaAddress
@@ -158,7 +167,7 @@ func (pn *pnCounterProxy) invokeGetInternal(excludedAddresses map[core.Address]s
 		(*vectorClock)(atomic.LoadPointer(&pn.observedClock)).EntrySet(), target.(*proto.Address))
 	response, lastError = pn.invokeOnAddress(request, target.(*proto.Address))
 	if lastError != nil {
-		log.Printf("error occurred while invoking operation on target %v, choosing different target", target)
+		pn.client.logger.Debug("error occurred while invoking operation on target ", target, ", choosing different target")
 		excludedAddresses[target] = struct{}{}
 		newTarget, err := pn.getCRDTOperationTarget(excludedAddresses)
 		if err != nil {
@@ -186,7 +195,8 @@ func (pn *pnCounterProxy) invokeAddInternal(delta int64, getBeforeUpdate bool,
 		(*vectorClock)(atomic.LoadPointer(&pn.observedClock)).EntrySet(), target.(*proto.Address))
 	response, lastError = pn.invokeOnAddress(request, target.(*proto.Address))
 	if lastError != nil {
-		log.Printf("unable to provide session guarantees when sending operations to %v, choosing different target", target)
+		pn.client.logger.Debug("unable to provide session guarantees when sending operations to ", target,
+			", choosing different target")
 		excludedAddresses[target] = struct{}{}
 		newTarget, err := pn.getCRDTOperationTarget(excludedAddresses)
 		if err != nil {
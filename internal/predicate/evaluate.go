@@ -0,0 +1,415 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package predicate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+)
+
+const (
+	keyAttribute  = "__key"
+	thisAttribute = "this"
+)
+
+// IndexQuery describes the part of a predicate that can be answered by an index on Attribute.
+// If Range is false, the matching entries are the ones whose attribute equals one of Values.
+// Otherwise they are the ones whose attribute lies between From and To, where a nil bound is unbounded.
+type IndexQuery struct {
+	Attribute     string
+	Values        []interface{}
+	Range         bool
+	From          interface{}
+	FromInclusive bool
+	To            interface{}
+	ToInclusive   bool
+}
+
+// Evaluate reports whether the entry with the given key and value satisfies the given predicate.
+// Evaluate returns a HazelcastUnsupportedOperationError for the predicates that can only be
+// evaluated by the cluster, such as SQL and InstanceOf.
+func Evaluate(pred interface{}, key interface{}, value interface{}) (bool, error) {
+	switch p := pred.(type) {
+	case nil:
+		return true, nil
+	case *True:
+		return true, nil
+	case *False:
+		return false, nil
+	case *And:
+		for _, inner := range p.predicates {
+			matched, err := Evaluate(inner, key, value)
+			if err != nil || !matched {
+				return false, err
+			}
+		}
+		return true, nil
+	case *Or:
+		for _, inner := range p.predicates {
+			matched, err := Evaluate(inner, key, value)
+			if err != nil || matched {
+				return matched, err
+			}
+		}
+		return false, nil
+	case *Paging:
+		return Evaluate(p.inner, key, value)
+	case *Not:
+		matched, err := Evaluate(p.pred, key, value)
+		return !matched && err == nil, err
+	case *NotEqual:
+		return !Equals(Extract(p.field, key, value), p.value), nil
+	case *Equal:
+		return Equals(Extract(p.field, key, value), p.value), nil
+	case *In:
+		attribute := Extract(p.field, key, value)
+		for _, candidate := range p.values {
+			if Equals(attribute, candidate) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case *GreaterLess:
+		result, ok := Compare(Extract(p.field, key, value), p.value)
+		if !ok {
+			return false, nil
+		}
+		if p.less {
+			return result < 0 || (p.equal && result == 0), nil
+		}
+		return result > 0 || (p.equal && result == 0), nil
+	case *Between:
+		attribute := Extract(p.field, key, value)
+		fromResult, ok := Compare(attribute, p.from)
+		if !ok || fromResult < 0 {
+			return false, nil
+		}
+		toResult, ok := Compare(attribute, p.to)
+		return ok && toResult <= 0, nil
+	case *ILike:
+		return matchLike(p.field, p.expr, true, key, value)
+	case *Like:
+		return matchLike(p.field, p.expr, false, key, value)
+	case *Regex:
+		re, err := regexp.Compile("^(?:" + p.regex + ")$")
+		if err != nil {
+			return false, core.NewHazelcastIllegalArgumentError("invalid regular expression "+p.regex, err)
+		}
+		text, ok := Extract(p.field, key, value).(string)
+		return ok && re.MatchString(text), nil
+	}
+	return false, core.NewHazelcastUnsupportedOperationError(
+		fmt.Sprintf("predicate %T cannot be evaluated locally", pred), nil)
+}
+
+// RefersToValue reports whether the given predicate extracts an attribute of the value of the entries,
+// rather than only the attributes of their keys.
+func RefersToValue(pred interface{}) bool {
+	switch p := pred.(type) {
+	case *And:
+		return anyRefersToValue(p.predicates)
+	case *Or:
+		return anyRefersToValue(p.predicates)
+	case *Not:
+		return RefersToValue(p.pred)
+	case *Paging:
+		return RefersToValue(p.inner)
+	case *Equal:
+		return isValueAttribute(p.field)
+	case *NotEqual:
+		return isValueAttribute(p.field)
+	case *In:
+		return isValueAttribute(p.field)
+	case *GreaterLess:
+		return isValueAttribute(p.field)
+	case *Between:
+		return isValueAttribute(p.field)
+	case *ILike:
+		return isValueAttribute(p.field)
+	case *Like:
+		return isValueAttribute(p.field)
+	case *Regex:
+		return isValueAttribute(p.field)
+	}
+	return false
+}
+
+func anyRefersToValue(predicates []interface{}) bool {
+	for _, inner := range predicates {
+		if RefersToValue(inner) {
+			return true
+		}
+	}
+	return false
+}
+
+func isValueAttribute(attribute string) bool {
+	return strings.Split(attribute, ".")[0] != keyAttribute
+}
+
+// IndexQueries returns the parts of the given predicate that can be looked up in an index.
+// Every entry that satisfies the predicate is matched by each of the returned queries.
+func IndexQueries(pred interface{}) []*IndexQuery {
+	switch p := pred.(type) {
+	case *Equal:
+		return []*IndexQuery{{Attribute: p.field, Values: []interface{}{p.value}}}
+	case *In:
+		return []*IndexQuery{{Attribute: p.field, Values: p.values}}
+	case *GreaterLess:
+		if p.less {
+			return []*IndexQuery{{Attribute: p.field, Range: true, To: p.value, ToInclusive: p.equal}}
+		}
+		return []*IndexQuery{{Attribute: p.field, Range: true, From: p.value, FromInclusive: p.equal}}
+	case *Between:
+		return []*IndexQuery{{Attribute: p.field, Range: true, From: p.from, FromInclusive: true,
+			To: p.to, ToInclusive: true}}
+	case *And:
+		var queries []*IndexQuery
+		for _, inner := range p.predicates {
+			queries = append(queries, IndexQueries(inner)...)
+		}
+		return queries
+	}
+	return nil
+}
+
+// Matches reports whether the given attribute value satisfies the index query.
+func (q *IndexQuery) Matches(attribute interface{}) bool {
+	if !q.Range {
+		for _, value := range q.Values {
+			if Equals(attribute, value) {
+				return true
+			}
+		}
+		return false
+	}
+	if q.From != nil {
+		result, ok := Compare(attribute, q.From)
+		if !ok || result < 0 || (result == 0 && !q.FromInclusive) {
+			return false
+		}
+	}
+	if q.To != nil {
+		result, ok := Compare(attribute, q.To)
+		if !ok || result > 0 || (result == 0 && !q.ToInclusive) {
+			return false
+		}
+	}
+	return true
+}
+
+// Extract returns the value of the given attribute of an entry.
+// The attribute "this" refers to the value itself and "__key" to the key. Nested fields of structs
+// and string keyed maps are separated by dots, as in "address.city" or "__key.id".
+// Struct fields are looked up by their exact name first and then case insensitively.
+// Extract returns nil if the attribute cannot be found.
+func Extract(attribute string, key interface{}, value interface{}) interface{} {
+	target := value
+	path := strings.Split(attribute, ".")
+	switch path[0] {
+	case keyAttribute:
+		target = key
+		path = path[1:]
+	case thisAttribute:
+		path = path[1:]
+	}
+	current := reflect.ValueOf(target)
+	for _, name := range path {
+		current = field(parseJSON(current), name)
+	}
+	return normalizeValue(parseJSON(current))
+}
+
+var jsonValueType = reflect.TypeOf(core.JSONValue(""))
+
+// parseJSON replaces a core.JSONValue with its parsed document, so that the attributes
+// are extracted from the JSON fields as the members do for HazelcastJsonValue.
+func parseJSON(v reflect.Value) reflect.Value {
+	if !v.IsValid() || v.Type() != jsonValueType {
+		return v
+	}
+	var document interface{}
+	if err := json.Unmarshal([]byte(v.String()), &document); err != nil {
+		return reflect.Value{}
+	}
+	return reflect.ValueOf(document)
+}
+
+func field(v reflect.Value, name string) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		if f := v.FieldByName(name); f.IsValid() {
+			return f
+		}
+		return v.FieldByNameFunc(func(fieldName string) bool {
+			return strings.EqualFold(fieldName, name)
+		})
+	case reflect.Map:
+		if v.Type().Key().Kind() == reflect.String {
+			return v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+		}
+	}
+	return reflect.Value{}
+}
+
+// Normalize converts the given value to the representation used for comparisons:
+// signed and unsigned integers become int64, floating point numbers become float64, and
+// integral floating point numbers become int64 so that they can be used as index keys.
+func Normalize(value interface{}) interface{} {
+	return normalizeValue(reflect.ValueOf(value))
+}
+
+// normalizeValue also reads unexported struct fields, which cannot be converted to interface{} directly.
+func normalizeValue(v reflect.Value) interface{} {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return int64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if f == math.Trunc(f) && math.Abs(f) < math.MaxInt64 {
+			return int64(f)
+		}
+		return f
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return v.Bool()
+	}
+	if v.CanInterface() {
+		return v.Interface()
+	}
+	return nil
+}
+
+// Compare compares the normalized forms of the given values.
+// It returns a negative number if a < b, zero if a == b and a positive number if a > b.
+// Compare returns false if the values are not comparable.
+func Compare(a interface{}, b interface{}) (int, bool) {
+	a, b = Normalize(a), Normalize(b)
+	switch x := a.(type) {
+	case int64:
+		switch y := b.(type) {
+		case int64:
+			return compareInt64(x, y), true
+		case float64:
+			return compareFloat64(float64(x), y), true
+		}
+	case float64:
+		switch y := b.(type) {
+		case int64:
+			return compareFloat64(x, float64(y)), true
+		case float64:
+			return compareFloat64(x, y), true
+		}
+	case string:
+		if y, ok := b.(string); ok {
+			return strings.Compare(x, y), true
+		}
+	case bool:
+		if y, ok := b.(bool); ok {
+			if x == y {
+				return 0, true
+			}
+			if y {
+				return -1, true
+			}
+			return 1, true
+		}
+	}
+	return 0, false
+}
+
+// Equals reports whether the given values are equal after normalization.
+func Equals(a interface{}, b interface{}) bool {
+	if result, ok := Compare(a, b); ok {
+		return result == 0
+	}
+	return reflect.DeepEqual(Normalize(a), Normalize(b))
+}
+
+func compareInt64(x int64, y int64) int {
+	if x < y {
+		return -1
+	}
+	if x > y {
+		return 1
+	}
+	return 0
+}
+
+func compareFloat64(x float64, y float64) int {
+	if x < y {
+		return -1
+	}
+	if x > y {
+		return 1
+	}
+	return 0
+}
+
+func matchLike(field string, expr string, ignoreCase bool, key interface{}, value interface{}) (bool, error) {
+	text, ok := Extract(field, key, value).(string)
+	if !ok {
+		return false, nil
+	}
+	var pattern bytes.Buffer
+	pattern.WriteString("(?s)")
+	if ignoreCase {
+		pattern.WriteString("(?i)")
+	}
+	pattern.WriteString("^")
+	escaped := false
+	for _, r := range expr {
+		switch {
+		case escaped:
+			pattern.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			pattern.WriteString(".*")
+		case r == '_':
+			pattern.WriteString(".")
+		default:
+			pattern.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	pattern.WriteString("$")
+	re, err := regexp.Compile(pattern.String())
+	if err != nil {
+		return false, core.NewHazelcastIllegalArgumentError("invalid like expression "+expr, err)
+	}
+	return re.MatchString(text), nil
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package predicate
+
+import (
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+)
+
+type address struct {
+	City string
+}
+
+type employee struct {
+	Name    string
+	age     int32
+	Salary  float64
+	Active  bool
+	Address *address
+	Tags    map[string]interface{}
+}
+
+type employeeKey struct {
+	ID int64
+}
+
+func TestEvaluate(t *testing.T) {
+	key := &employeeKey{ID: 7}
+	value := &employee{Name: "Joe Smith", age: 30, Salary: 1500.5, Active: true,
+		Address: &address{City: "Istanbul"}, Tags: map[string]interface{}{"team": "core"}}
+	testCases := []struct {
+		name     string
+		pred     interface{}
+		expected bool
+	}{
+		{"nil", nil, true},
+		{"true", NewTrue(), true},
+		{"false", NewFalse(), false},
+		{"equal", NewEqual("Name", "Joe Smith"), true},
+		{"equalDifferentIntType", NewEqual("age", int64(30)), true},
+		{"equalCaseInsensitiveField", NewEqual("name", "Joe Smith"), true},
+		{"equalNested", NewEqual("Address.City", "Istanbul"), true},
+		{"equalMap", NewEqual("Tags.team", "core"), true},
+		{"equalKey", NewEqual("__key.ID", 7), true},
+		{"equalMissingField", NewEqual("missing", "Joe Smith"), false},
+		{"notEqual", NewNotEqual("Name", "Jane"), true},
+		{"greater", NewGreaterLess("age", 29, false, false), true},
+		{"greaterNotEqual", NewGreaterLess("age", 30, false, false), false},
+		{"greaterEqual", NewGreaterLess("age", 30, true, false), true},
+		{"less", NewGreaterLess("Salary", 1500, false, true), false},
+		{"lessFloat", NewGreaterLess("Salary", 1500.75, false, true), true},
+		{"between", NewBetween("age", 30, 40), true},
+		{"notBetween", NewBetween("age", 31, 40), false},
+		{"in", NewIn("age", []interface{}{10, 20, 30}), true},
+		{"notIn", NewIn("age", []interface{}{10, 20}), false},
+		{"like", NewLike("Name", "Joe%"), true},
+		{"likeSingleChar", NewLike("Name", "J_e Smith"), true},
+		{"likeCase", NewLike("Name", "joe%"), false},
+		{"likeEscaped", NewLike("Name", "Joe\\%"), false},
+		{"ilike", NewILike("Name", "joe%"), true},
+		{"regex", NewRegex("Name", "J.*h"), true},
+		{"regexFullMatch", NewRegex("Name", "Joe"), false},
+		{"bool", NewEqual("Active", true), true},
+		{"and", NewAnd([]interface{}{NewEqual("Active", true), NewGreaterLess("age", 18, true, false)}), true},
+		{"andFalse", NewAnd([]interface{}{NewEqual("Active", true), NewEqual("age", 18)}), false},
+		{"or", NewOr([]interface{}{NewEqual("age", 18), NewLike("Name", "%Smith")}), true},
+		{"not", NewNot(NewEqual("Active", true)), false},
+	}
+	for _, testCase := range testCases {
+		matched, err := Evaluate(testCase.pred, key, value)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", testCase.name, err)
+		}
+		if matched != testCase.expected {
+			t.Errorf("%s: expected %t got %t", testCase.name, testCase.expected, matched)
+		}
+	}
+}
+
+func TestEvaluate_This(t *testing.T) {
+	matched, err := Evaluate(NewGreaterLess("this", 10, false, false), "key", int32(20))
+	if err != nil || !matched {
+		t.Errorf("expected this attribute to match, got %t, %v", matched, err)
+	}
+}
+
+func TestEvaluate_JSONValue(t *testing.T) {
+	value := core.JSONValue(`{"name": "Joe", "age": 30, "address": {"city": "Istanbul"}}`)
+	testCases := []struct {
+		name     string
+		pred     interface{}
+		value    interface{}
+		expected bool
+	}{
+		{"equal", NewEqual("name", "Joe"), value, true},
+		{"equalNested", NewEqual("address.city", "Istanbul"), value, true},
+		{"equalNumber", NewEqual("age", int32(30)), value, true},
+		{"greater", NewGreaterLess("age", 40, false, false), value, false},
+		{"missing", NewEqual("address.street", "Main"), value, false},
+		{"this", NewEqual("this", "Joe"), core.JSONValue(`"Joe"`), true},
+		{"invalid", NewEqual("name", "Joe"), core.JSONValue(`{"name":`), false},
+	}
+	for _, testCase := range testCases {
+		matched, err := Evaluate(testCase.pred, "key", testCase.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if matched != testCase.expected {
+			t.Errorf("%s: expected %t got %t", testCase.name, testCase.expected, matched)
+		}
+	}
+}
+
+func TestEvaluate_Unsupported(t *testing.T) {
+	_, err := Evaluate(NewSQL("age > 10"), nil, nil)
+	if _, ok := err.(*core.HazelcastUnsupportedOperationError); !ok {
+		t.Errorf("expected HazelcastUnsupportedOperationError, got %v", err)
+	}
+}
+
+func TestRefersToValue(t *testing.T) {
+	testCases := []struct {
+		name     string
+		pred     interface{}
+		expected bool
+	}{
+		{"true", NewTrue(), false},
+		{"key", NewEqual("__key", 1), false},
+		{"keyField", NewGreaterLess("__key.ID", 1, false, false), false},
+		{"this", NewEqual("this", 1), true},
+		{"valueField", NewLike("Name", "J%"), true},
+		{"andOfKeys", NewAnd([]interface{}{NewEqual("__key.ID", 1), NewNotEqual("__key", 2)}), false},
+		{"orWithValue", NewOr([]interface{}{NewEqual("__key.ID", 1), NewEqual("age", 30)}), true},
+		{"notOfValue", NewNot(NewBetween("age", 1, 2)), true},
+		{"paging", NewPaging(NewEqual("age", 30), 10, nil), true},
+	}
+	for _, testCase := range testCases {
+		if actual := RefersToValue(testCase.pred); actual != testCase.expected {
+			t.Errorf("%s: expected %t, got %t", testCase.name, testCase.expected, actual)
+		}
+	}
+}
+
+func TestIndexQueries(t *testing.T) {
+	pred := NewAnd([]interface{}{NewLike("Name", "J%"), NewEqual("age", 30), NewBetween("Salary", 10, 20)})
+	queries := IndexQueries(pred)
+	if len(queries) != 2 {
+		t.Fatalf("expected 2 index queries, got %d", len(queries))
+	}
+	if queries[0].Attribute != "age" || queries[0].Range || !queries[0].Matches(int32(30)) {
+		t.Errorf("unexpected equality query %+v", queries[0])
+	}
+	if queries[1].Attribute != "Salary" || !queries[1].Range || !queries[1].Matches(20.0) ||
+		queries[1].Matches(20.5) {
+		t.Errorf("unexpected range query %+v", queries[1])
+	}
+	if IndexQueries(NewOr([]interface{}{NewEqual("age", 30)})) != nil {
+		t.Error("expected no index queries for or predicate")
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package predicate
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+// The iteration types tell the members which part of the entries a paging query returns.
+const (
+	IterationTypeKey   = "KEY"
+	IterationTypeValue = "VALUE"
+	IterationTypeEntry = "ENTRY"
+)
+
+type entry struct {
+	key   interface{}
+	value interface{}
+}
+
+func (e *entry) Key() interface{} {
+	return e.key
+}
+
+func (e *entry) Value() interface{} {
+	return e.value
+}
+
+type anchor struct {
+	page  int32
+	entry *entry
+}
+
+// Paging is a core.PagingPredicate. Its anchors are the last entries of the pages that it has returned,
+// the anchor of a page is at the index of the page.
+type Paging struct {
+	*predicate
+	inner         interface{}
+	comparator    core.Comparator
+	page          int32
+	pageSize      int32
+	iterationType string
+	anchors       []*anchor
+}
+
+// NewPaging returns a Paging predicate that filters the entries with the given inner predicate, which can be nil.
+// The arguments are checked by Validate when the predicate is used in a query.
+func NewPaging(inner interface{}, pageSize int32, comparator core.Comparator) *Paging {
+	return &Paging{predicate: newPredicate(pagingID), inner: inner, comparator: comparator, pageSize: pageSize,
+		iterationType: IterationTypeEntry}
+}
+
+// Validate returns a HazelcastIllegalArgumentError if the page size is not positive or the inner predicate
+// is a Paging predicate.
+func (pp *Paging) Validate() error {
+	if pp.pageSize <= 0 {
+		return core.NewHazelcastIllegalArgumentError(fmt.Sprintf("pageSize should be positive, found %d", pp.pageSize), nil)
+	}
+	if _, ok := pp.inner.(*Paging); ok {
+		return core.NewHazelcastIllegalArgumentError("nested paging predicates are not supported", nil)
+	}
+	return nil
+}
+
+func (pp *Paging) ReadData(input serialization.DataInput) error {
+	var err error
+	pp.predicate = newPredicate(pagingID)
+	if pp.inner, err = input.ReadObject(); err != nil {
+		return err
+	}
+	comparator, err := input.ReadObject()
+	if err != nil {
+		return err
+	}
+	if comparator != nil {
+		var ok bool
+		if pp.comparator, ok = comparator.(core.Comparator); !ok {
+			return core.NewHazelcastSerializationError(fmt.Sprintf("%T is not a comparator", comparator), nil)
+		}
+	}
+	if pp.page, err = input.ReadInt32(); err != nil {
+		return err
+	}
+	if pp.pageSize, err = input.ReadInt32(); err != nil {
+		return err
+	}
+	if pp.iterationType, err = input.ReadUTF(); err != nil {
+		return err
+	}
+	length, err := input.ReadInt32()
+	if err != nil {
+		return err
+	}
+	pp.anchors = make([]*anchor, length)
+	for i := int32(0); i < length; i++ {
+		a := &anchor{entry: &entry{}}
+		if a.page, err = input.ReadInt32(); err != nil {
+			return err
+		}
+		if a.entry.key, err = input.ReadObject(); err != nil {
+			return err
+		}
+		if a.entry.value, err = input.ReadObject(); err != nil {
+			return err
+		}
+		pp.anchors[i] = a
+	}
+	return nil
+}
+
+func (pp *Paging) WriteData(output serialization.DataOutput) error {
+	if err := output.WriteObject(pp.inner); err != nil {
+		return err
+	}
+	if err := output.WriteObject(pp.comparator); err != nil {
+		return err
+	}
+	output.WriteInt32(pp.page)
+	output.WriteInt32(pp.pageSize)
+	output.WriteUTF(pp.iterationType)
+	output.WriteInt32(int32(len(pp.anchors)))
+	for _, a := range pp.anchors {
+		output.WriteInt32(a.page)
+		if err := output.WriteObject(a.entry.key); err != nil {
+			return err
+		}
+		if err := output.WriteObject(a.entry.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pp *Paging) NextPage() {
+	pp.page++
+}
+
+func (pp *Paging) PreviousPage() {
+	if pp.page > 0 {
+		pp.page--
+	}
+}
+
+func (pp *Paging) SetPage(page int32) {
+	if page < 0 {
+		page = 0
+	}
+	pp.page = page
+}
+
+func (pp *Paging) Page() int32 {
+	return pp.page
+}
+
+func (pp *Paging) PageSize() int32 {
+	return pp.pageSize
+}
+
+func (pp *Paging) Comparator() core.Comparator {
+	return pp.comparator
+}
+
+func (pp *Paging) Anchor() (page int32, anchor core.Pair) {
+	nearest := pp.nearestAnchor()
+	if nearest == nil {
+		return -1, nil
+	}
+	return nearest.page, nearest.entry
+}
+
+func (pp *Paging) Reset() {
+	pp.page = 0
+	pp.anchors = nil
+}
+
+// SetIterationType sets the part of the entries that the members return for the predicate.
+func (pp *Paging) SetIterationType(iterationType string) {
+	pp.iterationType = iterationType
+}
+
+func (pp *Paging) nearestAnchor() *anchor {
+	if pp.page <= 0 || len(pp.anchors) == 0 {
+		return nil
+	}
+	if int(pp.page) < len(pp.anchors) {
+		return pp.anchors[pp.page-1]
+	}
+	return pp.anchors[len(pp.anchors)-1]
+}
+
+func (pp *Paging) setAnchor(page int32, a *entry) {
+	switch {
+	case int(page) < len(pp.anchors):
+		pp.anchors[page] = &anchor{page, a}
+	case int(page) == len(pp.anchors):
+		pp.anchors = append(pp.anchors, &anchor{page, a})
+	}
+}
+
+// SortedPage sorts the given query results and returns the ones on the current page.
+// The results returned by the members start after the nearest anchor, so they may cover more than one page;
+// the anchors of the pages between the nearest anchor and the current page are recorded.
+func (pp *Paging) SortedPage(entries []core.Pair) []core.Pair {
+	if len(entries) == 0 {
+		return entries
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return pp.compare(entries[i], entries[j]) < 0
+	})
+	nearestPage := int32(-1)
+	if nearest := pp.nearestAnchor(); nearest != nil {
+		nearestPage = nearest.page
+	}
+	begin := int(pp.pageSize * (pp.page - nearestPage - 1))
+	if begin > len(entries) {
+		return []core.Pair{}
+	}
+	end := begin + int(pp.pageSize)
+	if end > len(entries) {
+		end = len(entries)
+	}
+	for i := int(pp.pageSize); i <= len(entries) && nearestPage < pp.page; i += int(pp.pageSize) {
+		nearestPage++
+		pp.setAnchor(nearestPage, &entry{entries[i-1].Key(), entries[i-1].Value()})
+	}
+	return entries[begin:end]
+}
+
+func (pp *Paging) compare(entry1 core.Pair, entry2 core.Pair) int {
+	if pp.comparator != nil {
+		if result := pp.comparator.Compare(entry1, entry2); result != 0 {
+			return result
+		}
+		result, _ := Compare(entry1.Key(), entry2.Key())
+		return result
+	}
+	switch pp.iterationType {
+	case IterationTypeKey:
+		result, _ := Compare(entry1.Key(), entry2.Key())
+		return result
+	case IterationTypeValue:
+		result, _ := Compare(entry1.Value(), entry2.Value())
+		return result
+	}
+	if result, ok := Compare(entry1.Value(), entry2.Value()); ok && result != 0 {
+		return result
+	}
+	result, _ := Compare(entry1.Key(), entry2.Key())
+	return result
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package predicate
+
+import (
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+)
+
+type reverseComparator struct{}
+
+func (reverseComparator) Compare(entry1 core.Pair, entry2 core.Pair) int {
+	return int(entry2.Value().(int32) - entry1.Value().(int32))
+}
+
+// queryAfterAnchor returns the values of the entries that a member returns for the paging predicate:
+// the ones that come after its nearest anchor, in no particular order.
+func queryAfterAnchor(pp *Paging, values ...int32) []core.Pair {
+	_, anchor := pp.Anchor()
+	var entries []core.Pair
+	for i := len(values) - 1; i >= 0; i-- {
+		if anchor == nil || pp.compare(&entry{values[i], values[i]}, anchor) > 0 {
+			entries = append(entries, &entry{values[i], values[i]})
+		}
+	}
+	return entries
+}
+
+func assertPage(t *testing.T, page []core.Pair, expected ...int32) {
+	if len(page) != len(expected) {
+		t.Fatalf("expected %v got %d entries", expected, len(page))
+	}
+	for i, value := range expected {
+		if page[i].Value() != value {
+			t.Fatalf("expected %v, got %v at %d", expected, page[i].Value(), i)
+		}
+	}
+}
+
+func TestPaging_SortedPage(t *testing.T) {
+	values := []int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	pp := NewPaging(nil, 3, nil)
+	assertPage(t, pp.SortedPage(queryAfterAnchor(pp, values...)), 0, 1, 2)
+	pp.NextPage()
+	assertPage(t, pp.SortedPage(queryAfterAnchor(pp, values...)), 3, 4, 5)
+	pp.SetPage(3)
+	if page, anchor := pp.Anchor(); page != 1 || anchor.Value() != int32(5) {
+		t.Errorf("expected the anchor of page 1, got %d %v", page, anchor)
+	}
+	assertPage(t, pp.SortedPage(queryAfterAnchor(pp, values...)), 9)
+	if len(pp.anchors) != 3 || pp.anchors[2].entry.Value() != int32(8) {
+		t.Errorf("expected the anchor of the skipped page to be recorded, got %v", pp.anchors)
+	}
+	pp.PreviousPage()
+	assertPage(t, pp.SortedPage(queryAfterAnchor(pp, values...)), 6, 7, 8)
+	pp.SetPage(5)
+	assertPage(t, pp.SortedPage(queryAfterAnchor(pp, values...)))
+	pp.Reset()
+	if page, anchor := pp.Anchor(); pp.Page() != 0 || page != -1 || anchor != nil {
+		t.Errorf("expected the predicate to be reset, got page %d anchor %d %v", pp.Page(), page, anchor)
+	}
+}
+
+func TestPaging_SortedPageWithComparator(t *testing.T) {
+	pp := NewPaging(NewGreaterLess("this", 2, false, false), 2, reverseComparator{})
+	assertPage(t, pp.SortedPage(queryAfterAnchor(pp, 3, 4, 5, 6, 7)), 7, 6)
+	pp.NextPage()
+	assertPage(t, pp.SortedPage(queryAfterAnchor(pp, 3, 4, 5, 6, 7)), 5, 4)
+}
+
+func TestPaging_SortedPageByKeys(t *testing.T) {
+	pp := NewPaging(nil, 2, nil)
+	pp.SetIterationType(IterationTypeKey)
+	page := pp.SortedPage([]core.Pair{&entry{"b", 1}, &entry{"c", 0}, &entry{"a", 2}})
+	if len(page) != 2 || page[0].Key() != "a" || page[1].Key() != "b" {
+		t.Errorf("expected the entries to be sorted by keys, got %v", page)
+	}
+}
+
+func TestPaging_PreviousPageOnFirstPage(t *testing.T) {
+	pp := NewPaging(nil, 2, nil)
+	pp.PreviousPage()
+	if pp.Page() != 0 {
+		t.Errorf("expected the first page, got %d", pp.Page())
+	}
+}
+
+func TestPaging_SetNegativePage(t *testing.T) {
+	pp := NewPaging(nil, 2, nil)
+	assertPage(t, pp.SortedPage(queryAfterAnchor(pp, 0, 1, 2, 3)), 0, 1)
+	pp.SetPage(-2)
+	if pp.Page() != 0 {
+		t.Errorf("expected the first page, got %d", pp.Page())
+	}
+	assertPage(t, pp.SortedPage(queryAfterAnchor(pp, 0, 1, 2, 3)), 0, 1)
+}
+
+func TestPaging_Validate(t *testing.T) {
+	if err := NewPaging(nil, 1, nil).Validate(); err != nil {
+		t.Errorf("expected a valid predicate, got %v", err)
+	}
+	if _, ok := NewPaging(nil, 0, nil).Validate().(*core.HazelcastIllegalArgumentError); !ok {
+		t.Error("expected HazelcastIllegalArgumentError for a zero pageSize")
+	}
+	if _, ok := NewPaging(NewPaging(nil, 1, nil), 1, nil).Validate().(*core.HazelcastIllegalArgumentError); !ok {
+		t.Error("expected HazelcastIllegalArgumentError for a nested paging predicate")
+	}
+}
+
+func TestEvaluate_Paging(t *testing.T) {
+	matched, err := Evaluate(NewPaging(NewEqual("this", 1), 10, nil), "key", 1)
+	if err != nil || !matched {
+		t.Errorf("expected the inner predicate to be evaluated, got %t, %v", matched, err)
+	}
+}
@@ -30,7 +30,7 @@ const (
 	regexID
 	falseID
 	trueID
-	// pagingID
+	pagingID
 	// partitionID
 	// nilObjectID
 )
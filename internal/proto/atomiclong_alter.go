@@ -0,0 +1,43 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+func atomiclongAlterCalculateSize(name string, function *serialization.Data) int {
+	// Calculates the request payload size
+	dataSize := 0
+	dataSize += stringCalculateSize(name)
+	dataSize += dataCalculateSize(function)
+	return dataSize
+}
+
+// AtomicLongAlterEncodeRequest creates and encodes a client message
+// with the given parameters.
+// It returns the encoded client message.
+func AtomicLongAlterEncodeRequest(name string, function *serialization.Data) *ClientMessage {
+	// Encode request into clientMessage
+	clientMessage := NewClientMessage(nil, atomiclongAlterCalculateSize(name, function))
+	clientMessage.SetMessageType(atomiclongAlter)
+	clientMessage.IsRetryable = false
+	clientMessage.AppendString(name)
+	clientMessage.AppendData(function)
+	clientMessage.UpdateFrameLength()
+	return clientMessage
+}
+
+// AtomicLongAlterDecodeResponse(clientMessage *ClientMessage), this message has no parameters to decode
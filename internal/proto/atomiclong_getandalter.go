@@ -0,0 +1,51 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+func atomiclongGetAndAlterCalculateSize(name string, function *serialization.Data) int {
+	// Calculates the request payload size
+	dataSize := 0
+	dataSize += stringCalculateSize(name)
+	dataSize += dataCalculateSize(function)
+	return dataSize
+}
+
+// AtomicLongGetAndAlterEncodeRequest creates and encodes a client message
+// with the given parameters.
+// It returns the encoded client message.
+func AtomicLongGetAndAlterEncodeRequest(name string, function *serialization.Data) *ClientMessage {
+	// Encode request into clientMessage
+	clientMessage := NewClientMessage(nil, atomiclongGetAndAlterCalculateSize(name, function))
+	clientMessage.SetMessageType(atomiclongGetAndAlter)
+	clientMessage.IsRetryable = false
+	clientMessage.AppendString(name)
+	clientMessage.AppendData(function)
+	clientMessage.UpdateFrameLength()
+	return clientMessage
+}
+
+// AtomicLongGetAndAlterDecodeResponse decodes the given client message.
+// It returns a function which returns the response parameters.
+func AtomicLongGetAndAlterDecodeResponse(clientMessage *ClientMessage) func() (response int64) {
+	// Decode response from client message
+	return func() (response int64) {
+		response = clientMessage.ReadInt64()
+		return
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+const (
+	atomiclongApply           = 0x0a01
+	atomiclongAlter           = 0x0a02
+	atomiclongAlterAndGet     = 0x0a03
+	atomiclongGetAndAlter     = 0x0a04
+	atomiclongAddAndGet       = 0x0a05
+	atomiclongCompareAndSet   = 0x0a06
+	atomiclongDecrementAndGet = 0x0a07
+	atomiclongGet             = 0x0a08
+	atomiclongGetAndAdd       = 0x0a09
+	atomiclongGetAndSet       = 0x0a0a
+	atomiclongIncrementAndGet = 0x0a0b
+	atomiclongGetAndIncrement = 0x0a0c
+	atomiclongSet             = 0x0a0d
+)
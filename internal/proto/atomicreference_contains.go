@@ -0,0 +1,58 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+func atomicreferenceContainsCalculateSize(name string, expected *serialization.Data) int {
+	// Calculates the request payload size
+	dataSize := 0
+	dataSize += stringCalculateSize(name)
+	dataSize += bufutil.BoolSizeInBytes
+	if expected != nil {
+		dataSize += dataCalculateSize(expected)
+	}
+	return dataSize
+}
+
+// AtomicReferenceContainsEncodeRequest creates and encodes a client message
+// with the given parameters.
+// It returns the encoded client message.
+func AtomicReferenceContainsEncodeRequest(name string, expected *serialization.Data) *ClientMessage {
+	// Encode request into clientMessage
+	clientMessage := NewClientMessage(nil, atomicreferenceContainsCalculateSize(name, expected))
+	clientMessage.SetMessageType(atomicreferenceContains)
+	clientMessage.IsRetryable = true
+	clientMessage.AppendString(name)
+	clientMessage.AppendBool(expected == nil)
+	if expected != nil {
+		clientMessage.AppendData(expected)
+	}
+	clientMessage.UpdateFrameLength()
+	return clientMessage
+}
+
+// AtomicReferenceContainsDecodeResponse decodes the given client message.
+// It returns a function which returns the response parameters.
+func AtomicReferenceContainsDecodeResponse(clientMessage *ClientMessage) func() (response bool) {
+	// Decode response from client message
+	return func() (response bool) {
+		response = clientMessage.ReadBool()
+		return
+	}
+}
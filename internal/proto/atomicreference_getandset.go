@@ -0,0 +1,61 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+func atomicreferenceGetAndSetCalculateSize(name string, newValue *serialization.Data) int {
+	// Calculates the request payload size
+	dataSize := 0
+	dataSize += stringCalculateSize(name)
+	dataSize += bufutil.BoolSizeInBytes
+	if newValue != nil {
+		dataSize += dataCalculateSize(newValue)
+	}
+	return dataSize
+}
+
+// AtomicReferenceGetAndSetEncodeRequest creates and encodes a client message
+// with the given parameters.
+// It returns the encoded client message.
+func AtomicReferenceGetAndSetEncodeRequest(name string, newValue *serialization.Data) *ClientMessage {
+	// Encode request into clientMessage
+	clientMessage := NewClientMessage(nil, atomicreferenceGetAndSetCalculateSize(name, newValue))
+	clientMessage.SetMessageType(atomicreferenceGetAndSet)
+	clientMessage.IsRetryable = false
+	clientMessage.AppendString(name)
+	clientMessage.AppendBool(newValue == nil)
+	if newValue != nil {
+		clientMessage.AppendData(newValue)
+	}
+	clientMessage.UpdateFrameLength()
+	return clientMessage
+}
+
+// AtomicReferenceGetAndSetDecodeResponse decodes the given client message.
+// It returns a function which returns the response parameters.
+func AtomicReferenceGetAndSetDecodeResponse(clientMessage *ClientMessage) func() (response *serialization.Data) {
+	// Decode response from client message
+	return func() (response *serialization.Data) {
+
+		if !clientMessage.ReadBool() {
+			response = clientMessage.ReadData()
+		}
+		return
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+const (
+	atomicreferenceApply         = 0x0b01
+	atomicreferenceAlter         = 0x0b02
+	atomicreferenceAlterAndGet   = 0x0b03
+	atomicreferenceGetAndAlter   = 0x0b04
+	atomicreferenceContains      = 0x0b05
+	atomicreferenceCompareAndSet = 0x0b06
+	atomicreferenceGet           = 0x0b08
+	atomicreferenceSet           = 0x0b09
+	atomicreferenceClear         = 0x0b0a
+	atomicreferenceGetAndSet     = 0x0b0b
+	atomicreferenceSetAndGet     = 0x0b0c
+	atomicreferenceIsNull        = 0x0b0d
+)
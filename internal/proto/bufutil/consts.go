@@ -27,6 +27,7 @@ const (
 	ServiceNameReliableTopic               = "hz:impl:reliableTopicService"
 	ServiceNameReplicatedMap               = "hz:impl:replicatedMapService"
 	ServiceNameRingbufferService           = "hz:impl:ringbufferService"
+	ServiceNameScheduledExecutor           = "hz:impl:scheduledExecutorService"
 	ServiceNameSemaphore                   = "hz:impl:semaphoreService"
 	ServiceNameSet                         = "hz:impl:setService"
 	ServiceNameQueue                       = "hz:impl:queueService"
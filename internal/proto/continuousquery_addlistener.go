@@ -0,0 +1,95 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+)
+
+func continuousQueryAddListenerCalculateSize(listenerName string, localOnly bool) int {
+	// Calculates the request payload size
+	dataSize := 0
+	dataSize += stringCalculateSize(listenerName)
+	dataSize += bufutil.BoolSizeInBytes
+	return dataSize
+}
+
+// ContinuousQueryAddListenerEncodeRequest creates and encodes a client message
+// with the given parameters.
+// It returns the encoded client message.
+func ContinuousQueryAddListenerEncodeRequest(listenerName string, localOnly bool) *ClientMessage {
+	// Encode request into clientMessage
+	clientMessage := NewClientMessage(nil, continuousQueryAddListenerCalculateSize(listenerName, localOnly))
+	clientMessage.SetMessageType(continuousQueryAddListener)
+	clientMessage.IsRetryable = false
+	clientMessage.AppendString(listenerName)
+	clientMessage.AppendBool(localOnly)
+	clientMessage.UpdateFrameLength()
+	return clientMessage
+}
+
+// ContinuousQueryAddListenerDecodeResponse decodes the given client message.
+// It returns a function which returns the response parameters.
+func ContinuousQueryAddListenerDecodeResponse(clientMessage *ClientMessage) func() (response string) {
+	// Decode response from client message
+	return func() (response string) {
+		response = clientMessage.ReadString()
+		return
+	}
+}
+
+// ContinuousQueryAddListenerHandleEventQueryCacheSingleFunc is the event handler function.
+type ContinuousQueryAddListenerHandleEventQueryCacheSingleFunc func(*QueryCacheEventData)
+
+// ContinuousQueryAddListenerHandleEventQueryCacheBatchFunc is the event handler function.
+type ContinuousQueryAddListenerHandleEventQueryCacheBatchFunc func([]*QueryCacheEventData, string, int32)
+
+// ContinuousQueryAddListenerEventQueryCacheSingleDecode decodes the corresponding event
+// from the given client message.
+// It returns the result parameters for the event.
+func ContinuousQueryAddListenerEventQueryCacheSingleDecode(clientMessage *ClientMessage) (data *QueryCacheEventData) {
+	data = QueryCacheEventDataCodecDecode(clientMessage)
+	return
+}
+
+// ContinuousQueryAddListenerEventQueryCacheBatchDecode decodes the corresponding event
+// from the given client message.
+// It returns the result parameters for the event.
+func ContinuousQueryAddListenerEventQueryCacheBatchDecode(clientMessage *ClientMessage) (
+	events []*QueryCacheEventData, source string, partitionID int32) {
+	eventsSize := clientMessage.ReadInt32()
+	events = make([]*QueryCacheEventData, eventsSize)
+	for eventsIndex := 0; eventsIndex < int(eventsSize); eventsIndex++ {
+		events[eventsIndex] = QueryCacheEventDataCodecDecode(clientMessage)
+	}
+	source = clientMessage.ReadString()
+	partitionID = clientMessage.ReadInt32()
+	return
+}
+
+// ContinuousQueryAddListenerHandle handles the event with the given
+// event handler functions.
+func ContinuousQueryAddListenerHandle(clientMessage *ClientMessage,
+	handleEventQueryCacheSingle ContinuousQueryAddListenerHandleEventQueryCacheSingleFunc,
+	handleEventQueryCacheBatch ContinuousQueryAddListenerHandleEventQueryCacheBatchFunc) {
+	// Event handler
+	messageType := clientMessage.MessageType()
+	if messageType == bufutil.EventQueryCacheSingle && handleEventQueryCacheSingle != nil {
+		handleEventQueryCacheSingle(ContinuousQueryAddListenerEventQueryCacheSingleDecode(clientMessage))
+	}
+	if messageType == bufutil.EventQueryCacheBatch && handleEventQueryCacheBatch != nil {
+		handleEventQueryCacheBatch(ContinuousQueryAddListenerEventQueryCacheBatchDecode(clientMessage))
+	}
+}
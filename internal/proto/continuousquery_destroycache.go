@@ -0,0 +1,47 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+func continuousQueryDestroyCacheCalculateSize(mapName string, cacheName string) int {
+	// Calculates the request payload size
+	dataSize := 0
+	dataSize += stringCalculateSize(mapName)
+	dataSize += stringCalculateSize(cacheName)
+	return dataSize
+}
+
+// ContinuousQueryDestroyCacheEncodeRequest creates and encodes a client message
+// with the given parameters.
+// It returns the encoded client message.
+func ContinuousQueryDestroyCacheEncodeRequest(mapName string, cacheName string) *ClientMessage {
+	// Encode request into clientMessage
+	clientMessage := NewClientMessage(nil, continuousQueryDestroyCacheCalculateSize(mapName, cacheName))
+	clientMessage.SetMessageType(continuousQueryDestroyCache)
+	clientMessage.IsRetryable = false
+	clientMessage.AppendString(mapName)
+	clientMessage.AppendString(cacheName)
+	clientMessage.UpdateFrameLength()
+	return clientMessage
+}
+
+// ContinuousQueryDestroyCacheDecodeResponse decodes the given client message.
+// It returns a function which returns the response parameters.
+func ContinuousQueryDestroyCacheDecodeResponse(clientMessage *ClientMessage) func() (response bool) {
+	// Decode response from client message
+	return func() (response bool) {
+		response = clientMessage.ReadBool()
+		return
+	}
+}
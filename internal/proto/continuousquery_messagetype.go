@@ -0,0 +1,24 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+const (
+	continuousQueryMadePublishable          = 0x1801
+	continuousQueryPublisherCreateWithValue = 0x1802
+	continuousQueryPublisherCreate          = 0x1803
+	continuousQueryAddListener              = 0x1804
+	continuousQuerySetReadCursor            = 0x1805
+	continuousQueryDestroyCache             = 0x1806
+)
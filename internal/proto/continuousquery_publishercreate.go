@@ -0,0 +1,69 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+func continuousQueryPublisherCreateCalculateSize(mapName string, cacheName string, predicate *serialization.Data, batchSize int32, bufferSize int32, delaySeconds int64, populate bool, coalesce bool) int {
+	// Calculates the request payload size
+	dataSize := 0
+	dataSize += stringCalculateSize(mapName)
+	dataSize += stringCalculateSize(cacheName)
+	dataSize += dataCalculateSize(predicate)
+	dataSize += bufutil.Int32SizeInBytes
+	dataSize += bufutil.Int32SizeInBytes
+	dataSize += bufutil.Int64SizeInBytes
+	dataSize += bufutil.BoolSizeInBytes
+	dataSize += bufutil.BoolSizeInBytes
+	return dataSize
+}
+
+// ContinuousQueryPublisherCreateEncodeRequest creates and encodes a client message
+// with the given parameters.
+// It returns the encoded client message.
+func ContinuousQueryPublisherCreateEncodeRequest(mapName string, cacheName string, predicate *serialization.Data, batchSize int32, bufferSize int32, delaySeconds int64, populate bool, coalesce bool) *ClientMessage {
+	// Encode request into clientMessage
+	clientMessage := NewClientMessage(nil, continuousQueryPublisherCreateCalculateSize(mapName, cacheName, predicate, batchSize, bufferSize, delaySeconds, populate, coalesce))
+	clientMessage.SetMessageType(continuousQueryPublisherCreate)
+	clientMessage.IsRetryable = false
+	clientMessage.AppendString(mapName)
+	clientMessage.AppendString(cacheName)
+	clientMessage.AppendData(predicate)
+	clientMessage.AppendInt32(batchSize)
+	clientMessage.AppendInt32(bufferSize)
+	clientMessage.AppendInt64(delaySeconds)
+	clientMessage.AppendBool(populate)
+	clientMessage.AppendBool(coalesce)
+	clientMessage.UpdateFrameLength()
+	return clientMessage
+}
+
+// ContinuousQueryPublisherCreateDecodeResponse decodes the given client message.
+// It returns a function which returns the response parameters.
+func ContinuousQueryPublisherCreateDecodeResponse(clientMessage *ClientMessage) func() (response []*serialization.Data) {
+	// Decode response from client message
+	return func() (response []*serialization.Data) {
+		responseSize := clientMessage.ReadInt32()
+		response = make([]*serialization.Data, responseSize)
+		for responseIndex := 0; responseIndex < int(responseSize); responseIndex++ {
+			responseItem := clientMessage.ReadData()
+			response[responseIndex] = responseItem
+		}
+		return
+	}
+}
@@ -17,6 +17,7 @@ package proto
 import (
 	"bytes"
 	"fmt"
+	"net"
 	"reflect"
 	"strconv"
 	"time"
@@ -45,14 +46,30 @@ func (a *Address) Port() int {
 }
 
 func (a *Address) String() string {
-	return a.Host() + ":" + strconv.Itoa(a.Port())
+	return net.JoinHostPort(a.Host(), strconv.Itoa(a.Port()))
 }
 
-type uuid struct {
+// UUID is the 128-bit universally unique identifier used by the protocol.
+type UUID struct {
 	msb int64
 	lsb int64
 }
 
+// NewUUID returns a UUID with the given most and least significant bits.
+func NewUUID(msb int64, lsb int64) *UUID {
+	return &UUID{msb: msb, lsb: lsb}
+}
+
+// MostSignificantBits returns the most significant 64 bits of the UUID.
+func (u *UUID) MostSignificantBits() int64 {
+	return u.msb
+}
+
+// LeastSignificantBits returns the least significant 64 bits of the UUID.
+func (u *UUID) LeastSignificantBits() int64 {
+	return u.lsb
+}
+
 type Member struct {
 	address      Address
 	uuid         string
@@ -196,6 +213,39 @@ func (ev *DataEntryView) TTL() int64 {
 	return ev.ttl
 }
 
+type QueryCacheEventData struct {
+	sequence    int64
+	keyData     *serialization.Data
+	valueData   *serialization.Data
+	eventType   int32
+	partitionID int32
+}
+
+func NewQueryCacheEventData(sequence int64, keyData *serialization.Data, valueData *serialization.Data,
+	eventType int32, partitionID int32) *QueryCacheEventData {
+	return &QueryCacheEventData{sequence, keyData, valueData, eventType, partitionID}
+}
+
+func (ev *QueryCacheEventData) Sequence() int64 {
+	return ev.sequence
+}
+
+func (ev *QueryCacheEventData) KeyData() *serialization.Data {
+	return ev.keyData
+}
+
+func (ev *QueryCacheEventData) ValueData() *serialization.Data {
+	return ev.valueData
+}
+
+func (ev *QueryCacheEventData) EventType() int32 {
+	return ev.eventType
+}
+
+func (ev *QueryCacheEventData) PartitionID() int32 {
+	return ev.partitionID
+}
+
 type EntryView struct {
 	key                    interface{}
 	value                  interface{}
@@ -470,8 +520,21 @@ func (e *ItemEvent) Member() core.Member {
 type DecodeListenerResponse func(message *ClientMessage) string
 type EncodeListenerRemoveRequest func(registrationID string) *ClientMessage
 
+// ListenerFlagsProvider is implemented by the listeners that declare the events they are interested in,
+// such as the typed listeners that implement every listener interface but use only some of them.
+type ListenerFlagsProvider interface {
+	// ListenerFlags returns the entry event flags that the listener should be registered for.
+	ListenerFlags() int32
+}
+
 // Helper function to get flags for listeners
 func GetMapListenerFlags(listener interface{}) (int32, error) {
+	if provider, ok := listener.(ListenerFlagsProvider); ok {
+		if flags := provider.ListenerFlags(); flags != 0 {
+			return flags, nil
+		}
+		return 0, core.NewHazelcastIllegalArgumentError("listener is not registered for any events", nil)
+	}
 	flags := int32(0)
 	if _, ok := listener.(core.EntryAddedListener); ok {
 		flags |= bufutil.EntryEventAdded
@@ -73,13 +73,27 @@ func DataEntryViewCodecDecode(msg *ClientMessage) *DataEntryView {
 	return &dataEntryView
 }
 
-func UUIDCodecEncode(msg *ClientMessage, uuid uuid) {
+func QueryCacheEventDataCodecDecode(msg *ClientMessage) *QueryCacheEventData {
+	eventData := QueryCacheEventData{}
+	eventData.sequence = msg.ReadInt64()
+	if !msg.ReadBool() {
+		eventData.keyData = msg.ReadData()
+	}
+	if !msg.ReadBool() {
+		eventData.valueData = msg.ReadData()
+	}
+	eventData.eventType = msg.ReadInt32()
+	eventData.partitionID = msg.ReadInt32()
+	return &eventData
+}
+
+func UUIDCodecEncode(msg *ClientMessage, uuid UUID) {
 	msg.AppendInt64(uuid.msb)
 	msg.AppendInt64(uuid.lsb)
 }
 
-func UUIDCodecDecode(msg *ClientMessage) *uuid {
-	return &uuid{msg.ReadInt64(), msg.ReadInt64()}
+func UUIDCodecDecode(msg *ClientMessage) *UUID {
+	return &UUID{msg.ReadInt64(), msg.ReadInt64()}
 }
 
 /*
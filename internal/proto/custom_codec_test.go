@@ -98,6 +98,27 @@ func TestDataEntryViewCodecEncodeDecode(t *testing.T) {
 
 }
 
+func TestQueryCacheEventDataCodecDecode(t *testing.T) {
+	keyData := &serialization.Data{Payload: []byte("test-key")}
+	msg := NewClientMessage(nil, bufutil.Int64SizeInBytes+2*bufutil.BoolSizeInBytes+dataCalculateSize(keyData)+
+		2*bufutil.Int32SizeInBytes)
+	msg.AppendInt64(42)
+	msg.AppendBool(false)
+	msg.AppendData(keyData)
+	msg.AppendBool(true)
+	msg.AppendInt32(bufutil.EntryEventRemoved)
+	msg.AppendInt32(7)
+	//Skip the header.
+	for i := 0; i < len(READ_HEADER); i++ {
+		msg.ReadUint8()
+	}
+	result := QueryCacheEventDataCodecDecode(msg)
+	if result.Sequence() != 42 || string(result.KeyData().Buffer()) != string(keyData.Buffer()) ||
+		result.ValueData() != nil || result.EventType() != bufutil.EntryEventRemoved || result.PartitionID() != 7 {
+		t.Errorf("QueryCacheEventDataCodecDecode returned a wrong event data %+v", result)
+	}
+}
+
 /*
 	Helper functions
 */
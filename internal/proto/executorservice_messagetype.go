@@ -0,0 +1,24 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+const (
+	executorserviceShutdown          = 0x0901
+	executorserviceIsShutdown        = 0x0902
+	executorserviceCancelOnPartition = 0x0903
+	executorserviceCancelOnAddress   = 0x0904
+	executorserviceSubmitToPartition = 0x0905
+	executorserviceSubmitToAddress   = 0x0906
+)
@@ -0,0 +1,26 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+const (
+	lockIsLocked                = 0x0701
+	lockIsLockedByCurrentThread = 0x0702
+	lockGetLockCount            = 0x0703
+	lockGetRemainingLeaseTime   = 0x0704
+	lockLock                    = 0x0705
+	lockUnlock                  = 0x0706
+	lockForceUnlock             = 0x0707
+	lockTryLock                 = 0x0708
+)
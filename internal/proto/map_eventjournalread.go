@@ -0,0 +1,87 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+func mapEventJournalReadCalculateSize(name string, startSequence int64, minSize int32, maxSize int32, predicate *serialization.Data, projection *serialization.Data) int {
+	// Calculates the request payload size
+	dataSize := 0
+	dataSize += stringCalculateSize(name)
+	dataSize += bufutil.Int64SizeInBytes
+	dataSize += bufutil.Int32SizeInBytes
+	dataSize += bufutil.Int32SizeInBytes
+	dataSize += bufutil.BoolSizeInBytes
+	if predicate != nil {
+		dataSize += dataCalculateSize(predicate)
+	}
+	dataSize += bufutil.BoolSizeInBytes
+	if projection != nil {
+		dataSize += dataCalculateSize(projection)
+	}
+	return dataSize
+}
+
+// MapEventJournalReadEncodeRequest creates and encodes a client message
+// with the given parameters.
+// It returns the encoded client message.
+func MapEventJournalReadEncodeRequest(name string, startSequence int64, minSize int32, maxSize int32, predicate *serialization.Data, projection *serialization.Data) *ClientMessage {
+	// Encode request into clientMessage
+	clientMessage := NewClientMessage(nil, mapEventJournalReadCalculateSize(name, startSequence, minSize, maxSize, predicate, projection))
+	clientMessage.SetMessageType(mapEventJournalRead)
+	clientMessage.IsRetryable = true
+	clientMessage.AppendString(name)
+	clientMessage.AppendInt64(startSequence)
+	clientMessage.AppendInt32(minSize)
+	clientMessage.AppendInt32(maxSize)
+	clientMessage.AppendBool(predicate == nil)
+	if predicate != nil {
+		clientMessage.AppendData(predicate)
+	}
+	clientMessage.AppendBool(projection == nil)
+	if projection != nil {
+		clientMessage.AppendData(projection)
+	}
+	clientMessage.UpdateFrameLength()
+	return clientMessage
+}
+
+// MapEventJournalReadDecodeResponse decodes the given client message.
+// It returns a function which returns the response parameters.
+func MapEventJournalReadDecodeResponse(clientMessage *ClientMessage) func() (readCount int32, items []*serialization.Data, itemSeqs []int64, nextSeq int64) {
+	// Decode response from client message
+	return func() (readCount int32, items []*serialization.Data, itemSeqs []int64, nextSeq int64) {
+		readCount = clientMessage.ReadInt32()
+		itemsSize := clientMessage.ReadInt32()
+		items = make([]*serialization.Data, itemsSize)
+		for itemsIndex := 0; itemsIndex < int(itemsSize); itemsIndex++ {
+			itemsItem := clientMessage.ReadData()
+			items[itemsIndex] = itemsItem
+		}
+		if !clientMessage.ReadBool() {
+			itemSeqsSize := clientMessage.ReadInt32()
+			itemSeqs = make([]int64, itemSeqsSize)
+			for itemSeqsIndex := 0; itemSeqsIndex < int(itemSeqsSize); itemSeqsIndex++ {
+				itemSeqsItem := clientMessage.ReadInt64()
+				itemSeqs[itemSeqsIndex] = itemSeqsItem
+			}
+		}
+		nextSeq = clientMessage.ReadInt64()
+		return
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+func mapFetchKeysCalculateSize(name string, partitionId int32, tableIndex int32, batch int32) int {
+	// Calculates the request payload size
+	dataSize := 0
+	dataSize += stringCalculateSize(name)
+	dataSize += bufutil.Int32SizeInBytes
+	dataSize += bufutil.Int32SizeInBytes
+	dataSize += bufutil.Int32SizeInBytes
+	return dataSize
+}
+
+// MapFetchKeysEncodeRequest creates and encodes a client message
+// with the given parameters.
+// It returns the encoded client message.
+func MapFetchKeysEncodeRequest(name string, partitionId int32, tableIndex int32, batch int32) *ClientMessage {
+	// Encode request into clientMessage
+	clientMessage := NewClientMessage(nil, mapFetchKeysCalculateSize(name, partitionId, tableIndex, batch))
+	clientMessage.SetMessageType(mapFetchKeys)
+	clientMessage.IsRetryable = true
+	clientMessage.AppendString(name)
+	clientMessage.AppendInt32(partitionId)
+	clientMessage.AppendInt32(tableIndex)
+	clientMessage.AppendInt32(batch)
+	clientMessage.UpdateFrameLength()
+	return clientMessage
+}
+
+// MapFetchKeysDecodeResponse decodes the given client message.
+// It returns a function which returns the response parameters.
+func MapFetchKeysDecodeResponse(clientMessage *ClientMessage) func() (tableIndex int32, keys []*serialization.Data) {
+	// Decode response from client message
+	return func() (tableIndex int32, keys []*serialization.Data) {
+		tableIndex = clientMessage.ReadInt32()
+		keysSize := clientMessage.ReadInt32()
+		keys = make([]*serialization.Data, keysSize)
+		for keysIndex := 0; keysIndex < int(keysSize); keysIndex++ {
+			keysItem := clientMessage.ReadData()
+			keys[keysIndex] = keysItem
+		}
+		return
+	}
+}
@@ -0,0 +1,342 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+import (
+	"fmt"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+)
+
+// MessageTypeName returns a readable name of the given message type, e.g. "MapPut".
+// The names match the names of the codecs. The hexadecimal message type is returned for unknown message types.
+func MessageTypeName(messageType bufutil.MessageType) string {
+	if name, found := messageTypeNames[messageType]; found {
+		return name
+	}
+	return fmt.Sprintf("0x%04x", messageType)
+}
+
+var messageTypeNames = map[bufutil.MessageType]string{
+	clientAuthentication:                  "ClientAuthentication",
+	clientAuthenticationCustom:            "ClientAuthenticationCustom",
+	clientAddMembershipListener:           "ClientAddMembershipListener",
+	clientCreateProxy:                     "ClientCreateProxy",
+	clientDestroyProxy:                    "ClientDestroyProxy",
+	clientGetPartitions:                   "ClientGetPartitions",
+	clientRemoveAllListeners:              "ClientRemoveAllListeners",
+	clientAddPartitionLostListener:        "ClientAddPartitionLostListener",
+	clientRemovePartitionLostListener:     "ClientRemovePartitionLostListener",
+	clientGetDistributedObjects:           "ClientGetDistributedObjects",
+	clientAddDistributedObjectListener:    "ClientAddDistributedObjectListener",
+	clientRemoveDistributedObjectListener: "ClientRemoveDistributedObjectListener",
+	clientPing:                            "ClientPing",
+	clientStatistics:                      "ClientStatistics",
+	clientDeployClasses:                   "ClientDeployClasses",
+	clientAddPartitionListener:            "ClientAddPartitionListener",
+	clientCreateProxies:                   "ClientCreateProxies",
+	flakeidgeneratorNewIdBatch:            "FlakeIDGeneratorNewIDBatch",
+	listSize:                              "ListSize",
+	listContains:                          "ListContains",
+	listContainsAll:                       "ListContainsAll",
+	listAdd:                               "ListAdd",
+	listRemove:                            "ListRemove",
+	listAddAll:                            "ListAddAll",
+	listCompareAndRemoveAll:               "ListCompareAndRemoveAll",
+	listCompareAndRetainAll:               "ListCompareAndRetainAll",
+	listClear:                             "ListClear",
+	listGetAll:                            "ListGetAll",
+	listAddListener:                       "ListAddListener",
+	listRemoveListener:                    "ListRemoveListener",
+	listIsEmpty:                           "ListIsEmpty",
+	listAddAllWithIndex:                   "ListAddAllWithIndex",
+	listGet:                               "ListGet",
+	listSet:                               "ListSet",
+	listAddWithIndex:                      "ListAddWithIndex",
+	listRemoveWithIndex:                   "ListRemoveWithIndex",
+	listLastIndexOf:                       "ListLastIndexOf",
+	listIndexOf:                           "ListIndexOf",
+	listSub:                               "ListSub",
+	listIterator:                          "ListIterator",
+	listListIterator:                      "ListListIterator",
+	mapPut:                                "MapPut",
+	mapGet:                                "MapGet",
+	mapRemove:                             "MapRemove",
+	mapReplace:                            "MapReplace",
+	mapReplaceIfSame:                      "MapReplaceIfSame",
+	mapContainsKey:                        "MapContainsKey",
+	mapContainsValue:                      "MapContainsValue",
+	mapRemoveIfSame:                       "MapRemoveIfSame",
+	mapDelete:                             "MapDelete",
+	mapFlush:                              "MapFlush",
+	mapTryRemove:                          "MapTryRemove",
+	mapTryPut:                             "MapTryPut",
+	mapPutTransient:                       "MapPutTransient",
+	mapPutIfAbsent:                        "MapPutIfAbsent",
+	mapSet:                                "MapSet",
+	mapLock:                               "MapLock",
+	mapTryLock:                            "MapTryLock",
+	mapIsLocked:                           "MapIsLocked",
+	mapUnlock:                             "MapUnlock",
+	mapAddInterceptor:                     "MapAddInterceptor",
+	mapRemoveInterceptor:                  "MapRemoveInterceptor",
+	mapAddEntryListenerToKeyWithPredicate: "MapAddEntryListenerToKeyWithPredicate",
+	mapAddEntryListenerWithPredicate:      "MapAddEntryListenerWithPredicate",
+	mapAddEntryListenerToKey:              "MapAddEntryListenerToKey",
+	mapAddEntryListener:                   "MapAddEntryListener",
+	mapAddNearCacheEntryListener:          "MapAddNearCacheEntryListener",
+	mapRemoveEntryListener:                "MapRemoveEntryListener",
+	mapAddPartitionLostListener:           "MapAddPartitionLostListener",
+	mapRemovePartitionLostListener:        "MapRemovePartitionLostListener",
+	mapGetEntryView:                       "MapGetEntryView",
+	mapEvict:                              "MapEvict",
+	mapEvictAll:                           "MapEvictAll",
+	mapLoadAll:                            "MapLoadAll",
+	mapLoadGivenKeys:                      "MapLoadGivenKeys",
+	mapKeySet:                             "MapKeySet",
+	mapGetAll:                             "MapGetAll",
+	mapValues:                             "MapValues",
+	mapEntrySet:                           "MapEntrySet",
+	mapKeySetWithPredicate:                "MapKeySetWithPredicate",
+	mapValuesWithPredicate:                "MapValuesWithPredicate",
+	mapEntriesWithPredicate:               "MapEntriesWithPredicate",
+	mapAddIndex:                           "MapAddIndex",
+	mapSize:                               "MapSize",
+	mapIsEmpty:                            "MapIsEmpty",
+	mapPutAll:                             "MapPutAll",
+	mapClear:                              "MapClear",
+	mapExecuteOnKey:                       "MapExecuteOnKey",
+	mapSubmitToKey:                        "MapSubmitToKey",
+	mapExecuteOnAllKeys:                   "MapExecuteOnAllKeys",
+	mapExecuteWithPredicate:               "MapExecuteWithPredicate",
+	mapExecuteOnKeys:                      "MapExecuteOnKeys",
+	mapForceUnlock:                        "MapForceUnlock",
+	mapKeySetWithPagingPredicate:          "MapKeySetWithPagingPredicate",
+	mapValuesWithPagingPredicate:          "MapValuesWithPagingPredicate",
+	mapEntriesWithPagingPredicate:         "MapEntriesWithPagingPredicate",
+	mapClearNearCache:                     "MapClearNearCache",
+	mapFetchKeys:                          "MapFetchKeys",
+	mapFetchEntries:                       "MapFetchEntries",
+	mapAggregate:                          "MapAggregate",
+	mapAggregateWithPredicate:             "MapAggregateWithPredicate",
+	mapProject:                            "MapProject",
+	mapProjectWithPredicate:               "MapProjectWithPredicate",
+	mapFetchNearCacheInvalidationMetadata: "MapFetchNearCacheInvalidationMetadata",
+	mapAssignAndGetUuids:                  "MapAssignAndGetUuids",
+	mapRemoveAll:                          "MapRemoveAll",
+	mapAddNearCacheInvalidationListener:   "MapAddNearCacheInvalidationListener",
+	mapFetchWithQuery:                     "MapFetchWithQuery",
+	mapEventJournalSubscribe:              "MapEventJournalSubscribe",
+	mapEventJournalRead:                   "MapEventJournalRead",
+	multimapPut:                           "MultiMapPut",
+	multimapGet:                           "MultiMapGet",
+	multimapRemove:                        "MultiMapRemove",
+	multimapKeySet:                        "MultiMapKeySet",
+	multimapValues:                        "MultiMapValues",
+	multimapEntrySet:                      "MultiMapEntrySet",
+	multimapContainsKey:                   "MultiMapContainsKey",
+	multimapContainsValue:                 "MultiMapContainsValue",
+	multimapContainsEntry:                 "MultiMapContainsEntry",
+	multimapSize:                          "MultiMapSize",
+	multimapClear:                         "MultiMapClear",
+	multimapValueCount:                    "MultiMapValueCount",
+	multimapAddEntryListenerToKey:         "MultiMapAddEntryListenerToKey",
+	multimapAddEntryListener:              "MultiMapAddEntryListener",
+	multimapRemoveEntryListener:           "MultiMapRemoveEntryListener",
+	multimapLock:                          "MultiMapLock",
+	multimapTryLock:                       "MultiMapTryLock",
+	multimapIsLocked:                      "MultiMapIsLocked",
+	multimapUnlock:                        "MultiMapUnlock",
+	multimapForceUnlock:                   "MultiMapForceUnlock",
+	multimapRemoveEntry:                   "MultiMapRemoveEntry",
+	multimapDelete:                        "MultiMapDelete",
+	pncounterGet:                          "PNCounterGet",
+	pncounterAdd:                          "PNCounterAdd",
+	pncounterGetConfiguredReplicaCount:    "PNCounterGetConfiguredReplicaCount",
+	queueOffer:                            "QueueOffer",
+	queuePut:                              "QueuePut",
+	queueSize:                             "QueueSize",
+	queueRemove:                           "QueueRemove",
+	queuePoll:                             "QueuePoll",
+	queueTake:                             "QueueTake",
+	queuePeek:                             "QueuePeek",
+	queueIterator:                         "QueueIterator",
+	queueDrainTo:                          "QueueDrainTo",
+	queueDrainToMaxSize:                   "QueueDrainToMaxSize",
+	queueContains:                         "QueueContains",
+	queueContainsAll:                      "QueueContainsAll",
+	queueCompareAndRemoveAll:              "QueueCompareAndRemoveAll",
+	queueCompareAndRetainAll:              "QueueCompareAndRetainAll",
+	queueClear:                            "QueueClear",
+	queueAddAll:                           "QueueAddAll",
+	queueAddListener:                      "QueueAddListener",
+	queueRemoveListener:                   "QueueRemoveListener",
+	queueRemainingCapacity:                "QueueRemainingCapacity",
+	queueIsEmpty:                          "QueueIsEmpty",
+	replicatedmapPut:                      "ReplicatedMapPut",
+	replicatedmapSize:                     "ReplicatedMapSize",
+	replicatedmapIsEmpty:                  "ReplicatedMapIsEmpty",
+	replicatedmapContainsKey:              "ReplicatedMapContainsKey",
+	replicatedmapContainsValue:            "ReplicatedMapContainsValue",
+	replicatedmapGet:                      "ReplicatedMapGet",
+	replicatedmapRemove:                   "ReplicatedMapRemove",
+	replicatedmapPutAll:                   "ReplicatedMapPutAll",
+	replicatedmapClear:                    "ReplicatedMapClear",
+	replicatedmapAddEntryListenerToKeyWithPredicate: "ReplicatedMapAddEntryListenerToKeyWithPredicate",
+	replicatedmapAddEntryListenerWithPredicate:      "ReplicatedMapAddEntryListenerWithPredicate",
+	replicatedmapAddEntryListenerToKey:              "ReplicatedMapAddEntryListenerToKey",
+	replicatedmapAddEntryListener:                   "ReplicatedMapAddEntryListener",
+	replicatedmapRemoveEntryListener:                "ReplicatedMapRemoveEntryListener",
+	replicatedmapKeySet:                             "ReplicatedMapKeySet",
+	replicatedmapValues:                             "ReplicatedMapValues",
+	replicatedmapEntrySet:                           "ReplicatedMapEntrySet",
+	replicatedmapAddNearCacheEntryListener:          "ReplicatedMapAddNearCacheEntryListener",
+	ringbufferSize:                                  "RingbufferSize",
+	ringbufferTailSequence:                          "RingbufferTailSequence",
+	ringbufferHeadSequence:                          "RingbufferHeadSequence",
+	ringbufferCapacity:                              "RingbufferCapacity",
+	ringbufferRemainingCapacity:                     "RingbufferRemainingCapacity",
+	ringbufferAdd:                                   "RingbufferAdd",
+	ringbufferReadOne:                               "RingbufferReadOne",
+	ringbufferAddAll:                                "RingbufferAddAll",
+	ringbufferReadMany:                              "RingbufferReadMany",
+	setSize:                                         "SetSize",
+	setContains:                                     "SetContains",
+	setContainsAll:                                  "SetContainsAll",
+	setAdd:                                          "SetAdd",
+	setRemove:                                       "SetRemove",
+	setAddAll:                                       "SetAddAll",
+	setCompareAndRemoveAll:                          "SetCompareAndRemoveAll",
+	setCompareAndRetainAll:                          "SetCompareAndRetainAll",
+	setClear:                                        "SetClear",
+	setGetAll:                                       "SetGetAll",
+	setAddListener:                                  "SetAddListener",
+	setRemoveListener:                               "SetRemoveListener",
+	setIsEmpty:                                      "SetIsEmpty",
+	topicPublish:                                    "TopicPublish",
+	topicAddMessageListener:                         "TopicAddMessageListener",
+	topicRemoveMessageListener:                      "TopicRemoveMessageListener",
+	transactionCommit:                               "TransactionCommit",
+	transactionCreate:                               "TransactionCreate",
+	transactionRollback:                             "TransactionRollback",
+	transactionalmapContainsKey:                     "TransactionalMapContainsKey",
+	transactionalmapGet:                             "TransactionalMapGet",
+	transactionalmapGetForUpdate:                    "TransactionalMapGetForUpdate",
+	transactionalmapSize:                            "TransactionalMapSize",
+	transactionalmapIsEmpty:                         "TransactionalMapIsEmpty",
+	transactionalmapPut:                             "TransactionalMapPut",
+	transactionalmapSet:                             "TransactionalMapSet",
+	transactionalmapPutIfAbsent:                     "TransactionalMapPutIfAbsent",
+	transactionalmapReplace:                         "TransactionalMapReplace",
+	transactionalmapReplaceIfSame:                   "TransactionalMapReplaceIfSame",
+	transactionalmapRemove:                          "TransactionalMapRemove",
+	transactionalmapDelete:                          "TransactionalMapDelete",
+	transactionalmapRemoveIfSame:                    "TransactionalMapRemoveIfSame",
+	transactionalmapKeySet:                          "TransactionalMapKeySet",
+	transactionalmapKeySetWithPredicate:             "TransactionalMapKeySetWithPredicate",
+	transactionalmapValues:                          "TransactionalMapValues",
+	transactionalmapValuesWithPredicate:             "TransactionalMapValuesWithPredicate",
+	transactionalmultimapPut:                        "TransactionalMultiMapPut",
+	transactionalmultimapGet:                        "TransactionalMultiMapGet",
+	transactionalmultimapRemove:                     "TransactionalMultiMapRemove",
+	transactionalmultimapRemoveEntry:                "TransactionalMultiMapRemoveEntry",
+	transactionalmultimapValueCount:                 "TransactionalMultiMapValueCount",
+	transactionalmultimapSize:                       "TransactionalMultiMapSize",
+	transactionalsetAdd:                             "TransactionalSetAdd",
+	transactionalsetRemove:                          "TransactionalSetRemove",
+	transactionalsetSize:                            "TransactionalSetSize",
+	transactionallistAdd:                            "TransactionalListAdd",
+	transactionallistRemove:                         "TransactionalListRemove",
+	transactionallistSize:                           "TransactionalListSize",
+	transactionalqueueOffer:                         "TransactionalQueueOffer",
+	transactionalqueueTake:                          "TransactionalQueueTake",
+	transactionalqueuePoll:                          "TransactionalQueuePoll",
+	transactionalqueuePeek:                          "TransactionalQueuePeek",
+	transactionalqueueSize:                          "TransactionalQueueSize",
+	lockIsLocked:                                    "LockIsLocked",
+	lockIsLockedByCurrentThread:                     "LockIsLockedByCurrentThread",
+	lockGetLockCount:                                "LockGetLockCount",
+	lockGetRemainingLeaseTime:                       "LockGetRemainingLeaseTime",
+	lockLock:                                        "LockLock",
+	lockUnlock:                                      "LockUnlock",
+	lockForceUnlock:                                 "LockForceUnlock",
+	lockTryLock:                                     "LockTryLock",
+	atomiclongApply:                                 "AtomicLongApply",
+	atomiclongAlter:                                 "AtomicLongAlter",
+	atomiclongAlterAndGet:                           "AtomicLongAlterAndGet",
+	atomiclongGetAndAlter:                           "AtomicLongGetAndAlter",
+	atomiclongAddAndGet:                             "AtomicLongAddAndGet",
+	atomiclongCompareAndSet:                         "AtomicLongCompareAndSet",
+	atomiclongDecrementAndGet:                       "AtomicLongDecrementAndGet",
+	atomiclongGet:                                   "AtomicLongGet",
+	atomiclongGetAndAdd:                             "AtomicLongGetAndAdd",
+	atomiclongGetAndSet:                             "AtomicLongGetAndSet",
+	atomiclongIncrementAndGet:                       "AtomicLongIncrementAndGet",
+	atomiclongGetAndIncrement:                       "AtomicLongGetAndIncrement",
+	atomiclongSet:                                   "AtomicLongSet",
+	atomicreferenceApply:                            "AtomicReferenceApply",
+	atomicreferenceAlter:                            "AtomicReferenceAlter",
+	atomicreferenceAlterAndGet:                      "AtomicReferenceAlterAndGet",
+	atomicreferenceGetAndAlter:                      "AtomicReferenceGetAndAlter",
+	atomicreferenceContains:                         "AtomicReferenceContains",
+	atomicreferenceCompareAndSet:                    "AtomicReferenceCompareAndSet",
+	atomicreferenceGet:                              "AtomicReferenceGet",
+	atomicreferenceSet:                              "AtomicReferenceSet",
+	atomicreferenceClear:                            "AtomicReferenceClear",
+	atomicreferenceGetAndSet:                        "AtomicReferenceGetAndSet",
+	atomicreferenceSetAndGet:                        "AtomicReferenceSetAndGet",
+	atomicreferenceIsNull:                           "AtomicReferenceIsNull",
+	countdownlatchAwait:                             "CountDownLatchAwait",
+	countdownlatchCountDown:                         "CountDownLatchCountDown",
+	countdownlatchGetCount:                          "CountDownLatchGetCount",
+	countdownlatchTrySetCount:                       "CountDownLatchTrySetCount",
+	semaphoreInit:                                   "SemaphoreInit",
+	semaphoreAcquire:                                "SemaphoreAcquire",
+	semaphoreAvailablePermits:                       "SemaphoreAvailablePermits",
+	semaphoreDrainPermits:                           "SemaphoreDrainPermits",
+	semaphoreReducePermits:                          "SemaphoreReducePermits",
+	semaphoreRelease:                                "SemaphoreRelease",
+	semaphoreTryAcquire:                             "SemaphoreTryAcquire",
+	executorserviceShutdown:                         "ExecutorServiceShutdown",
+	executorserviceIsShutdown:                       "ExecutorServiceIsShutdown",
+	executorserviceCancelOnPartition:                "ExecutorServiceCancelOnPartition",
+	executorserviceCancelOnAddress:                  "ExecutorServiceCancelOnAddress",
+	executorserviceSubmitToPartition:                "ExecutorServiceSubmitToPartition",
+	executorserviceSubmitToAddress:                  "ExecutorServiceSubmitToAddress",
+	scheduledexecutorShutdown:                       "ScheduledExecutorShutdown",
+	scheduledexecutorSubmitToPartition:              "ScheduledExecutorSubmitToPartition",
+	scheduledexecutorSubmitToAddress:                "ScheduledExecutorSubmitToAddress",
+	scheduledexecutorGetAllScheduledFutures:         "ScheduledExecutorGetAllScheduledFutures",
+	scheduledexecutorGetStatsFromPartition:          "ScheduledExecutorGetStatsFromPartition",
+	scheduledexecutorGetStatsFromAddress:            "ScheduledExecutorGetStatsFromAddress",
+	scheduledexecutorGetDelayFromPartition:          "ScheduledExecutorGetDelayFromPartition",
+	scheduledexecutorGetDelayFromAddress:            "ScheduledExecutorGetDelayFromAddress",
+	scheduledexecutorCancelFromPartition:            "ScheduledExecutorCancelFromPartition",
+	scheduledexecutorCancelFromAddress:              "ScheduledExecutorCancelFromAddress",
+	scheduledexecutorIsCancelledFromPartition:       "ScheduledExecutorIsCancelledFromPartition",
+	scheduledexecutorIsCancelledFromAddress:         "ScheduledExecutorIsCancelledFromAddress",
+	scheduledexecutorIsDoneFromPartition:            "ScheduledExecutorIsDoneFromPartition",
+	scheduledexecutorIsDoneFromAddress:              "ScheduledExecutorIsDoneFromAddress",
+	scheduledexecutorGetResultFromPartition:         "ScheduledExecutorGetResultFromPartition",
+	scheduledexecutorGetResultFromAddress:           "ScheduledExecutorGetResultFromAddress",
+	scheduledexecutorDisposeFromPartition:           "ScheduledExecutorDisposeFromPartition",
+	scheduledexecutorDisposeFromAddress:             "ScheduledExecutorDisposeFromAddress",
+	continuousQueryMadePublishable:                  "ContinuousQueryMadePublishable",
+	continuousQueryPublisherCreateWithValue:         "ContinuousQueryPublisherCreateWithValue",
+	continuousQueryPublisherCreate:                  "ContinuousQueryPublisherCreate",
+	continuousQueryAddListener:                      "ContinuousQueryAddListener",
+	continuousQuerySetReadCursor:                    "ContinuousQuerySetReadCursor",
+	continuousQueryDestroyCache:                     "ContinuousQueryDestroyCache",
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+)
+
+func scheduledexecutorCancelFromPartitionCalculateSize(schedulerName string, taskName string, mayInterruptIfRunning bool) int {
+	// Calculates the request payload size
+	dataSize := 0
+	dataSize += stringCalculateSize(schedulerName)
+	dataSize += stringCalculateSize(taskName)
+	dataSize += bufutil.BoolSizeInBytes
+	return dataSize
+}
+
+// ScheduledExecutorCancelFromPartitionEncodeRequest creates and encodes a client message
+// with the given parameters.
+// It returns the encoded client message.
+func ScheduledExecutorCancelFromPartitionEncodeRequest(schedulerName string, taskName string, mayInterruptIfRunning bool) *ClientMessage {
+	// Encode request into clientMessage
+	clientMessage := NewClientMessage(nil, scheduledexecutorCancelFromPartitionCalculateSize(schedulerName, taskName, mayInterruptIfRunning))
+	clientMessage.SetMessageType(scheduledexecutorCancelFromPartition)
+	clientMessage.IsRetryable = false
+	clientMessage.AppendString(schedulerName)
+	clientMessage.AppendString(taskName)
+	clientMessage.AppendBool(mayInterruptIfRunning)
+	clientMessage.UpdateFrameLength()
+	return clientMessage
+}
+
+// ScheduledExecutorCancelFromPartitionDecodeResponse decodes the given client message.
+// It returns a function which returns the response parameters.
+func ScheduledExecutorCancelFromPartitionDecodeResponse(clientMessage *ClientMessage) func() (response bool) {
+	// Decode response from client message
+	return func() (response bool) {
+		response = clientMessage.ReadBool()
+		return
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+func scheduledexecutorDisposeFromAddressCalculateSize(schedulerName string, taskName string, address *Address) int {
+	// Calculates the request payload size
+	dataSize := 0
+	dataSize += stringCalculateSize(schedulerName)
+	dataSize += stringCalculateSize(taskName)
+	dataSize += addressCalculateSize(address)
+	return dataSize
+}
+
+// ScheduledExecutorDisposeFromAddressEncodeRequest creates and encodes a client message
+// with the given parameters.
+// It returns the encoded client message.
+func ScheduledExecutorDisposeFromAddressEncodeRequest(schedulerName string, taskName string, address *Address) *ClientMessage {
+	// Encode request into clientMessage
+	clientMessage := NewClientMessage(nil, scheduledexecutorDisposeFromAddressCalculateSize(schedulerName, taskName, address))
+	clientMessage.SetMessageType(scheduledexecutorDisposeFromAddress)
+	clientMessage.IsRetryable = false
+	clientMessage.AppendString(schedulerName)
+	clientMessage.AppendString(taskName)
+	AddressCodecEncode(clientMessage, address)
+	clientMessage.UpdateFrameLength()
+	return clientMessage
+}
+
+// ScheduledExecutorDisposeFromAddressDecodeResponse(clientMessage *ClientMessage), this message has no parameters to decode
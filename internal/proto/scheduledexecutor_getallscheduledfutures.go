@@ -0,0 +1,58 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+func scheduledexecutorGetAllScheduledFuturesCalculateSize(schedulerName string) int {
+	// Calculates the request payload size
+	dataSize := 0
+	dataSize += stringCalculateSize(schedulerName)
+	return dataSize
+}
+
+// ScheduledExecutorGetAllScheduledFuturesEncodeRequest creates and encodes a client message
+// with the given parameters.
+// It returns the encoded client message.
+func ScheduledExecutorGetAllScheduledFuturesEncodeRequest(schedulerName string) *ClientMessage {
+	// Encode request into clientMessage
+	clientMessage := NewClientMessage(nil, scheduledexecutorGetAllScheduledFuturesCalculateSize(schedulerName))
+	clientMessage.SetMessageType(scheduledexecutorGetAllScheduledFutures)
+	clientMessage.IsRetryable = true
+	clientMessage.AppendString(schedulerName)
+	clientMessage.UpdateFrameLength()
+	return clientMessage
+}
+
+// ScheduledExecutorGetAllScheduledFuturesDecodeResponse decodes the given client message.
+// It returns a function which returns the members and the URNs of the task handlers scheduled on
+// each of them, at the same index.
+func ScheduledExecutorGetAllScheduledFuturesDecodeResponse(clientMessage *ClientMessage) func() (members []*Member,
+	handlerURNs [][]string) {
+	// Decode response from client message
+	return func() (members []*Member, handlerURNs [][]string) {
+		handlersSize := clientMessage.ReadInt32()
+		members = make([]*Member, handlersSize)
+		handlerURNs = make([][]string, handlersSize)
+		for handlersIndex := 0; handlersIndex < int(handlersSize); handlersIndex++ {
+			members[handlersIndex] = MemberCodecDecode(clientMessage)
+			urnsSize := clientMessage.ReadInt32()
+			urns := make([]string, urnsSize)
+			for urnsIndex := 0; urnsIndex < int(urnsSize); urnsIndex++ {
+				urns[urnsIndex] = clientMessage.ReadString()
+			}
+			handlerURNs[handlersIndex] = urns
+		}
+		return
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+func scheduledexecutorGetStatsFromPartitionCalculateSize(schedulerName string, taskName string) int {
+	// Calculates the request payload size
+	dataSize := 0
+	dataSize += stringCalculateSize(schedulerName)
+	dataSize += stringCalculateSize(taskName)
+	return dataSize
+}
+
+// ScheduledExecutorGetStatsFromPartitionEncodeRequest creates and encodes a client message
+// with the given parameters.
+// It returns the encoded client message.
+func ScheduledExecutorGetStatsFromPartitionEncodeRequest(schedulerName string, taskName string) *ClientMessage {
+	// Encode request into clientMessage
+	clientMessage := NewClientMessage(nil, scheduledexecutorGetStatsFromPartitionCalculateSize(schedulerName, taskName))
+	clientMessage.SetMessageType(scheduledexecutorGetStatsFromPartition)
+	clientMessage.IsRetryable = false
+	clientMessage.AppendString(schedulerName)
+	clientMessage.AppendString(taskName)
+	clientMessage.UpdateFrameLength()
+	return clientMessage
+}
+
+// ScheduledExecutorGetStatsFromPartitionDecodeResponse decodes the given client message.
+// It returns a function which returns the response parameters.
+func ScheduledExecutorGetStatsFromPartitionDecodeResponse(clientMessage *ClientMessage) func() (lastIdleTimeNanos int64, totalIdleTimeNanos int64, totalRuns int64, totalRunTimeNanos int64, lastRunDurationNanos int64) {
+	// Decode response from client message
+	return func() (lastIdleTimeNanos int64, totalIdleTimeNanos int64, totalRuns int64, totalRunTimeNanos int64, lastRunDurationNanos int64) {
+		lastIdleTimeNanos = clientMessage.ReadInt64()
+		totalIdleTimeNanos = clientMessage.ReadInt64()
+		totalRuns = clientMessage.ReadInt64()
+		totalRunTimeNanos = clientMessage.ReadInt64()
+		lastRunDurationNanos = clientMessage.ReadInt64()
+		return
+	}
+}
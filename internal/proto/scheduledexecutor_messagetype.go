@@ -0,0 +1,36 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+const (
+	scheduledexecutorShutdown                 = 0x1a01
+	scheduledexecutorSubmitToPartition        = 0x1a02
+	scheduledexecutorSubmitToAddress          = 0x1a03
+	scheduledexecutorGetAllScheduledFutures   = 0x1a04
+	scheduledexecutorGetStatsFromPartition    = 0x1a05
+	scheduledexecutorGetStatsFromAddress      = 0x1a06
+	scheduledexecutorGetDelayFromPartition    = 0x1a07
+	scheduledexecutorGetDelayFromAddress      = 0x1a08
+	scheduledexecutorCancelFromPartition      = 0x1a09
+	scheduledexecutorCancelFromAddress        = 0x1a0a
+	scheduledexecutorIsCancelledFromPartition = 0x1a0b
+	scheduledexecutorIsCancelledFromAddress   = 0x1a0c
+	scheduledexecutorIsDoneFromPartition      = 0x1a0d
+	scheduledexecutorIsDoneFromAddress        = 0x1a0e
+	scheduledexecutorGetResultFromPartition   = 0x1a0f
+	scheduledexecutorGetResultFromAddress     = 0x1a10
+	scheduledexecutorDisposeFromPartition     = 0x1a11
+	scheduledexecutorDisposeFromAddress       = 0x1a12
+)
@@ -0,0 +1,52 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+func scheduledexecutorSubmitToPartitionCalculateSize(schedulerName string, taskType uint8, taskName string, task *serialization.Data, initialDelayInMillis int64, periodInMillis int64) int {
+	// Calculates the request payload size
+	dataSize := 0
+	dataSize += stringCalculateSize(schedulerName)
+	dataSize += bufutil.Uint8SizeInBytes
+	dataSize += stringCalculateSize(taskName)
+	dataSize += dataCalculateSize(task)
+	dataSize += bufutil.Int64SizeInBytes
+	dataSize += bufutil.Int64SizeInBytes
+	return dataSize
+}
+
+// ScheduledExecutorSubmitToPartitionEncodeRequest creates and encodes a client message
+// with the given parameters.
+// It returns the encoded client message.
+func ScheduledExecutorSubmitToPartitionEncodeRequest(schedulerName string, taskType uint8, taskName string, task *serialization.Data, initialDelayInMillis int64, periodInMillis int64) *ClientMessage {
+	// Encode request into clientMessage
+	clientMessage := NewClientMessage(nil, scheduledexecutorSubmitToPartitionCalculateSize(schedulerName, taskType, taskName, task, initialDelayInMillis, periodInMillis))
+	clientMessage.SetMessageType(scheduledexecutorSubmitToPartition)
+	clientMessage.IsRetryable = false
+	clientMessage.AppendString(schedulerName)
+	clientMessage.AppendUint8(taskType)
+	clientMessage.AppendString(taskName)
+	clientMessage.AppendData(task)
+	clientMessage.AppendInt64(initialDelayInMillis)
+	clientMessage.AppendInt64(periodInMillis)
+	clientMessage.UpdateFrameLength()
+	return clientMessage
+}
+
+// ScheduledExecutorSubmitToPartitionDecodeResponse(clientMessage *ClientMessage), this message has no parameters to decode
@@ -0,0 +1,25 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+const (
+	semaphoreInit             = 0x0d01
+	semaphoreAcquire          = 0x0d02
+	semaphoreAvailablePermits = 0x0d03
+	semaphoreDrainPermits     = 0x0d04
+	semaphoreReducePermits    = 0x0d05
+	semaphoreRelease          = 0x0d06
+	semaphoreTryAcquire       = 0x0d07
+)
@@ -0,0 +1,43 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+)
+
+func transactionCommitCalculateSize(transactionId string, threadId int64) int {
+	// Calculates the request payload size
+	dataSize := 0
+	dataSize += stringCalculateSize(transactionId)
+	dataSize += bufutil.Int64SizeInBytes
+	return dataSize
+}
+
+// TransactionCommitEncodeRequest creates and encodes a client message
+// with the given parameters.
+// It returns the encoded client message.
+func TransactionCommitEncodeRequest(transactionId string, threadId int64) *ClientMessage {
+	// Encode request into clientMessage
+	clientMessage := NewClientMessage(nil, transactionCommitCalculateSize(transactionId, threadId))
+	clientMessage.SetMessageType(transactionCommit)
+	clientMessage.IsRetryable = false
+	clientMessage.AppendString(transactionId)
+	clientMessage.AppendInt64(threadId)
+	clientMessage.UpdateFrameLength()
+	return clientMessage
+}
+
+// TransactionCommitDecodeResponse(clientMessage *ClientMessage), this message has no parameters to decode
@@ -0,0 +1,55 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+)
+
+func transactionCreateCalculateSize(timeout int64, durability int32, transactionType int32, threadId int64) int {
+	// Calculates the request payload size
+	dataSize := 0
+	dataSize += bufutil.Int64SizeInBytes
+	dataSize += bufutil.Int32SizeInBytes
+	dataSize += bufutil.Int32SizeInBytes
+	dataSize += bufutil.Int64SizeInBytes
+	return dataSize
+}
+
+// TransactionCreateEncodeRequest creates and encodes a client message
+// with the given parameters.
+// It returns the encoded client message.
+func TransactionCreateEncodeRequest(timeout int64, durability int32, transactionType int32, threadId int64) *ClientMessage {
+	// Encode request into clientMessage
+	clientMessage := NewClientMessage(nil, transactionCreateCalculateSize(timeout, durability, transactionType, threadId))
+	clientMessage.SetMessageType(transactionCreate)
+	clientMessage.IsRetryable = false
+	clientMessage.AppendInt64(timeout)
+	clientMessage.AppendInt32(durability)
+	clientMessage.AppendInt32(transactionType)
+	clientMessage.AppendInt64(threadId)
+	clientMessage.UpdateFrameLength()
+	return clientMessage
+}
+
+// TransactionCreateDecodeResponse decodes the given client message.
+// It returns a function which returns the response parameters.
+func TransactionCreateDecodeResponse(clientMessage *ClientMessage) func() (response string) {
+	// Decode response from client message
+	return func() (response string) {
+		response = clientMessage.ReadString()
+		return
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+func transactionallistAddCalculateSize(name string, txnId string, threadId int64, item *serialization.Data) int {
+	// Calculates the request payload size
+	dataSize := 0
+	dataSize += stringCalculateSize(name)
+	dataSize += stringCalculateSize(txnId)
+	dataSize += bufutil.Int64SizeInBytes
+	dataSize += dataCalculateSize(item)
+	return dataSize
+}
+
+// TransactionalListAddEncodeRequest creates and encodes a client message
+// with the given parameters.
+// It returns the encoded client message.
+func TransactionalListAddEncodeRequest(name string, txnId string, threadId int64, item *serialization.Data) *ClientMessage {
+	// Encode request into clientMessage
+	clientMessage := NewClientMessage(nil, transactionallistAddCalculateSize(name, txnId, threadId, item))
+	clientMessage.SetMessageType(transactionallistAdd)
+	clientMessage.IsRetryable = false
+	clientMessage.AppendString(name)
+	clientMessage.AppendString(txnId)
+	clientMessage.AppendInt64(threadId)
+	clientMessage.AppendData(item)
+	clientMessage.UpdateFrameLength()
+	return clientMessage
+}
+
+// TransactionalListAddDecodeResponse decodes the given client message.
+// It returns a function which returns the response parameters.
+func TransactionalListAddDecodeResponse(clientMessage *ClientMessage) func() (response bool) {
+	// Decode response from client message
+	return func() (response bool) {
+		response = clientMessage.ReadBool()
+		return
+	}
+}
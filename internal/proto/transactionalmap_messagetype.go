@@ -0,0 +1,35 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+const (
+	transactionalmapContainsKey         = 0x1001
+	transactionalmapGet                 = 0x1002
+	transactionalmapGetForUpdate        = 0x1003
+	transactionalmapSize                = 0x1004
+	transactionalmapIsEmpty             = 0x1005
+	transactionalmapPut                 = 0x1006
+	transactionalmapSet                 = 0x1007
+	transactionalmapPutIfAbsent         = 0x1008
+	transactionalmapReplace             = 0x1009
+	transactionalmapReplaceIfSame       = 0x100a
+	transactionalmapRemove              = 0x100b
+	transactionalmapDelete              = 0x100c
+	transactionalmapRemoveIfSame        = 0x100d
+	transactionalmapKeySet              = 0x100e
+	transactionalmapKeySetWithPredicate = 0x100f
+	transactionalmapValues              = 0x1010
+	transactionalmapValuesWithPredicate = 0x1011
+)
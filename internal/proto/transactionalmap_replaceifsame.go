@@ -0,0 +1,60 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proto
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+func transactionalmapReplaceIfSameCalculateSize(name string, txnId string, threadId int64, key *serialization.Data, oldValue *serialization.Data, newValue *serialization.Data) int {
+	// Calculates the request payload size
+	dataSize := 0
+	dataSize += stringCalculateSize(name)
+	dataSize += stringCalculateSize(txnId)
+	dataSize += bufutil.Int64SizeInBytes
+	dataSize += dataCalculateSize(key)
+	dataSize += dataCalculateSize(oldValue)
+	dataSize += dataCalculateSize(newValue)
+	return dataSize
+}
+
+// TransactionalMapReplaceIfSameEncodeRequest creates and encodes a client message
+// with the given parameters.
+// It returns the encoded client message.
+func TransactionalMapReplaceIfSameEncodeRequest(name string, txnId string, threadId int64, key *serialization.Data, oldValue *serialization.Data, newValue *serialization.Data) *ClientMessage {
+	// Encode request into clientMessage
+	clientMessage := NewClientMessage(nil, transactionalmapReplaceIfSameCalculateSize(name, txnId, threadId, key, oldValue, newValue))
+	clientMessage.SetMessageType(transactionalmapReplaceIfSame)
+	clientMessage.IsRetryable = false
+	clientMessage.AppendString(name)
+	clientMessage.AppendString(txnId)
+	clientMessage.AppendInt64(threadId)
+	clientMessage.AppendData(key)
+	clientMessage.AppendData(oldValue)
+	clientMessage.AppendData(newValue)
+	clientMessage.UpdateFrameLength()
+	return clientMessage
+}
+
+// TransactionalMapReplaceIfSameDecodeResponse decodes the given client message.
+// It returns a function which returns the response parameters.
+func TransactionalMapReplaceIfSameDecodeResponse(clientMessage *ClientMessage) func() (response bool) {
+	// Decode response from client message
+	return func() (response bool) {
+		response = clientMessage.ReadBool()
+		return
+	}
+}
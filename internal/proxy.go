@@ -15,8 +15,11 @@
 package internal
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"reflect"
+	"time"
 
 	"github.com/hazelcast/hazelcast-go-client/core"
 	"github.com/hazelcast/hazelcast-go-client/internal/colutil"
@@ -34,6 +37,32 @@ type proxy struct {
 	client      *HazelcastClient
 	serviceName string
 	name        string
+	// ctx bounds the invocations of the proxy. If it is nil, the invocations are bound only by the invocation timeout.
+	ctx context.Context
+}
+
+func newProxy(client *HazelcastClient, serviceName string, name string) *proxy {
+	return &proxy{client: client, serviceName: serviceName, name: name}
+}
+
+// withContext returns a copy of the proxy whose invocations are bound to the given context.
+func (p *proxy) withContext(ctx context.Context) *proxy {
+	if ctx == nil {
+		panic("nil context")
+	}
+	ctxProxy := *p
+	ctxProxy.ctx = ctx
+	return &ctxProxy
+}
+
+// ownerID returns the lock owner ID carried by the context of the proxy, or the default owner ID of the client.
+func (p *proxy) ownerID() int64 {
+	if p.ctx != nil {
+		if ownerID, ok := core.LockOwnerFromContext(p.ctx); ok {
+			return ownerID
+		}
+	}
+	return threadID
 }
 
 func (p *proxy) Destroy() (bool, error) {
@@ -128,28 +157,22 @@ func (p *proxy) validateItemListener(listener interface{}) (err error) {
 }
 
 func (p *proxy) validateEntryListener(listener interface{}) (err error) {
-	argErr := core.NewHazelcastIllegalArgumentError(fmt.Sprintf("not a supported listener type: %v",
-		reflect.TypeOf(listener)), nil)
-	if p.serviceName == bufutil.ServiceNameReplicatedMap {
-		switch listener.(type) {
-		case core.EntryAddedListener:
-		case core.EntryRemovedListener:
-		case core.EntryUpdatedListener:
-		case core.EntryEvictedListener:
-		case core.MapClearedListener:
-		default:
-			err = argErr
-		}
-	} else if p.serviceName == bufutil.ServiceNameMultiMap {
-		switch listener.(type) {
-		case core.EntryAddedListener:
-		case core.EntryRemovedListener:
-		case core.MapClearedListener:
-		default:
-			err = argErr
-		}
+	var supported int32
+	switch p.serviceName {
+	case bufutil.ServiceNameReplicatedMap:
+		supported = bufutil.EntryEventAdded | bufutil.EntryEventRemoved | bufutil.EntryEventUpdated |
+			bufutil.EntryEventEvicted | bufutil.MapEventCleared
+	case bufutil.ServiceNameMultiMap:
+		supported = bufutil.EntryEventAdded | bufutil.EntryEventRemoved | bufutil.MapEventCleared
+	default:
+		return nil
 	}
-	return
+	flags, err := proto.GetMapListenerFlags(listener)
+	if err != nil || flags&supported == 0 {
+		return core.NewHazelcastIllegalArgumentError(fmt.Sprintf("not a supported listener type: %v",
+			reflect.TypeOf(listener)), nil)
+	}
+	return nil
 }
 
 func (p *proxy) validateAndSerializeMapAndGetPartitions(entries map[interface{}]interface{}) (map[int32][]*proto.Pair, error) {
@@ -170,19 +193,42 @@ func (p *proxy) validateAndSerializeMapAndGetPartitions(entries map[interface{}]
 }
 
 func (p *proxy) invokeOnKey(request *proto.ClientMessage, keyData *serialization.Data) (*proto.ClientMessage, error) {
-	return p.client.InvocationService.invokeOnKeyOwner(request, keyData).Result()
+	return p.result(p.invokeOnKeyOwner(request, keyData))
+}
+
+// invokeOnKeyOwner sends the request to the owner of the key without waiting for the response.
+func (p *proxy) invokeOnKeyOwner(request *proto.ClientMessage, keyData *serialization.Data) invocationResult {
+	p.recordOperation(request)
+	return p.client.InvocationService.invokeOnKeyOwner(request, keyData)
 }
 
 func (p *proxy) invokeOnRandomTarget(request *proto.ClientMessage) (*proto.ClientMessage, error) {
-	return p.client.InvocationService.invokeOnRandomTarget(request).Result()
+	p.recordOperation(request)
+	return p.result(p.client.InvocationService.invokeOnRandomTarget(request))
 }
 
 func (p *proxy) invokeOnPartition(request *proto.ClientMessage, partitionID int32) (*proto.ClientMessage, error) {
-	return p.client.InvocationService.invokeOnPartitionOwner(request, partitionID).Result()
+	p.recordOperation(request)
+	return p.result(p.client.InvocationService.invokeOnPartitionOwner(request, partitionID))
 }
 
 func (p *proxy) invokeOnAddress(request *proto.ClientMessage, address *proto.Address) (*proto.ClientMessage, error) {
-	return p.client.InvocationService.invokeOnTarget(request, address).Result()
+	p.recordOperation(request)
+	return p.result(p.client.InvocationService.invokeOnTarget(request, address))
+}
+
+// recordOperation counts the operations of maps for the client statistics.
+func (p *proxy) recordOperation(request *proto.ClientMessage) {
+	if p.serviceName == bufutil.ServiceNameMap {
+		p.client.StatisticsService.recordMapOperation(p.name, request.MessageType())
+	}
+}
+
+func (p *proxy) result(invocationResult invocationResult) (*proto.ClientMessage, error) {
+	if p.ctx == nil {
+		return invocationResult.Result()
+	}
+	return invocationResult.ResultWithContext(p.ctx)
 }
 
 func (p *proxy) toObject(data *serialization.Data) (interface{}, error) {
@@ -193,6 +239,14 @@ func (p *proxy) toData(object interface{}) (*serialization.Data, error) {
 	return p.client.SerializationService.ToData(object)
 }
 
+// toNullableData serializes the value, keeping nil as nil so that it is sent as a null reference.
+func (p *proxy) toNullableData(value interface{}) (*serialization.Data, error) {
+	if value == nil {
+		return nil, nil
+	}
+	return p.toData(value)
+}
+
 func (p *proxy) decodeToObjectAndError(responseMessage *proto.ClientMessage, inputError error,
 	decodeFunc func(*proto.ClientMessage) func() *serialization.Data) (response interface{}, err error) {
 	if inputError != nil {
@@ -241,6 +295,19 @@ func (p *proxy) decodeToInt64AndError(responseMessage *proto.ClientMessage, inpu
 	return decodeFunc(responseMessage)(), nil
 }
 
+// objectDecoder returns a function that decodes a response message to an object with the given decodeFunc,
+// to be used with the futures.
+func (p *proxy) objectDecoder(decodeFunc func(*proto.ClientMessage) func() *serialization.Data) func(
+	*proto.ClientMessage) (interface{}, error) {
+	return func(responseMessage *proto.ClientMessage) (interface{}, error) {
+		return p.toObject(decodeFunc(responseMessage)())
+	}
+}
+
+func decodeToNil(responseMessage *proto.ClientMessage) (interface{}, error) {
+	return nil, nil
+}
+
 type partitionSpecificProxy struct {
 	*proxy
 	partitionID int32
@@ -248,16 +315,36 @@ type partitionSpecificProxy struct {
 
 func newPartitionSpecificProxy(client *HazelcastClient, serviceName string, name string) (*partitionSpecificProxy, error) {
 	var err error
-	parSpecProxy := &partitionSpecificProxy{proxy: &proxy{client, serviceName, name}}
+	parSpecProxy := &partitionSpecificProxy{proxy: newProxy(client, serviceName, name)}
 	parSpecProxy.partitionID, err = parSpecProxy.client.PartitionService.GetPartitionIDWithKey(parSpecProxy.PartitionKey())
 	return parSpecProxy, err
 
 }
 
+func (parSpecProxy *partitionSpecificProxy) withContext(ctx context.Context) *partitionSpecificProxy {
+	return &partitionSpecificProxy{proxy: parSpecProxy.proxy.withContext(ctx), partitionID: parSpecProxy.partitionID}
+}
+
 func (parSpecProxy *partitionSpecificProxy) invoke(request *proto.ClientMessage) (*proto.ClientMessage, error) {
 	return parSpecProxy.invokeOnPartition(request, parSpecProxy.partitionID)
 }
 
+// invokeBlocking invokes an operation that waits on the member for up to the given timeout, or until it completes
+// if the timeout is negative. The invocation timeout of the client is counted after the timeout of the operation,
+// so that an operation that is still waiting on the member is retried instead of failing with a HazelcastTimeoutError.
+// A context bound to the proxy still bounds the whole operation.
+func (parSpecProxy *partitionSpecificProxy) invokeBlocking(request *proto.ClientMessage,
+	timeout time.Duration) (*proto.ClientMessage, error) {
+	invocation := newInvocation(request, parSpecProxy.partitionID, nil, nil, parSpecProxy.client)
+	if timeout < 0 {
+		invocation.deadline = invocation.startTime.Add(time.Duration(math.MaxInt64))
+	} else {
+		invocation.deadline = invocation.deadline.Add(timeout)
+	}
+	parSpecProxy.recordOperation(request)
+	return parSpecProxy.result(parSpecProxy.client.InvocationService.sendInvocation(invocation))
+}
+
 func (p *proxy) createOnItemEvent(listener interface{}) func(itemData *serialization.Data, uuid string, eventType int32) {
 	return func(itemData *serialization.Data, uuid string, eventType int32) {
 		var item interface{}
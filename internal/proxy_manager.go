@@ -89,7 +89,7 @@ func (pm *proxyManager) destroyProxy(serviceName string, name string) (bool, err
 }
 
 func (pm *proxyManager) findNextProxyAddress() *proto.Address {
-	return pm.client.LoadBalancer.nextAddress()
+	return nextAddress(pm.client.LoadBalancer)
 }
 
 func (pm *proxyManager) getProxyByNameSpace(serviceName string, name string) (core.DistributedObject, error) {
@@ -101,6 +101,8 @@ func (pm *proxyManager) getProxyByNameSpace(serviceName string, name string) (co
 		return newSetProxy(pm.client, serviceName, name)
 	} else if bufutil.ServiceNameTopic == serviceName {
 		return newTopicProxy(pm.client, serviceName, name)
+	} else if bufutil.ServiceNameReliableTopic == serviceName {
+		return newReliableTopicProxy(pm.client, serviceName, name)
 	} else if bufutil.ServiceNameMultiMap == serviceName {
 		return newMultiMapProxy(pm.client, serviceName, name)
 	} else if bufutil.ServiceNameReplicatedMap == serviceName {
@@ -111,6 +113,20 @@ func (pm *proxyManager) getProxyByNameSpace(serviceName string, name string) (co
 		return newRingbufferProxy(pm.client, serviceName, name)
 	} else if bufutil.ServiceNamePNCounter == serviceName {
 		return newPNCounterProxy(pm.client, serviceName, name)
+	} else if bufutil.ServiceNameAtomicLong == serviceName {
+		return newAtomicLongProxy(pm.client, serviceName, name)
+	} else if bufutil.ServiceNameAtomicReference == serviceName {
+		return newAtomicReferenceProxy(pm.client, serviceName, name)
+	} else if bufutil.ServiceNameCountDownLatch == serviceName {
+		return newCountDownLatchProxy(pm.client, serviceName, name)
+	} else if bufutil.ServiceNameSemaphore == serviceName {
+		return newSemaphoreProxy(pm.client, serviceName, name)
+	} else if bufutil.ServiceNameExecutor == serviceName {
+		return newExecutorServiceProxy(pm.client, serviceName, name)
+	} else if bufutil.ServiceNameScheduledExecutor == serviceName {
+		return newScheduledExecutorServiceProxy(pm.client, serviceName, name)
+	} else if bufutil.ServiceNameLock == serviceName {
+		return newLockProxy(pm.client, serviceName, name)
 	} else if bufutil.ServiceNameIDGenerator == serviceName {
 		return newFlakeIDGenerator(pm.client, serviceName, name)
 	}
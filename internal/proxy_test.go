@@ -0,0 +1,75 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/config"
+	"github.com/hazelcast/hazelcast-go-client/config/property"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+)
+
+// deadlineRecordingInvocationService completes the invocations it is given and records their deadlines.
+type deadlineRecordingInvocationService struct {
+	invocationService
+	deadlines []time.Time
+}
+
+func (s *deadlineRecordingInvocationService) sendInvocation(invocation *invocation) invocationResult {
+	s.deadlines = append(s.deadlines, invocation.deadline)
+	invocation.complete(invocation.request.Load().(*proto.ClientMessage))
+	return invocation
+}
+
+func TestProxy_OwnerID(t *testing.T) {
+	p := newProxy(nil, "service", "name")
+	if p.ownerID() != threadID {
+		t.Errorf("expected the default owner ID, got %d", p.ownerID())
+	}
+	if ownerID := p.withContext(context.Background()).ownerID(); ownerID != threadID {
+		t.Errorf("expected the default owner ID for a context without an owner, got %d", ownerID)
+	}
+	ctx := core.WithLockOwner(context.Background(), 42)
+	if ownerID := p.withContext(ctx).ownerID(); ownerID != 42 {
+		t.Errorf("expected the owner ID of the context, got %d", ownerID)
+	}
+}
+
+func TestPartitionSpecificProxy_InvokeBlocking(t *testing.T) {
+	service := &deadlineRecordingInvocationService{}
+	client := &HazelcastClient{
+		properties:        property.NewHazelcastProperties(config.Properties{property.InvocationTimeoutSeconds.Name(): "10"}),
+		InvocationService: service,
+	}
+	p := &partitionSpecificProxy{proxy: newProxy(client, bufutil.ServiceNameSemaphore, "name")}
+	start := time.Now()
+	if _, err := p.invokeBlocking(proto.SemaphoreTryAcquireEncodeRequest("name", 1, 60000), time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.invokeBlocking(proto.SemaphoreAcquireEncodeRequest("name", 1), -1); err != nil {
+		t.Fatal(err)
+	}
+	if deadline := service.deadlines[0].Sub(start); deadline < 70*time.Second || deadline > 71*time.Second {
+		t.Errorf("expected the invocation timeout to be counted after the timeout, got %v", deadline)
+	}
+	if deadline := service.deadlines[1].Sub(start); deadline < 100*365*24*time.Hour {
+		t.Errorf("expected no deadline for an operation without a timeout, got %v", deadline)
+	}
+}
@@ -0,0 +1,650 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"sync"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/iputil"
+	"github.com/hazelcast/hazelcast-go-client/internal/predicate"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/internal/querycache"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+// The publisher settings of the query caches, they are the defaults of the members.
+const (
+	queryCacheBatchSize    = 1
+	queryCacheBufferSize   = 16
+	queryCacheDelaySeconds = 0
+	queryCachePopulate     = true
+	queryCacheCoalesce     = false
+)
+
+type queryCacheKey struct {
+	mapName   string
+	cacheName string
+}
+
+// queryCacheManager keeps the query caches of the client, so that a query cache is created only once
+// for a map and name.
+type queryCacheManager struct {
+	client      *HazelcastClient
+	mu          sync.Mutex // guards queryCaches
+	queryCaches map[queryCacheKey]*queryCacheProxy
+}
+
+func newQueryCacheManager(client *HazelcastClient) *queryCacheManager {
+	return &queryCacheManager{
+		client:      client,
+		queryCaches: make(map[queryCacheKey]*queryCacheProxy),
+	}
+}
+
+func (qcm *queryCacheManager) getOrCreateQueryCache(mapName string, name string, predicate interface{},
+	includeValue bool) (*queryCacheProxy, error) {
+	key := queryCacheKey{mapName, name}
+	qcm.mu.Lock()
+	defer qcm.mu.Unlock()
+	if queryCache, found := qcm.queryCaches[key]; found {
+		return queryCache, nil
+	}
+	queryCache := newQueryCacheProxy(qcm, mapName, name, includeValue)
+	if err := queryCache.create(predicate); err != nil {
+		return nil, err
+	}
+	qcm.queryCaches[key] = queryCache
+	return queryCache, nil
+}
+
+func (qcm *queryCacheManager) removeQueryCache(mapName string, name string) {
+	qcm.mu.Lock()
+	defer qcm.mu.Unlock()
+	delete(qcm.queryCaches, queryCacheKey{mapName, name})
+}
+
+// queryCacheProxy is a core.QueryCache. The members publish the changes of the entries that satisfy
+// the predicate of the query cache to its listener, which is named by the cacheID.
+// Every partition numbers its events, the sequences are tracked to detect the missed events.
+type queryCacheProxy struct {
+	*proxy
+	manager         *queryCacheManager
+	cacheName       string
+	cacheID         string
+	includeValue    bool
+	predicateData   *serialization.Data
+	store           *querycache.Store
+	listenerID      string
+	clusterListener *queryCacheClusterListener
+	mu              sync.Mutex // guards sequences and brokenSequences
+	sequences       map[int32]int64
+	brokenSequences map[int32]int64
+	listenersMu     sync.RWMutex // guards listeners
+	listeners       map[string]*queryCacheListener
+}
+
+type queryCacheListener struct {
+	listener     interface{}
+	includeValue bool
+}
+
+// queryCacheClusterListener creates the publishers of a query cache on the members that join the cluster,
+// and recreates all of them when the client reconnects, since the members may have lost them.
+type queryCacheClusterListener struct {
+	queryCache           *queryCacheProxy
+	membershipListenerID string
+	lifecycleListenerID  string
+}
+
+func (l *queryCacheClusterListener) MemberAdded(member core.Member) {
+	go l.queryCache.createPublisher(member.Address().(*proto.Address))
+}
+
+func (l *queryCacheClusterListener) LifecycleStateChanged(newState string) {
+	// The listener is added when the client is connected, so every connected event is a reconnection.
+	if newState == LifecycleStateConnected {
+		go l.queryCache.recreatePublishers()
+	}
+}
+
+type eventLostEvent struct {
+	partitionID int32
+}
+
+func (e *eventLostEvent) PartitionID() int32 {
+	return e.partitionID
+}
+
+func newQueryCacheProxy(manager *queryCacheManager, mapName string, name string,
+	includeValue bool) *queryCacheProxy {
+	return &queryCacheProxy{
+		proxy:           newProxy(manager.client, bufutil.ServiceNameMap, mapName),
+		manager:         manager,
+		cacheName:       name,
+		includeValue:    includeValue,
+		store:           querycache.New(),
+		sequences:       make(map[int32]int64),
+		brokenSequences: make(map[int32]int64),
+		listeners:       make(map[string]*queryCacheListener),
+	}
+}
+
+// create registers the listener of the query cache before the publishers are created on the members,
+// so that no event is missed. The members start publishing after the query cache is populated.
+func (qc *queryCacheProxy) create(predicate interface{}) (err error) {
+	qc.predicateData, err = qc.validateAndSerializePredicate(predicate)
+	if err != nil {
+		return err
+	}
+	qc.cacheID, err = iputil.NewUUID()
+	if err != nil {
+		return err
+	}
+	request := proto.ContinuousQueryAddListenerEncodeRequest(qc.cacheID, qc.isSmart())
+	eventHandler := func(clientMessage *proto.ClientMessage) {
+		proto.ContinuousQueryAddListenerHandle(clientMessage, qc.onEvent, qc.onBatchEvent)
+	}
+	qc.listenerID, err = qc.client.ListenerService.registerListener(request, eventHandler,
+		qc.removeListenerRequest, func(clientMessage *proto.ClientMessage) string {
+			return proto.ContinuousQueryAddListenerDecodeResponse(clientMessage)()
+		})
+	if err != nil {
+		return err
+	}
+	qc.clusterListener = &queryCacheClusterListener{queryCache: qc}
+	qc.clusterListener.membershipListenerID = qc.client.ClusterService.AddListener(qc.clusterListener)
+	qc.clusterListener.lifecycleListenerID = qc.client.LifecycleService.AddListener(qc.clusterListener)
+	members := qc.client.ClusterService.GetMembers()
+	for _, member := range members {
+		if err = qc.populate(member.Address().(*proto.Address)); err != nil {
+			qc.destroy()
+			return err
+		}
+	}
+	for _, member := range members {
+		request := proto.ContinuousQueryMadePublishableEncodeRequest(qc.name, qc.cacheID)
+		if _, err = qc.invokeOnAddress(request, member.Address().(*proto.Address)); err != nil {
+			qc.destroy()
+			return err
+		}
+	}
+	return nil
+}
+
+// createPublisher creates the publisher of the query cache on a member that joined the cluster.
+// If it fails, the events of the partitions owned by the member are lost, so the EventLostListeners
+// are notified for all the partitions.
+func (qc *queryCacheProxy) createPublisher(address *proto.Address) {
+	err := qc.populate(address)
+	if err == nil {
+		request := proto.ContinuousQueryMadePublishableEncodeRequest(qc.name, qc.cacheID)
+		_, err = qc.invokeOnAddress(request, address)
+	}
+	if err != nil {
+		qc.client.logger.Warn("Could not create the publisher of query cache ", qc.cacheName, " on member ",
+			address, ": ", err)
+		qc.markAllPartitionsBroken()
+	}
+}
+
+// recreatePublishers repopulates the query cache from scratch after the client reconnects to the cluster.
+// The sequences of the partitions are reset, since the members that restarted number their events from the start.
+func (qc *queryCacheProxy) recreatePublishers() {
+	qc.mu.Lock()
+	qc.sequences = make(map[int32]int64)
+	qc.brokenSequences = make(map[int32]int64)
+	qc.mu.Unlock()
+	qc.store.Clear()
+	for _, member := range qc.client.ClusterService.GetMembers() {
+		qc.createPublisher(member.Address().(*proto.Address))
+	}
+}
+
+// markAllPartitionsBroken records every partition as broken, so that TryRecover asks the members to
+// publish their events again from the next expected sequence.
+func (qc *queryCacheProxy) markAllPartitionsBroken() {
+	partitionCount := qc.client.PartitionService.getPartitionCount()
+	qc.mu.Lock()
+	for partitionID := int32(0); partitionID < partitionCount; partitionID++ {
+		if _, broken := qc.brokenSequences[partitionID]; !broken {
+			qc.brokenSequences[partitionID] = qc.sequences[partitionID] + 1
+		}
+	}
+	qc.mu.Unlock()
+	for partitionID := int32(0); partitionID < partitionCount; partitionID++ {
+		qc.fireEventLost(partitionID)
+	}
+}
+
+// populate creates the publisher of the query cache on the member with the given address and
+// stores the entries of the partitions owned by the member.
+func (qc *queryCacheProxy) populate(address *proto.Address) error {
+	if !qc.includeValue {
+		request := proto.ContinuousQueryPublisherCreateEncodeRequest(qc.name, qc.cacheID, qc.predicateData,
+			queryCacheBatchSize, queryCacheBufferSize, queryCacheDelaySeconds, queryCachePopulate, queryCacheCoalesce)
+		responseMessage, err := qc.invokeOnAddress(request, address)
+		if err != nil {
+			return err
+		}
+		for _, keyData := range proto.ContinuousQueryPublisherCreateDecodeResponse(responseMessage)() {
+			key, err := qc.toObject(keyData)
+			if err != nil {
+				return err
+			}
+			qc.store.Put(keyData, key, nil)
+		}
+		return nil
+	}
+	request := proto.ContinuousQueryPublisherCreateWithValueEncodeRequest(qc.name, qc.cacheID, qc.predicateData,
+		queryCacheBatchSize, queryCacheBufferSize, queryCacheDelaySeconds, queryCachePopulate, queryCacheCoalesce)
+	responseMessage, err := qc.invokeOnAddress(request, address)
+	if err != nil {
+		return err
+	}
+	for _, pair := range proto.ContinuousQueryPublisherCreateWithValueDecodeResponse(responseMessage)() {
+		keyData := pair.Key().(*serialization.Data)
+		key, err := qc.toObject(keyData)
+		if err != nil {
+			return err
+		}
+		value, err := qc.toObject(pair.Value().(*serialization.Data))
+		if err != nil {
+			return err
+		}
+		qc.store.Put(keyData, key, value)
+	}
+	return nil
+}
+
+func (qc *queryCacheProxy) removeListenerRequest(registrationID string) *proto.ClientMessage {
+	return proto.MapRemoveEntryListenerEncodeRequest(qc.cacheID, registrationID)
+}
+
+func (qc *queryCacheProxy) onEvent(event *proto.QueryCacheEventData) {
+	if qc.isNextEvent(event) {
+		qc.applyEvent(event)
+	}
+}
+
+func (qc *queryCacheProxy) onBatchEvent(events []*proto.QueryCacheEventData, source string, partitionID int32) {
+	for _, event := range events {
+		qc.onEvent(event)
+	}
+}
+
+// isNextEvent reports whether the given event should be applied, i.e. it is not a duplicate.
+// If some events of the partition are skipped, the first skipped sequence is recorded for
+// TryRecover and the EventLostListeners are notified. The event is applied anyway.
+func (qc *queryCacheProxy) isNextEvent(event *proto.QueryCacheEventData) bool {
+	partitionID := event.PartitionID()
+	qc.mu.Lock()
+	expected := qc.sequences[partitionID] + 1
+	if event.Sequence() < expected {
+		qc.mu.Unlock()
+		return false
+	}
+	lost := event.Sequence() > expected
+	if _, broken := qc.brokenSequences[partitionID]; lost && !broken {
+		qc.brokenSequences[partitionID] = expected
+	}
+	qc.sequences[partitionID] = event.Sequence()
+	qc.mu.Unlock()
+	if lost {
+		qc.fireEventLost(partitionID)
+	}
+	return true
+}
+
+func (qc *queryCacheProxy) applyEvent(event *proto.QueryCacheEventData) {
+	eventType := event.EventType()
+	switch eventType {
+	case bufutil.MapEventCleared, bufutil.MapEventEvicted:
+		numberOfAffectedEntries := qc.store.Clear()
+		qc.fireMapEvent(eventType, int32(numberOfAffectedEntries))
+		return
+	}
+	keyData := event.KeyData()
+	if keyData == nil {
+		return
+	}
+	key, err := qc.toObject(keyData)
+	if err != nil {
+		qc.client.logger.Warn("Could not deserialize the key of the query cache event: ", err)
+		return
+	}
+	switch eventType {
+	case bufutil.EntryEventAdded, bufutil.EntryEventUpdated, bufutil.EntryEventMerged:
+		var value interface{}
+		if qc.includeValue {
+			value, err = qc.toObject(event.ValueData())
+			if err != nil {
+				qc.client.logger.Warn("Could not deserialize the value of the query cache event: ", err)
+				return
+			}
+		}
+		var oldValue interface{}
+		if old := qc.store.Put(keyData, key, value); old != nil {
+			oldValue = old.Value
+		}
+		qc.fireEntryEvent(eventType, key, value, oldValue)
+	case bufutil.EntryEventRemoved, bufutil.EntryEventEvicted, bufutil.EntryEventExpired:
+		if old := qc.store.Remove(keyData); old != nil {
+			qc.fireEntryEvent(eventType, key, nil, old.Value)
+		}
+	}
+}
+
+func (qc *queryCacheProxy) fireEntryEvent(eventType int32, key interface{}, value interface{}, oldValue interface{}) {
+	for _, l := range qc.listenerList() {
+		var entryEvent *proto.EntryEvent
+		if l.includeValue {
+			entryEvent = proto.NewEntryEvent(qc.cacheName, nil, eventType, key, value, oldValue, nil)
+		} else {
+			entryEvent = proto.NewEntryEvent(qc.cacheName, nil, eventType, key, nil, nil, nil)
+		}
+		switch eventType {
+		case bufutil.EntryEventAdded:
+			if listener, ok := l.listener.(core.EntryAddedListener); ok {
+				listener.EntryAdded(entryEvent)
+			}
+		case bufutil.EntryEventUpdated:
+			if listener, ok := l.listener.(core.EntryUpdatedListener); ok {
+				listener.EntryUpdated(entryEvent)
+			}
+		case bufutil.EntryEventMerged:
+			if listener, ok := l.listener.(core.EntryMergedListener); ok {
+				listener.EntryMerged(entryEvent)
+			}
+		case bufutil.EntryEventRemoved:
+			if listener, ok := l.listener.(core.EntryRemovedListener); ok {
+				listener.EntryRemoved(entryEvent)
+			}
+		case bufutil.EntryEventEvicted:
+			if listener, ok := l.listener.(core.EntryEvictedListener); ok {
+				listener.EntryEvicted(entryEvent)
+			}
+		case bufutil.EntryEventExpired:
+			if listener, ok := l.listener.(core.EntryExpiredListener); ok {
+				listener.EntryExpired(entryEvent)
+			}
+		}
+	}
+}
+
+func (qc *queryCacheProxy) fireMapEvent(eventType int32, numberOfAffectedEntries int32) {
+	mapEvent := proto.NewMapEvent(qc.cacheName, nil, eventType, numberOfAffectedEntries)
+	for _, l := range qc.listenerList() {
+		switch eventType {
+		case bufutil.MapEventCleared:
+			if listener, ok := l.listener.(core.MapClearedListener); ok {
+				listener.MapCleared(mapEvent)
+			}
+		case bufutil.MapEventEvicted:
+			if listener, ok := l.listener.(core.MapEvictedListener); ok {
+				listener.MapEvicted(mapEvent)
+			}
+		}
+	}
+}
+
+func (qc *queryCacheProxy) fireEventLost(partitionID int32) {
+	event := &eventLostEvent{partitionID}
+	for _, l := range qc.listenerList() {
+		if listener, ok := l.listener.(core.EventLostListener); ok {
+			listener.EventLost(event)
+		}
+	}
+}
+
+func (qc *queryCacheProxy) listenerList() []*queryCacheListener {
+	qc.listenersMu.RLock()
+	defer qc.listenersMu.RUnlock()
+	listeners := make([]*queryCacheListener, 0, len(qc.listeners))
+	for _, listener := range qc.listeners {
+		listeners = append(listeners, listener)
+	}
+	return listeners
+}
+
+func (qc *queryCacheProxy) Name() string {
+	return qc.cacheName
+}
+
+func (qc *queryCacheProxy) Get(key interface{}) (value interface{}, err error) {
+	keyData, err := qc.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	entry := qc.store.Get(keyData)
+	if entry == nil {
+		return nil, nil
+	}
+	if !qc.includeValue {
+		return (&mapProxy{qc.proxy}).Get(key)
+	}
+	return entry.Value, nil
+}
+
+func (qc *queryCacheProxy) ContainsKey(key interface{}) (found bool, err error) {
+	keyData, err := qc.validateAndSerialize(key)
+	if err != nil {
+		return false, err
+	}
+	return qc.store.Get(keyData) != nil, nil
+}
+
+func (qc *queryCacheProxy) Size() int32 {
+	return int32(qc.store.Size())
+}
+
+func (qc *queryCacheProxy) IsEmpty() bool {
+	return qc.store.Size() == 0
+}
+
+func (qc *queryCacheProxy) KeySet() (keySet []interface{}, err error) {
+	return qc.keySet(nil)
+}
+
+func (qc *queryCacheProxy) KeySetWithPredicate(predicate interface{}) (keySet []interface{}, err error) {
+	if predicate == nil {
+		return nil, core.NewHazelcastNilPointerError(bufutil.NilPredicateIsNotAllowed, nil)
+	}
+	return qc.keySet(predicate)
+}
+
+// query returns the entries of the store that satisfy the given predicate. If the values are not cached,
+// the predicate can only refer to the attributes of the keys.
+func (qc *queryCacheProxy) query(pred interface{}) ([]*querycache.Entry, error) {
+	if !qc.includeValue && predicate.RefersToValue(pred) {
+		return nil, core.NewHazelcastIllegalArgumentError("query cache "+qc.cacheName+" does not cache the values, "+
+			"its predicates can only refer to the attributes of the keys", nil)
+	}
+	return qc.store.Query(pred)
+}
+
+// readValues reads the values of the given entries from the map if the values are not cached.
+// The entries that are removed from the map in the meantime are left out.
+func (qc *queryCacheProxy) readValues(entries []*querycache.Entry) ([]*querycache.Entry, error) {
+	if qc.includeValue || len(entries) == 0 {
+		return entries, nil
+	}
+	keys := make([]interface{}, len(entries))
+	for i, entry := range entries {
+		keys[i] = entry.Key
+	}
+	values, err := (&mapProxy{qc.proxy}).GetAll(keys)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*querycache.Entry, 0, len(entries))
+	for _, entry := range entries {
+		if value, found := values[entry.Key]; found {
+			result = append(result, &querycache.Entry{KeyData: entry.KeyData, Key: entry.Key, Value: value})
+		}
+	}
+	return result, nil
+}
+
+func (qc *queryCacheProxy) keySet(pred interface{}) ([]interface{}, error) {
+	entries, err := qc.query(pred)
+	if err != nil {
+		return nil, err
+	}
+	keySet := make([]interface{}, len(entries))
+	for i, entry := range entries {
+		keySet[i] = entry.Key
+	}
+	return keySet, nil
+}
+
+func (qc *queryCacheProxy) Values() (values []interface{}, err error) {
+	return qc.values(nil)
+}
+
+func (qc *queryCacheProxy) ValuesWithPredicate(predicate interface{}) (values []interface{}, err error) {
+	if predicate == nil {
+		return nil, core.NewHazelcastNilPointerError(bufutil.NilPredicateIsNotAllowed, nil)
+	}
+	return qc.values(predicate)
+}
+
+func (qc *queryCacheProxy) values(pred interface{}) ([]interface{}, error) {
+	entries, err := qc.query(pred)
+	if err == nil {
+		entries, err = qc.readValues(entries)
+	}
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(entries))
+	for i, entry := range entries {
+		values[i] = entry.Value
+	}
+	return values, nil
+}
+
+func (qc *queryCacheProxy) EntrySet() (resultPairs []core.Pair, err error) {
+	return qc.entrySet(nil)
+}
+
+func (qc *queryCacheProxy) EntrySetWithPredicate(predicate interface{}) (resultPairs []core.Pair, err error) {
+	if predicate == nil {
+		return nil, core.NewHazelcastNilPointerError(bufutil.NilPredicateIsNotAllowed, nil)
+	}
+	return qc.entrySet(predicate)
+}
+
+func (qc *queryCacheProxy) entrySet(pred interface{}) ([]core.Pair, error) {
+	entries, err := qc.query(pred)
+	if err == nil {
+		entries, err = qc.readValues(entries)
+	}
+	if err != nil {
+		return nil, err
+	}
+	pairs := make([]core.Pair, len(entries))
+	for i, entry := range entries {
+		pairs[i] = proto.NewPair(entry.Key, entry.Value)
+	}
+	return pairs, nil
+}
+
+func (qc *queryCacheProxy) AddEntryListener(listener interface{}, includeValue bool) (registrationID string, err error) {
+	if _, ok := listener.(core.EventLostListener); !ok {
+		if _, err = proto.GetMapListenerFlags(listener); err != nil {
+			return "", err
+		}
+	}
+	registrationID, err = iputil.NewUUID()
+	if err != nil {
+		return "", err
+	}
+	qc.listenersMu.Lock()
+	defer qc.listenersMu.Unlock()
+	qc.listeners[registrationID] = &queryCacheListener{listener: listener, includeValue: includeValue}
+	return registrationID, nil
+}
+
+func (qc *queryCacheProxy) RemoveEntryListener(registrationID string) (removed bool) {
+	qc.listenersMu.Lock()
+	defer qc.listenersMu.Unlock()
+	_, removed = qc.listeners[registrationID]
+	delete(qc.listeners, registrationID)
+	return removed
+}
+
+func (qc *queryCacheProxy) AddIndex(attribute string, ordered bool) (err error) {
+	if attribute == "" {
+		return core.NewHazelcastIllegalArgumentError("attribute should not be empty", nil)
+	}
+	qc.store.AddIndex(attribute, ordered)
+	return nil
+}
+
+func (qc *queryCacheProxy) TryRecover() (recovered bool, err error) {
+	qc.mu.Lock()
+	brokenSequences := make(map[int32]int64, len(qc.brokenSequences))
+	for partitionID, sequence := range qc.brokenSequences {
+		brokenSequences[partitionID] = sequence
+	}
+	qc.mu.Unlock()
+	recovered = true
+	for partitionID, sequence := range brokenSequences {
+		request := proto.ContinuousQuerySetReadCursorEncodeRequest(qc.name, qc.cacheID, sequence)
+		responseMessage, err := qc.invokeOnPartition(request, partitionID)
+		if err != nil {
+			return false, err
+		}
+		if !proto.ContinuousQuerySetReadCursorDecodeResponse(responseMessage)() {
+			recovered = false
+			continue
+		}
+		// The member publishes the events again starting from the given sequence.
+		qc.mu.Lock()
+		if qc.brokenSequences[partitionID] == sequence {
+			delete(qc.brokenSequences, partitionID)
+			qc.sequences[partitionID] = sequence - 1
+		}
+		qc.mu.Unlock()
+	}
+	return recovered, nil
+}
+
+func (qc *queryCacheProxy) Destroy() (err error) {
+	qc.manager.removeQueryCache(qc.name, qc.cacheName)
+	return qc.destroy()
+}
+
+// destroy removes the listener and the publishers of the query cache. It returns the first error
+// that occurred, after trying to remove all of them.
+func (qc *queryCacheProxy) destroy() (err error) {
+	if qc.clusterListener != nil {
+		qc.client.ClusterService.RemoveListener(qc.clusterListener.membershipListenerID)
+		qc.client.LifecycleService.RemoveListener(qc.clusterListener.lifecycleListenerID)
+	}
+	if qc.listenerID != "" {
+		_, err = qc.client.ListenerService.deregisterListener(qc.listenerID, qc.removeListenerRequest)
+	}
+	for _, member := range qc.client.ClusterService.GetMembers() {
+		request := proto.ContinuousQueryDestroyCacheEncodeRequest(qc.name, qc.cacheID)
+		if _, destroyErr := qc.invokeOnAddress(request, member.Address().(*proto.Address)); err == nil {
+			err = destroyErr
+		}
+	}
+	qc.store.Clear()
+	return err
+}
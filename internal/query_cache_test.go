@@ -0,0 +1,195 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client/config"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/predicate"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+type queryCacheTestListener struct {
+	added, updated, removed []core.EntryEvent
+	cleared                 []core.MapEvent
+	lost                    []int32
+}
+
+func (l *queryCacheTestListener) EntryAdded(event core.EntryEvent) {
+	l.added = append(l.added, event)
+}
+
+func (l *queryCacheTestListener) EntryUpdated(event core.EntryEvent) {
+	l.updated = append(l.updated, event)
+}
+
+func (l *queryCacheTestListener) EntryRemoved(event core.EntryEvent) {
+	l.removed = append(l.removed, event)
+}
+
+func (l *queryCacheTestListener) MapCleared(event core.MapEvent) {
+	l.cleared = append(l.cleared, event)
+}
+
+func (l *queryCacheTestListener) EventLost(event core.EventLostEvent) {
+	l.lost = append(l.lost, event.PartitionID())
+}
+
+func newTestQueryCache(t *testing.T, includeValue bool) *queryCacheProxy {
+	service, err := serialization.NewSerializationService(config.NewSerializationConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &HazelcastClient{SerializationService: service}
+	return newQueryCacheProxy(newQueryCacheManager(client), "map", "cache", includeValue)
+}
+
+func queryCacheEvent(t *testing.T, qc *queryCacheProxy, sequence int64, key interface{}, value interface{},
+	eventType int32, partitionID int32) *proto.QueryCacheEventData {
+	keyData, err := qc.toNullableData(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valueData, err := qc.toNullableData(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return proto.NewQueryCacheEventData(sequence, keyData, valueData, eventType, partitionID)
+}
+
+func TestQueryCacheProxy_ApplyEvents(t *testing.T) {
+	qc := newTestQueryCache(t, true)
+	listener := &queryCacheTestListener{}
+	if _, err := qc.AddEntryListener(listener, true); err != nil {
+		t.Fatal(err)
+	}
+	qc.onEvent(queryCacheEvent(t, qc, 1, "a", int32(1), bufutil.EntryEventAdded, 0))
+	qc.onBatchEvent([]*proto.QueryCacheEventData{
+		queryCacheEvent(t, qc, 1, "b", int32(2), bufutil.EntryEventAdded, 1),
+		queryCacheEvent(t, qc, 2, "a", int32(3), bufutil.EntryEventUpdated, 0),
+	}, "source", 0)
+	if value, err := qc.Get("a"); err != nil || value != int32(3) {
+		t.Errorf("expected the updated value, got %v, %v", value, err)
+	}
+	if size := qc.Size(); size != 2 {
+		t.Errorf("expected size 2 got %d", size)
+	}
+	if len(listener.added) != 2 || len(listener.updated) != 1 || listener.updated[0].OldValue() != int32(1) {
+		t.Errorf("unexpected entry events added: %v updated: %v", listener.added, listener.updated)
+	}
+	keySet, err := qc.KeySetWithPredicate(predicate.NewGreaterLess("this", 2, false, false))
+	if err != nil || len(keySet) != 1 || keySet[0] != "a" {
+		t.Errorf("expected the key of the greater value, got %v, %v", keySet, err)
+	}
+
+	qc.onEvent(queryCacheEvent(t, qc, 2, "b", nil, bufutil.EntryEventRemoved, 1))
+	if found, _ := qc.ContainsKey("b"); found {
+		t.Error("expected the removed key not to be found")
+	}
+	if len(listener.removed) != 1 || listener.removed[0].OldValue() != int32(2) {
+		t.Errorf("unexpected removed events %v", listener.removed)
+	}
+
+	qc.onEvent(queryCacheEvent(t, qc, 3, nil, nil, bufutil.MapEventCleared, 0))
+	if !qc.IsEmpty() {
+		t.Error("expected the query cache to be cleared")
+	}
+	if len(listener.cleared) != 1 || listener.cleared[0].NumberOfAffectedEntries() != 1 {
+		t.Errorf("unexpected cleared events %v", listener.cleared)
+	}
+}
+
+func TestQueryCacheProxy_WithoutValues(t *testing.T) {
+	qc := newTestQueryCache(t, false)
+	qc.onEvent(queryCacheEvent(t, qc, 1, "a", int32(1), bufutil.EntryEventAdded, 0))
+	if found, _ := qc.ContainsKey("a"); !found {
+		t.Error("expected the key to be found")
+	}
+	keySet, err := qc.KeySetWithPredicate(predicate.NewEqual("__key", "a"))
+	if err != nil || len(keySet) != 1 || keySet[0] != "a" {
+		t.Errorf("expected the key predicate to be evaluated, got %v, %v", keySet, err)
+	}
+	_, err = qc.KeySetWithPredicate(predicate.NewEqual("this", int32(1)))
+	if _, ok := err.(*core.HazelcastIllegalArgumentError); !ok {
+		t.Errorf("expected HazelcastIllegalArgumentError for a value predicate, got %v", err)
+	}
+	_, err = qc.ValuesWithPredicate(predicate.NewNot(predicate.NewEqual("this", int32(1))))
+	if _, ok := err.(*core.HazelcastIllegalArgumentError); !ok {
+		t.Errorf("expected HazelcastIllegalArgumentError for a value predicate, got %v", err)
+	}
+}
+
+func TestQueryCacheProxy_EventLost(t *testing.T) {
+	qc := newTestQueryCache(t, true)
+	listener := &queryCacheTestListener{}
+	registrationID, err := qc.AddEntryListener(listener, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	qc.onEvent(queryCacheEvent(t, qc, 1, "a", int32(1), bufutil.EntryEventAdded, 5))
+	qc.onEvent(queryCacheEvent(t, qc, 4, "b", int32(2), bufutil.EntryEventAdded, 5))
+	qc.onEvent(queryCacheEvent(t, qc, 6, "c", int32(3), bufutil.EntryEventAdded, 5))
+	if len(listener.lost) != 2 || listener.lost[0] != 5 {
+		t.Errorf("expected two lost events for partition 5, got %v", listener.lost)
+	}
+	if sequence := qc.brokenSequences[5]; sequence != 2 {
+		t.Errorf("expected the first missed sequence to be recorded, got %d", sequence)
+	}
+	if len(listener.added) != 3 || listener.added[0].Value() != nil {
+		t.Errorf("expected the events to be applied without values, got %v", listener.added)
+	}
+	qc.onEvent(queryCacheEvent(t, qc, 4, "b", int32(5), bufutil.EntryEventUpdated, 5))
+	if value, _ := qc.Get("b"); value != int32(2) {
+		t.Errorf("expected the duplicate event to be ignored, got %v", value)
+	}
+	if !qc.RemoveEntryListener(registrationID) || qc.RemoveEntryListener(registrationID) {
+		t.Error("expected the listener to be removed once")
+	}
+}
+
+func TestQueryCacheProxy_MarkAllPartitionsBroken(t *testing.T) {
+	qc := newTestQueryCache(t, true)
+	qc.client.PartitionService = newPartitionService(qc.client)
+	qc.client.PartitionService.mp.Store(map[int32]*proto.Address{0: nil, 1: nil, 2: nil})
+	listener := &queryCacheTestListener{}
+	if _, err := qc.AddEntryListener(listener, false); err != nil {
+		t.Fatal(err)
+	}
+	qc.onEvent(queryCacheEvent(t, qc, 3, "a", int32(1), bufutil.EntryEventAdded, 1))
+	qc.markAllPartitionsBroken()
+	expected := map[int32]int64{0: 1, 1: 1, 2: 1}
+	if len(qc.brokenSequences) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, qc.brokenSequences)
+	}
+	for partitionID, sequence := range expected {
+		if qc.brokenSequences[partitionID] != sequence {
+			t.Errorf("expected %v, got %v", expected, qc.brokenSequences)
+		}
+	}
+	if len(listener.lost) != 4 {
+		t.Errorf("expected the event lost listener to be notified for every partition, got %v", listener.lost)
+	}
+}
+
+func TestQueryCacheProxy_AddEntryListenerNotSupported(t *testing.T) {
+	qc := newTestQueryCache(t, true)
+	if _, err := qc.AddEntryListener("listener", true); err == nil {
+		t.Error("expected an error for an unsupported listener")
+	}
+}
@@ -0,0 +1,343 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package querycache contains the local storage of the continuous query caches.
+package querycache
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/predicate"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+// Entry is an entry of a query cache.
+// Entries are never modified once they are stored, an update replaces the entry.
+type Entry struct {
+	KeyData *serialization.Data
+	Key     interface{}
+	Value   interface{}
+}
+
+// Store keeps the entries of a query cache together with the indexes added on them.
+// Store is safe for concurrent use.
+type Store struct {
+	mu      sync.RWMutex // guards entries and indexes
+	entries map[string]*Entry
+	indexes map[string]*index
+}
+
+// New returns an empty Store.
+func New() *Store {
+	return &Store{
+		entries: make(map[string]*Entry),
+		indexes: make(map[string]*index),
+	}
+}
+
+// Put stores the given entry and returns the entry it replaced, or nil if there was none.
+func (s *Store) Put(keyData *serialization.Data, key interface{}, value interface{}) (old *Entry) {
+	entry := &Entry{KeyData: keyData, Key: key, Value: value}
+	id := string(keyData.Buffer())
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old = s.entries[id]
+	s.entries[id] = entry
+	for _, index := range s.indexes {
+		index.remove(id)
+		index.add(id, entry)
+	}
+	return old
+}
+
+// Remove removes the entry with the given key and returns it, or nil if there was none.
+func (s *Store) Remove(keyData *serialization.Data) (old *Entry) {
+	id := string(keyData.Buffer())
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old, found := s.entries[id]
+	if !found {
+		return nil
+	}
+	delete(s.entries, id)
+	for _, index := range s.indexes {
+		index.remove(id)
+	}
+	return old
+}
+
+// Get returns the entry with the given key, or nil if there is none.
+func (s *Store) Get(keyData *serialization.Data) *Entry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.entries[string(keyData.Buffer())]
+}
+
+// Size returns the number of entries in the store.
+func (s *Store) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.entries)
+}
+
+// Clear removes all the entries and returns the number of removed entries.
+// The indexes are kept.
+func (s *Store) Clear() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	size := len(s.entries)
+	s.entries = make(map[string]*Entry)
+	for attribute, index := range s.indexes {
+		s.indexes[attribute] = newIndex(attribute, index.ordered)
+	}
+	return size
+}
+
+// AddIndex indexes the entries by the given attribute, see predicate.Extract for the attribute syntax.
+// An ordered index keeps the attribute values sorted, and is also used for range predicates,
+// such as GreaterLess and Between.
+// Adding an index for an attribute that is already indexed only changes whether it is ordered.
+func (s *Store) AddIndex(attribute string, ordered bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if index, found := s.indexes[attribute]; found {
+		index.setOrdered(ordered)
+		return
+	}
+	index := newIndex(attribute, ordered)
+	for id, entry := range s.entries {
+		index.add(id, entry)
+	}
+	s.indexes[attribute] = index
+}
+
+// Query returns the entries that satisfy the given predicate, or all the entries if the predicate is nil.
+// Query narrows the candidate entries with the first index that can answer a part of the predicate,
+// and evaluates the whole predicate on the candidates.
+func (s *Store) Query(pred interface{}) ([]*Entry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	candidates := s.candidates(pred)
+	result := make([]*Entry, 0, len(candidates))
+	for _, entry := range candidates {
+		matched, err := predicate.Evaluate(pred, entry.Key, entry.Value)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			result = append(result, entry)
+		}
+	}
+	return result, nil
+}
+
+func (s *Store) candidates(pred interface{}) map[string]*Entry {
+	for _, query := range predicate.IndexQueries(pred) {
+		index, found := s.indexes[query.Attribute]
+		if !found || (query.Range && !index.ordered) {
+			continue
+		}
+		candidates := make(map[string]*Entry)
+		for id := range index.lookup(query) {
+			candidates[id] = s.entries[id]
+		}
+		return candidates
+	}
+	return s.entries
+}
+
+// index maps the values of an attribute to the IDs of the entries having them.
+// The values that cannot be used as map keys, including nil, are kept aside and are
+// returned by every lookup, so that the predicate can decide on them.
+// An ordered index also keeps its distinct values sorted by compareValues, so that a range lookup
+// visits only the values within the range.
+type index struct {
+	attribute string
+	ordered   bool
+	values    map[interface{}]map[string]struct{}
+	sorted    []interface{}
+	unindexed map[string]struct{}
+	entries   map[string]interface{}
+}
+
+func newIndex(attribute string, ordered bool) *index {
+	return &index{
+		attribute: attribute,
+		ordered:   ordered,
+		values:    make(map[interface{}]map[string]struct{}),
+		unindexed: make(map[string]struct{}),
+		entries:   make(map[string]interface{}),
+	}
+}
+
+func (i *index) setOrdered(ordered bool) {
+	if ordered == i.ordered {
+		return
+	}
+	i.ordered = ordered
+	i.sorted = nil
+	if !ordered {
+		return
+	}
+	i.sorted = make([]interface{}, 0, len(i.values))
+	for value := range i.values {
+		i.sorted = append(i.sorted, value)
+	}
+	sort.Slice(i.sorted, func(a, b int) bool {
+		return compareValues(i.sorted[a], i.sorted[b]) < 0
+	})
+}
+
+func (i *index) add(id string, entry *Entry) {
+	value := predicate.Normalize(predicate.Extract(i.attribute, entry.Key, entry.Value))
+	i.entries[id] = value
+	if !isIndexable(value) {
+		i.unindexed[id] = struct{}{}
+		return
+	}
+	ids, found := i.values[value]
+	if !found {
+		ids = make(map[string]struct{})
+		i.values[value] = ids
+		if i.ordered {
+			position := sort.Search(len(i.sorted), func(k int) bool {
+				return compareValues(i.sorted[k], value) >= 0
+			})
+			i.sorted = append(i.sorted, nil)
+			copy(i.sorted[position+1:], i.sorted[position:])
+			i.sorted[position] = value
+		}
+	}
+	ids[id] = struct{}{}
+}
+
+func (i *index) remove(id string) {
+	value, found := i.entries[id]
+	if !found {
+		return
+	}
+	delete(i.entries, id)
+	if !isIndexable(value) {
+		delete(i.unindexed, id)
+		return
+	}
+	ids := i.values[value]
+	delete(ids, id)
+	if len(ids) > 0 {
+		return
+	}
+	delete(i.values, value)
+	if i.ordered {
+		// Distinct values may compare equal, such as int64(1) and float64(1), so the value is looked up
+		// among the ones that compare equal to it.
+		position := sort.Search(len(i.sorted), func(k int) bool {
+			return compareValues(i.sorted[k], value) >= 0
+		})
+		for ; position < len(i.sorted); position++ {
+			if i.sorted[position] == value {
+				i.sorted = append(i.sorted[:position], i.sorted[position+1:]...)
+				return
+			}
+		}
+	}
+}
+
+func (i *index) lookup(query *predicate.IndexQuery) map[string]struct{} {
+	result := make(map[string]struct{})
+	for id := range i.unindexed {
+		result[id] = struct{}{}
+	}
+	if !query.Range {
+		for _, value := range query.Values {
+			value = predicate.Normalize(value)
+			if !isIndexable(value) {
+				continue
+			}
+			for id := range i.values[value] {
+				result[id] = struct{}{}
+			}
+		}
+		return result
+	}
+	for _, value := range i.rangeValues(query) {
+		if query.Matches(value) {
+			for id := range i.values[value] {
+				result[id] = struct{}{}
+			}
+		}
+	}
+	return result
+}
+
+// rangeValues returns the values of the index that may lie in the range of the given query.
+// Values of different kinds are not comparable, so the values of the kind of the bounds are returned.
+func (i *index) rangeValues(query *predicate.IndexQuery) []interface{} {
+	from, to := predicate.Normalize(query.From), predicate.Normalize(query.To)
+	bound := from
+	if bound == nil {
+		bound = to
+	}
+	kind := valueKind(bound)
+	if !i.ordered || kind < 0 {
+		values := make([]interface{}, 0, len(i.values))
+		for value := range i.values {
+			values = append(values, value)
+		}
+		return values
+	}
+	begin := sort.Search(len(i.sorted), func(k int) bool {
+		if from == nil {
+			return valueKind(i.sorted[k]) >= kind
+		}
+		return compareValues(i.sorted[k], from) >= 0
+	})
+	end := begin
+	for end < len(i.sorted) && valueKind(i.sorted[end]) == kind && (to == nil || compareValues(i.sorted[end], to) <= 0) {
+		end++
+	}
+	return i.sorted[begin:end]
+}
+
+// valueKind ranks the kinds of the indexable values, numbers are comparable with each other.
+// It returns -1 if the value is not indexable.
+func valueKind(value interface{}) int {
+	switch value.(type) {
+	case int64, float64:
+		return 0
+	case string:
+		return 1
+	case bool:
+		return 2
+	}
+	return -1
+}
+
+// compareValues orders the indexable values by their kinds first, and then by predicate.Compare.
+func compareValues(a interface{}, b interface{}) int {
+	if kindA, kindB := valueKind(a), valueKind(b); kindA != kindB {
+		return kindA - kindB
+	}
+	result, _ := predicate.Compare(a, b)
+	return result
+}
+
+// isIndexable reports whether the given normalized value is compared by == in predicate.Equals.
+func isIndexable(value interface{}) bool {
+	switch value.(type) {
+	case int64, float64, string, bool:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycache
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client/config"
+	"github.com/hazelcast/hazelcast-go-client/internal/predicate"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+type person struct {
+	Name string
+	Age  int32
+}
+
+func newTestStore(t *testing.T, people map[string]*person) (*Store, *serialization.Service) {
+	service, err := serialization.NewSerializationService(config.NewSerializationConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := New()
+	for key, value := range people {
+		store.Put(toData(t, service, key), key, value)
+	}
+	return store, service
+}
+
+func toData(t *testing.T, service *serialization.Service, object interface{}) *serialization.Data {
+	data, err := service.ToData(object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func queryKeys(t *testing.T, store *Store, pred interface{}) []string {
+	entries, err := store.Query(pred)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := make([]string, len(entries))
+	for i, entry := range entries {
+		keys[i] = entry.Key.(string)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func assertKeys(t *testing.T, actual []string, expected ...string) {
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %v got %v", expected, actual)
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Fatalf("expected %v got %v", expected, actual)
+		}
+	}
+}
+
+func TestStore_PutGetRemove(t *testing.T) {
+	store, service := newTestStore(t, nil)
+	keyData := toData(t, service, "a")
+	if old := store.Put(keyData, "a", &person{"A", 1}); old != nil {
+		t.Errorf("expected no old entry, got %v", old)
+	}
+	if old := store.Put(keyData, "a", &person{"A", 2}); old == nil || old.Value.(*person).Age != 1 {
+		t.Errorf("expected the replaced entry, got %v", old)
+	}
+	if entry := store.Get(keyData); entry == nil || entry.Value.(*person).Age != 2 {
+		t.Errorf("expected the stored entry, got %v", entry)
+	}
+	if size := store.Size(); size != 1 {
+		t.Errorf("expected size 1 got %d", size)
+	}
+	if old := store.Remove(keyData); old == nil {
+		t.Error("expected the removed entry")
+	}
+	if entry := store.Get(keyData); entry != nil {
+		t.Errorf("expected no entry, got %v", entry)
+	}
+}
+
+func TestStore_Query(t *testing.T) {
+	store, _ := newTestStore(t, map[string]*person{
+		"a": {"Ann", 20}, "b": {"Bob", 30}, "c": {"Cid", 40},
+	})
+	assertKeys(t, queryKeys(t, store, nil), "a", "b", "c")
+	assertKeys(t, queryKeys(t, store, predicate.NewGreaterLess("Age", 30, true, false)), "b", "c")
+	assertKeys(t, queryKeys(t, store, predicate.NewLike("Name", "%o%")), "b")
+}
+
+func TestStore_QueryWithIndex(t *testing.T) {
+	store, service := newTestStore(t, map[string]*person{
+		"a": {"Ann", 20}, "b": {"Bob", 30}, "c": {"Cid", 40},
+	})
+	store.AddIndex("Name", false)
+	store.AddIndex("Age", true)
+	assertKeys(t, queryKeys(t, store, predicate.NewEqual("Name", "Bob")), "b")
+	assertKeys(t, queryKeys(t, store, predicate.NewIn("Name", []interface{}{"Ann", "Cid", "Dan"})), "a", "c")
+	assertKeys(t, queryKeys(t, store, predicate.NewBetween("Age", 25, 40)), "b", "c")
+	assertKeys(t, queryKeys(t, store, predicate.NewAnd([]interface{}{
+		predicate.NewGreaterLess("Age", 30, false, true), predicate.NewEqual("Name", "Ann")})), "a")
+
+	store.Put(toData(t, service, "b"), "b", &person{"Ben", 50})
+	assertKeys(t, queryKeys(t, store, predicate.NewEqual("Name", "Bob")))
+	assertKeys(t, queryKeys(t, store, predicate.NewGreaterLess("Age", 45, false, false)), "b")
+
+	store.Remove(toData(t, service, "c"))
+	assertKeys(t, queryKeys(t, store, predicate.NewEqual("Name", "Cid")))
+
+	store.Clear()
+	assertKeys(t, queryKeys(t, store, predicate.NewEqual("Name", "Ann")))
+	store.Put(toData(t, service, "d"), "d", &person{"Ann", 60})
+	assertKeys(t, queryKeys(t, store, predicate.NewEqual("Name", "Ann")), "d")
+}
+
+func TestStore_QueryWithIndexOnMissingAttribute(t *testing.T) {
+	store, service := newTestStore(t, map[string]*person{"a": {"Ann", 20}})
+	store.Put(toData(t, service, "b"), "b", "not a person")
+	store.AddIndex("Name", false)
+	assertKeys(t, queryKeys(t, store, predicate.NewEqual("Name", "Ann")), "a")
+	assertKeys(t, queryKeys(t, store, predicate.NewEqual("this", "not a person")), "b")
+}
+
+func TestStore_OrderedIndex(t *testing.T) {
+	store, service := newTestStore(t, map[string]*person{
+		"a": {"Ann", 20}, "b": {"Bob", 30}, "c": {"Cid", 40}, "d": {"Dan", 30},
+	})
+	store.Put(toData(t, service, "e"), "e", map[string]interface{}{"Age": 35.5, "Name": true})
+	store.AddIndex("Age", true)
+	store.AddIndex("Name", false)
+	store.AddIndex("Name", true)
+	index := store.indexes["Age"]
+	if len(index.sorted) != 4 || index.sorted[0] != int64(20) || index.sorted[2] != 35.5 {
+		t.Errorf("expected the distinct values to be sorted, got %v", index.sorted)
+	}
+	values := index.rangeValues(&predicate.IndexQuery{Range: true, From: 25, To: 36})
+	if len(values) != 2 || values[0] != int64(30) || values[1] != 35.5 {
+		t.Errorf("expected the values within the range, got %v", values)
+	}
+	assertKeys(t, queryKeys(t, store, predicate.NewBetween("Age", 30, 35.5)), "b", "d", "e")
+	assertKeys(t, queryKeys(t, store, predicate.NewGreaterLess("Age", 35.5, false, false)), "c")
+	assertKeys(t, queryKeys(t, store, predicate.NewGreaterLess("Name", "Bob", false, true)), "a")
+	assertKeys(t, queryKeys(t, store, predicate.NewGreaterLess("Name", "Bob", true, false)), "b", "c", "d")
+
+	store.Remove(toData(t, service, "b"))
+	store.Put(toData(t, service, "d"), "d", &person{"Dan", 45})
+	if len(index.sorted) != 4 || index.sorted[1] != 35.5 || index.sorted[3] != int64(45) {
+		t.Errorf("expected the removed values to leave the index, got %v", index.sorted)
+	}
+	assertKeys(t, queryKeys(t, store, predicate.NewGreaterLess("Age", 30, true, false)), "c", "d", "e")
+	store.AddIndex("Age", false)
+	if index.sorted != nil {
+		t.Errorf("expected an unordered index to drop its sorted values, got %v", index.sorted)
+	}
+	assertKeys(t, queryKeys(t, store, predicate.NewGreaterLess("Age", 30, true, false)), "c", "d", "e")
+}
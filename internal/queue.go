@@ -15,6 +15,7 @@
 package internal
 
 import (
+	"context"
 	"time"
 
 	"github.com/hazelcast/hazelcast-go-client/core"
@@ -239,3 +240,7 @@ func (qp *queueProxy) createEventHandler(listener interface{}) func(clientMessag
 		})
 	}
 }
+
+func (qp *queueProxy) WithContext(ctx context.Context) core.Queue {
+	return &queueProxy{qp.partitionSpecificProxy.withContext(ctx)}
+}
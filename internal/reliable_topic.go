@@ -0,0 +1,324 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/config"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/iputil"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/internal/reliabletopic"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/internal/timeutil"
+)
+
+const (
+	// reliableTopicRingbufferPrefix is the prefix of the name of the ringbuffer that backs a reliable topic.
+	reliableTopicRingbufferPrefix = "_hz_rb_"
+
+	reliableTopicInitialBackoff = 100 * time.Millisecond
+	reliableTopicMaxBackoff     = 2 * time.Second
+)
+
+type reliableTopicProxy struct {
+	*proxy
+	ringbuffer core.Ringbuffer
+	config     *config.ReliableTopicConfig
+	runners    *reliableMessageRunners
+}
+
+// reliableMessageRunners is shared by the context bound views of a reliable topic.
+type reliableMessageRunners struct {
+	mu      sync.Mutex
+	runners map[string]*reliableMessageRunner
+}
+
+func newReliableTopicProxy(client *HazelcastClient, serviceName string, name string) (*reliableTopicProxy, error) {
+	ringbuffer, err := client.GetDistributedObject(bufutil.ServiceNameRingbufferService, reliableTopicRingbufferPrefix+name)
+	if err != nil {
+		return nil, err
+	}
+	return &reliableTopicProxy{
+		proxy:      newProxy(client, serviceName, name),
+		ringbuffer: ringbuffer.(core.Ringbuffer),
+		config:     client.ClientConfig.GetReliableTopicConfig(name),
+		runners:    &reliableMessageRunners{runners: make(map[string]*reliableMessageRunner)},
+	}, nil
+}
+
+func (rtp *reliableTopicProxy) AddMessageListener(messageListener core.MessageListener) (registrationID string, err error) {
+	if messageListener == nil {
+		return "", core.NewHazelcastNilPointerError(bufutil.NilListenerIsNotAllowed, nil)
+	}
+	listener, ok := messageListener.(core.ReliableMessageListener)
+	if !ok {
+		listener = &reliableMessageListenerAdapter{messageListener}
+	}
+	sequence := listener.RetrieveInitialSequence()
+	if sequence == -1 {
+		tailSequence, err := rtp.ringbuffer.TailSequence()
+		if err != nil {
+			return "", err
+		}
+		sequence = tailSequence + 1
+	}
+	registrationID, err = iputil.NewUUID()
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	runner := &reliableMessageRunner{
+		topic:          rtp,
+		registrationID: registrationID,
+		listener:       listener,
+		sequence:       sequence,
+		ringbuffer:     rtp.ringbuffer.WithContext(ctx),
+		ctx:            ctx,
+		cancel:         cancel,
+	}
+	rtp.runners.mu.Lock()
+	rtp.runners.runners[registrationID] = runner
+	rtp.runners.mu.Unlock()
+	go runner.run()
+	return registrationID, nil
+}
+
+func (rtp *reliableTopicProxy) RemoveMessageListener(registrationID string) (removed bool, err error) {
+	return rtp.removeRunner(registrationID), nil
+}
+
+func (rtp *reliableTopicProxy) Publish(message interface{}) (err error) {
+	messageData, err := rtp.validateAndSerialize(message)
+	if err != nil {
+		return err
+	}
+	item := reliabletopic.NewMessage(messageData, timeutil.ConvertUnixTimeToMillis(time.Now()))
+	switch rtp.config.TopicOverloadPolicy() {
+	case config.TopicOverloadPolicyDiscardOldest:
+		_, err = rtp.ringbuffer.Add(item, core.OverflowPolicyOverwrite)
+		return err
+	case config.TopicOverloadPolicyDiscardNewest:
+		_, err = rtp.ringbuffer.Add(item, core.OverflowPolicyFail)
+		return err
+	case config.TopicOverloadPolicyError:
+		sequence, err := rtp.ringbuffer.Add(item, core.OverflowPolicyFail)
+		if err == nil && sequence == -1 {
+			err = core.NewHazelcastTopicOverloadError("failed to publish message to topic "+rtp.name+
+				", the ringbuffer of the topic is full", nil)
+		}
+		return err
+	default:
+		return rtp.addWithBackoff(item)
+	}
+}
+
+// addWithBackoff retries to add the item to the ringbuffer until it has room for the item.
+func (rtp *reliableTopicProxy) addWithBackoff(item *reliabletopic.Message) error {
+	ctx := rtp.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	backoff := reliableTopicInitialBackoff
+	for {
+		sequence, err := rtp.ringbuffer.Add(item, core.OverflowPolicyFail)
+		if err != nil || sequence != -1 {
+			return err
+		}
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return core.NewHazelcastCancellationError("publishing message to topic "+rtp.name+" is cancelled", ctx.Err())
+		}
+		backoff *= 2
+		if backoff > reliableTopicMaxBackoff {
+			backoff = reliableTopicMaxBackoff
+		}
+	}
+}
+
+func (rtp *reliableTopicProxy) Destroy() (bool, error) {
+	rtp.runners.mu.Lock()
+	for registrationID, runner := range rtp.runners.runners {
+		runner.cancel()
+		delete(rtp.runners.runners, registrationID)
+	}
+	rtp.runners.mu.Unlock()
+	if _, err := rtp.ringbuffer.Destroy(); err != nil {
+		return false, err
+	}
+	return rtp.proxy.Destroy()
+}
+
+func (rtp *reliableTopicProxy) WithContext(ctx context.Context) core.Topic {
+	return &reliableTopicProxy{
+		proxy:      rtp.proxy.withContext(ctx),
+		ringbuffer: rtp.ringbuffer.WithContext(ctx),
+		config:     rtp.config,
+		runners:    rtp.runners,
+	}
+}
+
+func (rtp *reliableTopicProxy) removeRunner(registrationID string) bool {
+	rtp.runners.mu.Lock()
+	defer rtp.runners.mu.Unlock()
+	runner, found := rtp.runners.runners[registrationID]
+	if !found {
+		return false
+	}
+	runner.cancel()
+	delete(rtp.runners.runners, registrationID)
+	return true
+}
+
+// reliableMessageRunner reads the messages of a listener from the ringbuffer of the topic.
+type reliableMessageRunner struct {
+	topic          *reliableTopicProxy
+	registrationID string
+	listener       core.ReliableMessageListener
+	// sequence is the sequence of the next message to read.
+	sequence   int64
+	ringbuffer core.Ringbuffer
+	ctx        context.Context
+	cancel     context.CancelFunc
+}
+
+func (r *reliableMessageRunner) run() {
+	batchSize := r.topic.config.ReadBatchSize()
+	for r.ctx.Err() == nil {
+		result, err := r.ringbuffer.ReadMany(r.sequence, 1, batchSize, nil)
+		if err != nil {
+			if !r.handleError(err) {
+				r.topic.removeRunner(r.registrationID)
+				return
+			}
+			continue
+		}
+		for i := int32(0); i < result.Size() && r.ctx.Err() == nil; i++ {
+			sequence, _ := result.Sequence(i)
+			item, err := result.Get(i)
+			if err != nil {
+				r.topic.client.logger.Warn("Terminating the message listener ", r.registrationID, " of topic ",
+					r.topic.name, ", the message at sequence ", sequence, " cannot be read: ", err)
+				r.topic.removeRunner(r.registrationID)
+				return
+			}
+			message, err := r.toTopicMessage(item)
+			if err != nil {
+				r.topic.client.logger.Warn("Terminating the message listener ", r.registrationID, " of topic ",
+					r.topic.name, ", the message at sequence ", sequence, " cannot be deserialized: ", err)
+				r.topic.removeRunner(r.registrationID)
+				return
+			}
+			r.listener.StoreSequence(sequence)
+			if !r.onMessage(message) {
+				r.topic.removeRunner(r.registrationID)
+				return
+			}
+			r.sequence = sequence + 1
+		}
+	}
+}
+
+// onMessage passes the message to the listener. It returns false if the listener panicked.
+func (r *reliableMessageRunner) onMessage(message core.Message) (ok bool) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			r.topic.client.logger.Error("Terminating the message listener ", r.registrationID, " of topic ",
+				r.topic.name, ", it panicked: ", recovered)
+			ok = false
+		}
+	}()
+	r.listener.OnMessage(message)
+	return true
+}
+
+// handleError returns true if the runner should continue after the given error.
+func (r *reliableMessageRunner) handleError(err error) bool {
+	if r.ctx.Err() != nil {
+		return false
+	}
+	switch err.(type) {
+	case *core.HazelcastTimeoutError:
+		return true
+	case *core.HazelcastClientNotActiveError:
+		return false
+	case *core.HazelcastTargetDisconnectedError, *core.HazelcastIOError, *core.HazelcastTargetNotMemberError:
+		// The connection will be restored, continue from the same sequence.
+		select {
+		case <-time.After(reliableTopicInitialBackoff):
+		case <-r.ctx.Done():
+		}
+		return true
+	case *core.HazelcastStaleSequenceError:
+		if !r.listener.IsLossTolerant() {
+			r.topic.client.logger.Warn("Terminating the message listener ", r.registrationID, " of topic ",
+				r.topic.name, ", the listener is not loss tolerant and it has fallen behind the ringbuffer: ", err)
+			return false
+		}
+		headSequence, headErr := r.ringbuffer.HeadSequence()
+		if headErr != nil {
+			return r.handleError(headErr)
+		}
+		r.topic.client.logger.Warn("The message listener ", r.registrationID, " of topic ", r.topic.name,
+			" has lost ", headSequence-r.sequence, " messages, it continues from sequence ", headSequence)
+		r.sequence = headSequence
+		return true
+	}
+	r.topic.client.logger.Warn("Terminating the message listener ", r.registrationID, " of topic ", r.topic.name,
+		": ", err)
+	return false
+}
+
+func (r *reliableMessageRunner) toTopicMessage(item interface{}) (*proto.TopicMessage, error) {
+	message, ok := item.(*reliabletopic.Message)
+	if !ok {
+		// The item is not published through a reliable topic, pass it as it is.
+		return proto.NewTopicMessage(item, 0, nil), nil
+	}
+	var messageObject interface{}
+	if payload, ok := message.Payload().(*serialization.Data); ok {
+		var err error
+		if messageObject, err = r.topic.toObject(payload); err != nil {
+			return nil, err
+		}
+	}
+	var publisher *proto.Member
+	if address := message.PublisherAddress(); address != nil {
+		member := r.topic.client.ClusterService.GetMember(proto.NewAddressWithParameters(address.Host(), address.Port()))
+		publisher, _ = member.(*proto.Member)
+	}
+	return proto.NewTopicMessage(messageObject, message.PublishTime(), publisher), nil
+}
+
+// reliableMessageListenerAdapter is the ReliableMessageListener of a MessageListener.
+type reliableMessageListenerAdapter struct {
+	core.MessageListener
+}
+
+func (*reliableMessageListenerAdapter) RetrieveInitialSequence() int64 {
+	return -1
+}
+
+func (*reliableMessageListenerAdapter) StoreSequence(sequence int64) {
+}
+
+func (*reliableMessageListenerAdapter) IsLossTolerant() bool {
+	return false
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client/config"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/internal/reliabletopic"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+)
+
+type panickingMessageListener struct{}
+
+func (panickingMessageListener) OnMessage(message core.Message) {
+	panic("listener failed")
+}
+
+func newTestReliableMessageRunner(t *testing.T, logger *recordingLogger) *reliableMessageRunner {
+	service, err := serialization.NewSerializationService(config.NewSerializationConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &HazelcastClient{SerializationService: service, logger: logger}
+	topic := &reliableTopicProxy{
+		proxy:   newProxy(client, bufutil.ServiceNameReliableTopic, "topic"),
+		runners: &reliableMessageRunners{runners: make(map[string]*reliableMessageRunner)},
+	}
+	return &reliableMessageRunner{
+		topic:          topic,
+		registrationID: "registration",
+		listener:       &reliableMessageListenerAdapter{panickingMessageListener{}},
+	}
+}
+
+func TestReliableMessageRunner_ListenerPanics(t *testing.T) {
+	logger := &recordingLogger{}
+	runner := newTestReliableMessageRunner(t, logger)
+	if runner.onMessage(proto.NewTopicMessage("message", 0, nil)) {
+		t.Error("expected the panic of the listener to be reported")
+	}
+	if len(logger.errors) != 1 {
+		t.Errorf("expected the panic to be logged, got %v", logger.errors)
+	}
+}
+
+func TestReliableMessageRunner_UndeserializableMessage(t *testing.T) {
+	runner := newTestReliableMessageRunner(t, &recordingLogger{})
+	// The payload has an unknown serializer type.
+	payload := serialization.NewData([]byte{0, 0, 0, 0, 0, 0, 0x30, 0x39, 1})
+	if _, err := runner.toTopicMessage(reliabletopic.NewMessage(payload, 0)); err == nil {
+		t.Error("expected the deserialization error to be returned")
+	}
+	message, err := runner.toTopicMessage("plain item")
+	if err != nil || message.MessageObject() != "plain item" {
+		t.Errorf("expected the item to be passed as it is, got %v, %v", message, err)
+	}
+}
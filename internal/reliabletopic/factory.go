@@ -0,0 +1,55 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reliabletopic
+
+import "github.com/hazelcast/hazelcast-go-client/serialization"
+
+// FactoryID is the factory ID of the messages that are stored in the ringbuffers of reliable topics.
+const FactoryID = -18
+
+// ClusterFactoryID is the factory ID of the addresses that the members write as publishers of the messages.
+const ClusterFactoryID = 0
+
+type Factory struct {
+}
+
+func NewFactory() *Factory {
+	return &Factory{}
+}
+
+func (*Factory) Create(id int32) serialization.IdentifiedDataSerializable {
+	switch id {
+	case messageClassID:
+		return &Message{}
+	default:
+		return nil
+	}
+}
+
+type ClusterFactory struct {
+}
+
+func NewClusterFactory() *ClusterFactory {
+	return &ClusterFactory{}
+}
+
+func (*ClusterFactory) Create(id int32) serialization.IdentifiedDataSerializable {
+	switch id {
+	case addressClassID:
+		return &Address{}
+	default:
+		return nil
+	}
+}
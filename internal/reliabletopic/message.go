@@ -0,0 +1,130 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reliabletopic
+
+import "github.com/hazelcast/hazelcast-go-client/serialization"
+
+const (
+	messageClassID = 2
+	addressClassID = 1
+)
+
+// Message is the item that is stored in the ringbuffer of a reliable topic for a published message.
+type Message struct {
+	publishTime      int64
+	publisherAddress *Address
+	payload          serialization.Data
+}
+
+// NewMessage returns a message with the given payload, published at the given time in milliseconds.
+// Messages published by clients do not have a publisher address.
+func NewMessage(payload serialization.Data, publishTime int64) *Message {
+	return &Message{publishTime: publishTime, payload: payload}
+}
+
+// PublishTime returns the time in milliseconds when the message is published.
+func (m *Message) PublishTime() int64 {
+	return m.publishTime
+}
+
+// PublisherAddress returns the address of the member that published the message,
+// or nil if the message is published by a client.
+func (m *Message) PublisherAddress() *Address {
+	return m.publisherAddress
+}
+
+// Payload returns the serialized message object.
+func (m *Message) Payload() serialization.Data {
+	return m.payload
+}
+
+func (*Message) FactoryID() (factoryID int32) {
+	return FactoryID
+}
+
+func (*Message) ClassID() (classID int32) {
+	return messageClassID
+}
+
+func (m *Message) WriteData(output serialization.DataOutput) (err error) {
+	output.WriteInt64(m.publishTime)
+	if m.publisherAddress != nil {
+		err = output.WriteObject(m.publisherAddress)
+	} else {
+		err = output.WriteObject(nil)
+	}
+	if err != nil {
+		return
+	}
+	output.WriteData(m.payload)
+	return
+}
+
+func (m *Message) ReadData(input serialization.DataInput) (err error) {
+	if m.publishTime, err = input.ReadInt64(); err != nil {
+		return
+	}
+	publisherAddress, err := input.ReadObject()
+	if err != nil {
+		return
+	}
+	m.publisherAddress, _ = publisherAddress.(*Address)
+	m.payload, err = input.ReadData()
+	return
+}
+
+// Address is the address of a member in the form that the members serialize it.
+type Address struct {
+	port        int32
+	addressType byte
+	host        string
+}
+
+// Host returns the host of the address.
+func (a *Address) Host() string {
+	return a.host
+}
+
+// Port returns the port of the address.
+func (a *Address) Port() int32 {
+	return a.port
+}
+
+func (*Address) FactoryID() (factoryID int32) {
+	return ClusterFactoryID
+}
+
+func (*Address) ClassID() (classID int32) {
+	return addressClassID
+}
+
+func (a *Address) WriteData(output serialization.DataOutput) (err error) {
+	output.WriteInt32(a.port)
+	output.WriteByte(a.addressType)
+	output.WriteByteArray([]byte(a.host))
+	return
+}
+
+func (a *Address) ReadData(input serialization.DataInput) (err error) {
+	if a.port, err = input.ReadInt32(); err != nil {
+		return
+	}
+	if a.addressType, err = input.ReadByte(); err != nil {
+		return
+	}
+	host, err := input.ReadByteArray()
+	a.host = string(host)
+	return
+}
@@ -15,6 +15,7 @@
 package internal
 
 import (
+	"context"
 	"math/rand"
 	"time"
 
@@ -33,7 +34,7 @@ type replicatedMapProxy struct {
 func newReplicatedMapProxy(client *HazelcastClient, serviceName string, name string) (*replicatedMapProxy, error) {
 	partitionCount := client.PartitionService.getPartitionCount()
 	tarGetPartitionID := rand.Int31n(partitionCount)
-	return &replicatedMapProxy{proxy: &proxy{client, serviceName, name}, tarGetPartitionID: tarGetPartitionID}, nil
+	return &replicatedMapProxy{proxy: newProxy(client, serviceName, name), tarGetPartitionID: tarGetPartitionID}, nil
 }
 
 func (rmp *replicatedMapProxy) Put(key interface{}, value interface{}) (oldValue interface{}, err error) {
@@ -291,3 +292,7 @@ func (rmp *replicatedMapProxy) createEventHandlerToKeyWithPredicate(listener int
 			})
 	}
 }
+
+func (rmp *replicatedMapProxy) WithContext(ctx context.Context) core.ReplicatedMap {
+	return &replicatedMapProxy{proxy: rmp.proxy.withContext(ctx), tarGetPartitionID: rmp.tarGetPartitionID}
+}
@@ -15,6 +15,7 @@
 package internal
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hazelcast/hazelcast-go-client/core"
@@ -200,3 +201,7 @@ func (rs *LazyReadResultSet) rangeCheck(index int32) (err error) {
 	}
 	return
 }
+
+func (rp *ringbufferProxy) WithContext(ctx context.Context) core.Ringbuffer {
+	return &ringbufferProxy{rp.partitionSpecificProxy.withContext(ctx), rp.capacity}
+}
@@ -0,0 +1,355 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/iputil"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/internal/timeutil"
+)
+
+const (
+	scheduledTaskTypeSingleRun   uint8 = 0
+	scheduledTaskTypeAtFixedRate uint8 = 1
+)
+
+const (
+	scheduledTaskHandlerURNBase   = "urn:hzScheduledTaskHandler:"
+	scheduledTaskHandlerSeparator = "\x00"
+	scheduledTaskHandlerNoAddress = "-"
+)
+
+type scheduledExecutorServiceProxy struct {
+	*proxy
+}
+
+func newScheduledExecutorServiceProxy(client *HazelcastClient, serviceName string,
+	name string) (*scheduledExecutorServiceProxy, error) {
+	return &scheduledExecutorServiceProxy{newProxy(client, serviceName, name)}, nil
+}
+
+func (sesp *scheduledExecutorServiceProxy) Schedule(task interface{}, delay time.Duration) (core.ScheduledFuture, error) {
+	return sesp.scheduleOnPartition(task, scheduledTaskTypeSingleRun, delay, 0)
+}
+
+func (sesp *scheduledExecutorServiceProxy) ScheduleAtFixedRate(task interface{}, initialDelay time.Duration,
+	period time.Duration) (core.ScheduledFuture, error) {
+	if period <= 0 {
+		return nil, core.NewHazelcastIllegalArgumentError("period should be positive", nil)
+	}
+	return sesp.scheduleOnPartition(task, scheduledTaskTypeAtFixedRate, initialDelay, period)
+}
+
+func (sesp *scheduledExecutorServiceProxy) ScheduleOnMember(task interface{}, member core.Member,
+	delay time.Duration) (core.ScheduledFuture, error) {
+	if member == nil {
+		return nil, core.NewHazelcastNilPointerError("member cannot be nil", nil)
+	}
+	taskData, taskName, err := sesp.serializeTask(task)
+	if err != nil {
+		return nil, err
+	}
+	address := member.Address().(*proto.Address)
+	request := proto.ScheduledExecutorSubmitToAddressEncodeRequest(sesp.name, address, scheduledTaskTypeSingleRun,
+		taskName, taskData, timeutil.GetTimeInMilliSeconds(delay), 0)
+	if _, err = sesp.invokeOnAddress(request, address); err != nil {
+		return nil, err
+	}
+	return sesp.newScheduledFuture(&scheduledTaskHandler{
+		address:       address,
+		partitionID:   -1,
+		schedulerName: sesp.name,
+		taskName:      taskName,
+	}), nil
+}
+
+func (sesp *scheduledExecutorServiceProxy) ScheduleOnKeyOwner(task interface{}, key interface{},
+	delay time.Duration) (core.ScheduledFuture, error) {
+	keyData, err := sesp.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	taskData, taskName, err := sesp.serializeTask(task)
+	if err != nil {
+		return nil, err
+	}
+	partitionID := sesp.client.PartitionService.GetPartitionID(keyData)
+	request := proto.ScheduledExecutorSubmitToPartitionEncodeRequest(sesp.name, scheduledTaskTypeSingleRun, taskName,
+		taskData, timeutil.GetTimeInMilliSeconds(delay), 0)
+	if _, err = sesp.invokeOnKey(request, keyData); err != nil {
+		return nil, err
+	}
+	return sesp.newScheduledFuture(&scheduledTaskHandler{
+		partitionID:   partitionID,
+		schedulerName: sesp.name,
+		taskName:      taskName,
+	}), nil
+}
+
+func (sesp *scheduledExecutorServiceProxy) GetScheduledFuture(handlerURN string) (core.ScheduledFuture, error) {
+	handler, err := parseScheduledTaskHandler(handlerURN)
+	if err != nil {
+		return nil, err
+	}
+	return sesp.newScheduledFuture(handler), nil
+}
+
+func (sesp *scheduledExecutorServiceProxy) GetAllScheduledFutures() (map[core.Member][]core.ScheduledFuture, error) {
+	request := proto.ScheduledExecutorGetAllScheduledFuturesEncodeRequest(sesp.name)
+	responseMessage, err := sesp.invokeOnRandomTarget(request)
+	if err != nil {
+		return nil, err
+	}
+	members, handlerURNs := proto.ScheduledExecutorGetAllScheduledFuturesDecodeResponse(responseMessage)()
+	futures := make(map[core.Member][]core.ScheduledFuture, len(members))
+	for i, member := range members {
+		memberFutures := make([]core.ScheduledFuture, 0, len(handlerURNs[i]))
+		for _, urn := range handlerURNs[i] {
+			future, err := sesp.GetScheduledFuture(urn)
+			if err != nil {
+				return nil, err
+			}
+			memberFutures = append(memberFutures, future)
+		}
+		futures[member] = memberFutures
+	}
+	return futures, nil
+}
+
+func (sesp *scheduledExecutorServiceProxy) Shutdown() (err error) {
+	for _, member := range sesp.client.ClusterService.GetMembers() {
+		address := member.Address().(*proto.Address)
+		request := proto.ScheduledExecutorShutdownEncodeRequest(sesp.name, address)
+		if _, err = sesp.invokeOnAddress(request, address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (sesp *scheduledExecutorServiceProxy) scheduleOnPartition(task interface{}, taskType uint8,
+	initialDelay time.Duration, period time.Duration) (core.ScheduledFuture, error) {
+	taskData, taskName, err := sesp.serializeTask(task)
+	if err != nil {
+		return nil, err
+	}
+	partitionID, err := sesp.client.PartitionService.GetPartitionIDWithKey(taskName)
+	if err != nil {
+		return nil, err
+	}
+	request := proto.ScheduledExecutorSubmitToPartitionEncodeRequest(sesp.name, taskType, taskName, taskData,
+		timeutil.GetTimeInMilliSeconds(initialDelay), timeutil.GetTimeInMilliSeconds(period))
+	if _, err = sesp.invokeOnPartition(request, partitionID); err != nil {
+		return nil, err
+	}
+	return sesp.newScheduledFuture(&scheduledTaskHandler{
+		partitionID:   partitionID,
+		schedulerName: sesp.name,
+		taskName:      taskName,
+	}), nil
+}
+
+// serializeTask serializes the task and returns it together with its name.
+func (sesp *scheduledExecutorServiceProxy) serializeTask(task interface{}) (*serialization.Data, string, error) {
+	taskData, err := sesp.validateAndSerialize(task)
+	if err != nil {
+		return nil, "", err
+	}
+	if namedTask, ok := task.(core.NamedTask); ok {
+		return taskData, namedTask.TaskName(), nil
+	}
+	taskName, err := iputil.NewUUID()
+	return taskData, taskName, err
+}
+
+// scheduledTaskHandler identifies a scheduled task. A task is bound either to a partition,
+// or to a member when address is not nil.
+type scheduledTaskHandler struct {
+	address       *proto.Address
+	partitionID   int32
+	schedulerName string
+	taskName      string
+}
+
+// urn returns the URN of the handler in the same format as the other Hazelcast clients and the members.
+func (h *scheduledTaskHandler) urn() string {
+	address := scheduledTaskHandlerNoAddress
+	if h.address != nil {
+		address = fmt.Sprintf("%s:%d", h.address.Host(), h.address.Port())
+	}
+	return scheduledTaskHandlerURNBase + strings.Join([]string{address, strconv.Itoa(int(h.partitionID)),
+		h.schedulerName, h.taskName}, scheduledTaskHandlerSeparator)
+}
+
+func parseScheduledTaskHandler(urn string) (*scheduledTaskHandler, error) {
+	if !strings.HasPrefix(urn, scheduledTaskHandlerURNBase) {
+		return nil, core.NewHazelcastIllegalArgumentError("wrong scheduled task handler URN: "+urn, nil)
+	}
+	parts := strings.Split(strings.TrimPrefix(urn, scheduledTaskHandlerURNBase), scheduledTaskHandlerSeparator)
+	if len(parts) != 4 {
+		return nil, core.NewHazelcastIllegalArgumentError("wrong scheduled task handler URN: "+urn, nil)
+	}
+	partitionID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, core.NewHazelcastIllegalArgumentError("wrong partition ID in scheduled task handler URN: "+urn, err)
+	}
+	handler := &scheduledTaskHandler{partitionID: int32(partitionID), schedulerName: parts[2], taskName: parts[3]}
+	if parts[0] != scheduledTaskHandlerNoAddress {
+		separator := strings.LastIndex(parts[0], ":")
+		if separator < 0 {
+			return nil, core.NewHazelcastIllegalArgumentError("wrong address in scheduled task handler URN: "+urn, nil)
+		}
+		port, err := strconv.Atoi(parts[0][separator+1:])
+		if err != nil {
+			return nil, core.NewHazelcastIllegalArgumentError("wrong address in scheduled task handler URN: "+urn, err)
+		}
+		handler.address = proto.NewAddressWithParameters(parts[0][:separator], int32(port))
+	}
+	return handler, nil
+}
+
+// scheduledFuture is a core.ScheduledFuture. Its requests are sent to the member of the task if the task
+// is bound to a member, to the owner of the partition of the task otherwise.
+type scheduledFuture struct {
+	executor *scheduledExecutorServiceProxy
+	handler  *scheduledTaskHandler
+}
+
+func (sesp *scheduledExecutorServiceProxy) newScheduledFuture(handler *scheduledTaskHandler) *scheduledFuture {
+	return &scheduledFuture{executor: sesp, handler: handler}
+}
+
+func (sf *scheduledFuture) HandlerURN() string {
+	return sf.handler.urn()
+}
+
+func (sf *scheduledFuture) Get() (value interface{}, err error) {
+	h := sf.handler
+	if h.address != nil {
+		request := proto.ScheduledExecutorGetResultFromAddressEncodeRequest(h.schedulerName, h.taskName, h.address)
+		responseMessage, err := sf.invoke(request)
+		return sf.executor.decodeToObjectAndError(responseMessage, err, proto.ScheduledExecutorGetResultFromAddressDecodeResponse)
+	}
+	request := proto.ScheduledExecutorGetResultFromPartitionEncodeRequest(h.schedulerName, h.taskName)
+	responseMessage, err := sf.invoke(request)
+	return sf.executor.decodeToObjectAndError(responseMessage, err, proto.ScheduledExecutorGetResultFromPartitionDecodeResponse)
+}
+
+func (sf *scheduledFuture) GetDelay() (delay time.Duration, err error) {
+	h := sf.handler
+	var delayInNanos int64
+	if h.address != nil {
+		request := proto.ScheduledExecutorGetDelayFromAddressEncodeRequest(h.schedulerName, h.taskName, h.address)
+		responseMessage, err := sf.invoke(request)
+		delayInNanos, err = sf.executor.decodeToInt64AndError(responseMessage, err,
+			proto.ScheduledExecutorGetDelayFromAddressDecodeResponse)
+	} else {
+		request := proto.ScheduledExecutorGetDelayFromPartitionEncodeRequest(h.schedulerName, h.taskName)
+		responseMessage, err := sf.invoke(request)
+		delayInNanos, err = sf.executor.decodeToInt64AndError(responseMessage, err,
+			proto.ScheduledExecutorGetDelayFromPartitionDecodeResponse)
+	}
+	return time.Duration(delayInNanos), err
+}
+
+func (sf *scheduledFuture) Cancel(mayInterrupt bool) (cancelled bool, err error) {
+	h := sf.handler
+	if h.address != nil {
+		request := proto.ScheduledExecutorCancelFromAddressEncodeRequest(h.schedulerName, h.taskName, h.address,
+			mayInterrupt)
+		responseMessage, err := sf.invoke(request)
+		return sf.executor.decodeToBoolAndError(responseMessage, err, proto.ScheduledExecutorCancelFromAddressDecodeResponse)
+	}
+	request := proto.ScheduledExecutorCancelFromPartitionEncodeRequest(h.schedulerName, h.taskName, mayInterrupt)
+	responseMessage, err := sf.invoke(request)
+	return sf.executor.decodeToBoolAndError(responseMessage, err, proto.ScheduledExecutorCancelFromPartitionDecodeResponse)
+}
+
+func (sf *scheduledFuture) IsCancelled() (cancelled bool, err error) {
+	h := sf.handler
+	if h.address != nil {
+		request := proto.ScheduledExecutorIsCancelledFromAddressEncodeRequest(h.schedulerName, h.taskName, h.address)
+		responseMessage, err := sf.invoke(request)
+		return sf.executor.decodeToBoolAndError(responseMessage, err,
+			proto.ScheduledExecutorIsCancelledFromAddressDecodeResponse)
+	}
+	request := proto.ScheduledExecutorIsCancelledFromPartitionEncodeRequest(h.schedulerName, h.taskName)
+	responseMessage, err := sf.invoke(request)
+	return sf.executor.decodeToBoolAndError(responseMessage, err,
+		proto.ScheduledExecutorIsCancelledFromPartitionDecodeResponse)
+}
+
+func (sf *scheduledFuture) IsDone() (done bool, err error) {
+	h := sf.handler
+	if h.address != nil {
+		request := proto.ScheduledExecutorIsDoneFromAddressEncodeRequest(h.schedulerName, h.taskName, h.address)
+		responseMessage, err := sf.invoke(request)
+		return sf.executor.decodeToBoolAndError(responseMessage, err, proto.ScheduledExecutorIsDoneFromAddressDecodeResponse)
+	}
+	request := proto.ScheduledExecutorIsDoneFromPartitionEncodeRequest(h.schedulerName, h.taskName)
+	responseMessage, err := sf.invoke(request)
+	return sf.executor.decodeToBoolAndError(responseMessage, err, proto.ScheduledExecutorIsDoneFromPartitionDecodeResponse)
+}
+
+func (sf *scheduledFuture) Dispose() (err error) {
+	h := sf.handler
+	var request *proto.ClientMessage
+	if h.address != nil {
+		request = proto.ScheduledExecutorDisposeFromAddressEncodeRequest(h.schedulerName, h.taskName, h.address)
+	} else {
+		request = proto.ScheduledExecutorDisposeFromPartitionEncodeRequest(h.schedulerName, h.taskName)
+	}
+	_, err = sf.invoke(request)
+	return
+}
+
+func (sf *scheduledFuture) GetStats() (stats core.ScheduledTaskStatistics, err error) {
+	h := sf.handler
+	var responseMessage *proto.ClientMessage
+	if h.address != nil {
+		request := proto.ScheduledExecutorGetStatsFromAddressEncodeRequest(h.schedulerName, h.taskName, h.address)
+		responseMessage, err = sf.invoke(request)
+	} else {
+		request := proto.ScheduledExecutorGetStatsFromPartitionEncodeRequest(h.schedulerName, h.taskName)
+		responseMessage, err = sf.invoke(request)
+	}
+	if err != nil {
+		return stats, err
+	}
+	// The responses of both requests have the same layout.
+	lastIdleTime, totalIdleTime, totalRuns, totalRunTime, lastRunDuration :=
+		proto.ScheduledExecutorGetStatsFromPartitionDecodeResponse(responseMessage)()
+	return core.ScheduledTaskStatistics{
+		TotalRuns:       totalRuns,
+		LastRunDuration: time.Duration(lastRunDuration),
+		LastIdleTime:    time.Duration(lastIdleTime),
+		TotalRunTime:    time.Duration(totalRunTime),
+		TotalIdleTime:   time.Duration(totalIdleTime),
+	}, nil
+}
+
+func (sf *scheduledFuture) invoke(request *proto.ClientMessage) (*proto.ClientMessage, error) {
+	if sf.handler.address != nil {
+		return sf.executor.invokeOnAddress(request, sf.handler.address)
+	}
+	return sf.executor.invokeOnPartition(request, sf.handler.partitionID)
+}
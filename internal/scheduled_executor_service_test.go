@@ -0,0 +1,70 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+func TestScheduledTaskHandlerURNWithPartition(t *testing.T) {
+	handler := &scheduledTaskHandler{partitionID: 42, schedulerName: "scheduler", taskName: "task"}
+	parsed, err := parseScheduledTaskHandler(handler.urn())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.address != nil || parsed.partitionID != 42 || parsed.schedulerName != "scheduler" ||
+		parsed.taskName != "task" {
+		t.Fatalf("unexpected handler %+v", parsed)
+	}
+}
+
+func TestScheduledTaskHandlerURNWithAddress(t *testing.T) {
+	handler := &scheduledTaskHandler{
+		address:       proto.NewAddressWithParameters("127.0.0.1", 5701),
+		partitionID:   -1,
+		schedulerName: "scheduler",
+		taskName:      "task",
+	}
+	parsed, err := parseScheduledTaskHandler(handler.urn())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.address == nil || parsed.address.Host() != "127.0.0.1" || parsed.address.Port() != 5701 {
+		t.Fatalf("unexpected address %v", parsed.address)
+	}
+	if parsed.partitionID != -1 || parsed.urn() != handler.urn() {
+		t.Fatalf("unexpected handler %+v", parsed)
+	}
+}
+
+func TestParseScheduledTaskHandlerInvalid(t *testing.T) {
+	for _, urn := range []string{
+		"",
+		"task",
+		scheduledTaskHandlerURNBase + "-\x001\x00scheduler",
+		scheduledTaskHandlerURNBase + "-\x00partition\x00scheduler\x00task",
+		scheduledTaskHandlerURNBase + "localhost\x00-1\x00scheduler\x00task",
+		scheduledTaskHandlerURNBase + "localhost:port\x00-1\x00scheduler\x00task",
+	} {
+		if _, err := parseScheduledTaskHandler(urn); err == nil {
+			t.Errorf("expected an error for %q", urn)
+		} else if _, ok := err.(*core.HazelcastIllegalArgumentError); !ok {
+			t.Errorf("expected HazelcastIllegalArgumentError for %q, got %v", urn, err)
+		}
+	}
+}
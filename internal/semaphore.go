@@ -0,0 +1,105 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/timeutil"
+)
+
+type semaphoreProxy struct {
+	*partitionSpecificProxy
+}
+
+func newSemaphoreProxy(client *HazelcastClient, serviceName string, name string) (*semaphoreProxy, error) {
+	parSpecProxy, err := newPartitionSpecificProxy(client, serviceName, name)
+	if err != nil {
+		return nil, err
+	}
+	return &semaphoreProxy{parSpecProxy}, nil
+}
+
+func (sp *semaphoreProxy) Init(permits int32) (initialized bool, err error) {
+	if err = checkPermits(permits); err != nil {
+		return false, err
+	}
+	request := proto.SemaphoreInitEncodeRequest(sp.name, permits)
+	responseMessage, err := sp.invoke(request)
+	return sp.decodeToBoolAndError(responseMessage, err, proto.SemaphoreInitDecodeResponse)
+}
+
+func (sp *semaphoreProxy) Acquire(permits int32) (err error) {
+	if err = checkPermits(permits); err != nil {
+		return err
+	}
+	request := proto.SemaphoreAcquireEncodeRequest(sp.name, permits)
+	_, err = sp.invokeBlocking(request, -1)
+	return
+}
+
+func (sp *semaphoreProxy) TryAcquire(permits int32, timeout time.Duration) (acquired bool, err error) {
+	if err = checkPermits(permits); err != nil {
+		return false, err
+	}
+	timeoutInMillis := timeutil.GetTimeInMilliSeconds(timeout)
+	request := proto.SemaphoreTryAcquireEncodeRequest(sp.name, permits, timeoutInMillis)
+	responseMessage, err := sp.invokeBlocking(request, timeout)
+	return sp.decodeToBoolAndError(responseMessage, err, proto.SemaphoreTryAcquireDecodeResponse)
+}
+
+func (sp *semaphoreProxy) Release(permits int32) (err error) {
+	if err = checkPermits(permits); err != nil {
+		return err
+	}
+	request := proto.SemaphoreReleaseEncodeRequest(sp.name, permits)
+	_, err = sp.invoke(request)
+	return
+}
+
+func (sp *semaphoreProxy) AvailablePermits() (permits int32, err error) {
+	request := proto.SemaphoreAvailablePermitsEncodeRequest(sp.name)
+	responseMessage, err := sp.invoke(request)
+	return sp.decodeToInt32AndError(responseMessage, err, proto.SemaphoreAvailablePermitsDecodeResponse)
+}
+
+func (sp *semaphoreProxy) DrainPermits() (permits int32, err error) {
+	request := proto.SemaphoreDrainPermitsEncodeRequest(sp.name)
+	responseMessage, err := sp.invoke(request)
+	return sp.decodeToInt32AndError(responseMessage, err, proto.SemaphoreDrainPermitsDecodeResponse)
+}
+
+func (sp *semaphoreProxy) ReducePermits(reduction int32) (err error) {
+	if err = checkPermits(reduction); err != nil {
+		return err
+	}
+	request := proto.SemaphoreReducePermitsEncodeRequest(sp.name, reduction)
+	_, err = sp.invoke(request)
+	return
+}
+
+func (sp *semaphoreProxy) WithContext(ctx context.Context) core.Semaphore {
+	return &semaphoreProxy{sp.partitionSpecificProxy.withContext(ctx)}
+}
+
+func checkPermits(permits int32) error {
+	if permits < 0 {
+		return core.NewHazelcastIllegalArgumentError("permits cannot be negative", nil)
+	}
+	return nil
+}
@@ -15,6 +15,8 @@
 package classdef
 
 import (
+	"sort"
+
 	"github.com/hazelcast/hazelcast-go-client/serialization"
 )
 
@@ -49,6 +51,18 @@ func (cd *ClassDefinitionImpl) FieldCount() int {
 	return len(cd.fields)
 }
 
+// FieldDefinitions returns the field definitions ordered by their indexes.
+func (cd *ClassDefinitionImpl) FieldDefinitions() []serialization.FieldDefinition {
+	fields := make([]serialization.FieldDefinition, 0, len(cd.fields))
+	for _, field := range cd.fields {
+		fields = append(fields, field)
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Index() < fields[j].Index()
+	})
+	return fields
+}
+
 func (cd *ClassDefinitionImpl) AddFieldDefinition(definition serialization.FieldDefinition) {
 	cd.fields[definition.Name()] = definition
 }
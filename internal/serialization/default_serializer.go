@@ -234,6 +234,23 @@ func (*StringSerializer) Write(output serialization.DataOutput, i interface{}) e
 	return nil
 }
 
+// JSONSerializer serializes core.JSONValue the same way as HazelcastJsonValue is serialized by the members.
+type JSONSerializer struct{}
+
+func (*JSONSerializer) ID() int32 {
+	return JSONSerializationType
+}
+
+func (*JSONSerializer) Read(input serialization.DataInput) (interface{}, error) {
+	value, err := input.ReadUTF()
+	return core.JSONValue(value), err
+}
+
+func (*JSONSerializer) Write(output serialization.DataOutput, i interface{}) error {
+	output.WriteUTF(string(i.(core.JSONValue)))
+	return nil
+}
+
 type ByteArraySerializer struct{}
 
 func (*ByteArraySerializer) ID() int32 {
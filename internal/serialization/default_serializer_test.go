@@ -37,6 +37,19 @@ func TestNilSerializer_Write(t *testing.T) {
 	}
 }
 
+func TestJSONSerializer(t *testing.T) {
+	service := &Service{}
+	serializer := &JSONSerializer{}
+	value := core.JSONValue(`{"name": "Joe"}`)
+	o := NewObjectDataOutput(0, service, false)
+	serializer.Write(o, value)
+	in := NewObjectDataInput(o.buffer, 0, service, false)
+	ret, err := serializer.Read(in)
+	if err != nil || ret != value {
+		t.Errorf("Read() returns %v, %v expected %v", ret, err, value)
+	}
+}
+
 type factory struct{}
 
 func (factory) Create(classID int32) serialization.IdentifiedDataSerializable {
@@ -47,7 +47,11 @@ func (ps *PortableSerializer) Read(input serialization.DataInput) (interface{},
 	if err != nil {
 		return nil, err
 	}
-	return ps.ReadObject(input, factoryID, classID)
+	portable, err := ps.ReadObject(input, factoryID, classID)
+	if structPortable, ok := portable.(*structPortable); ok {
+		return structPortable.object(), err
+	}
+	return portable, err
 }
 
 func (ps *PortableSerializer) ReadObject(input serialization.DataInput, factoryID int32, classID int32) (
@@ -96,6 +100,9 @@ func (ps *PortableSerializer) ReadObject(input serialization.DataInput, factoryI
 }
 
 func (ps *PortableSerializer) createNewPortableInstance(factoryID int32, classID int32) (serialization.Portable, error) {
+	if structPortable := ps.service.structs.create(factoryID, classID); structPortable != nil {
+		return structPortable, nil
+	}
 	factory := ps.factories[factoryID]
 	if factory == nil {
 		return nil, core.NewHazelcastSerializationError(fmt.Sprintf("there is no suitable portable factory for factory id: %d",
@@ -111,6 +118,13 @@ func (ps *PortableSerializer) createNewPortableInstance(factoryID int32, classID
 }
 
 func (ps *PortableSerializer) Write(output serialization.DataOutput, i interface{}) error {
+	if _, ok := i.(serialization.Portable); !ok {
+		structPortable, err := ps.service.structs.portable(i)
+		if err != nil {
+			return err
+		}
+		i = structPortable
+	}
 	output.WriteInt32(i.(serialization.Portable).FactoryID())
 	output.WriteInt32(i.(serialization.Portable).ClassID())
 	err := ps.WriteObject(output, i)
@@ -22,8 +22,10 @@ import (
 	"github.com/hazelcast/hazelcast-go-client/config"
 	"github.com/hazelcast/hazelcast-go-client/core"
 	"github.com/hazelcast/hazelcast-go-client/internal/aggregation"
+	"github.com/hazelcast/hazelcast-go-client/internal/eventjournal"
 	"github.com/hazelcast/hazelcast-go-client/internal/predicate"
 	"github.com/hazelcast/hazelcast-go-client/internal/projection"
+	"github.com/hazelcast/hazelcast-go-client/internal/reliabletopic"
 	"github.com/hazelcast/hazelcast-go-client/serialization"
 )
 
@@ -33,12 +35,18 @@ type Service struct {
 	serializationConfig *config.SerializationConfig
 	registry            map[int32]serialization.Serializer
 	nameToID            map[string]int32
+	structs             *structRegistry
 }
 
 func NewSerializationService(serializationConfig *config.SerializationConfig) (*Service, error) {
 	v1 := Service{serializationConfig: serializationConfig, nameToID: make(map[string]int32),
 		registry: make(map[int32]serialization.Serializer)}
-	err := v1.registerDefaultSerializers()
+	structs, err := newStructRegistry(serializationConfig.Structs())
+	if err != nil {
+		return nil, err
+	}
+	v1.structs = structs
+	err = v1.registerDefaultSerializers()
 	if err != nil {
 		return nil, err
 	}
@@ -182,6 +190,9 @@ func (s *Service) registerDefaultSerializers() error {
 	s.registerSerializer(&StringArraySerializer{})
 	s.nameToID["[]string"] = ConstantTypeStringArray
 
+	s.registerSerializer(&JSONSerializer{})
+	s.nameToID[reflect.TypeOf(core.JSONValue("")).String()] = JSONSerializationType
+
 	s.registerSerializer(&GobSerializer{})
 	s.nameToID["!gob"] = GoGobSerializationType
 
@@ -194,6 +205,11 @@ func (s *Service) registerDefaultSerializers() error {
 		s.serializationConfig.PortableVersion())
 
 	s.registerClassDefinitions(portableSerializer, s.serializationConfig.ClassDefinitions())
+	structClassDefinitions, err := s.structs.classDefinitions(portableSerializer.portableContext.Version())
+	if err != nil {
+		return err
+	}
+	s.registerClassDefinitions(portableSerializer, structClassDefinitions)
 	s.registerSerializer(portableSerializer)
 	s.nameToID["!portable"] = ConstantTypePortable
 	return nil
@@ -246,6 +262,9 @@ func (s *Service) lookUpDefaultSerializer(obj interface{}) serialization.Seriali
 	if isPortableSerializable(obj) {
 		return s.registry[s.nameToID["!portable"]]
 	}
+	if class, _ := s.structs.lookUp(reflect.TypeOf(obj)); class != nil {
+		return s.registry[s.nameToID["!portable"]]
+	}
 
 	if s.getIDByObject(obj) == nil {
 		return nil
@@ -284,9 +303,10 @@ func (s *Service) registerIdentifiedFactories() error {
 	factories[predicate.FactoryID] = predicate.NewFactory()
 	factories[projection.FactoryID] = projection.NewFactory()
 	factories[aggregation.FactoryID] = aggregation.NewFactory()
+	factories[reliabletopic.FactoryID] = reliabletopic.NewFactory()
+	factories[reliabletopic.ClusterFactoryID] = reliabletopic.NewClusterFactory()
+	factories[eventjournal.MapFactoryID] = eventjournal.NewMapFactory()
 
-	//factories[RELIABLE_TOPIC_MESSAGE_FACTORY_ID] = new ReliableTopicMessageFactory()
-	//factories[CLUSTER_DATA_FACTORY_ID] = new ClusterDataFactory()
 	err := s.registerSerializer(NewIdentifiedDataSerializableSerializer(factories))
 	if err != nil {
 		return err
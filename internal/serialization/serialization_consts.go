@@ -36,5 +36,6 @@ const (
 	ConstantTypeFloat32Array     = -18
 	ConstantTypeFloat64Array     = -19
 	ConstantTypeStringArray      = -20
+	JSONSerializationType        = -130
 	GoGobSerializationType       = -140
 )
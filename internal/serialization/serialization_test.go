@@ -21,6 +21,10 @@ import (
 	"testing"
 
 	"github.com/hazelcast/hazelcast-go-client/config"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/eventjournal"
+	"github.com/hazelcast/hazelcast-go-client/internal/predicate"
+	"github.com/hazelcast/hazelcast-go-client/internal/reliabletopic"
 	"github.com/hazelcast/hazelcast-go-client/serialization"
 	"github.com/hazelcast/hazelcast-go-client/test/assert"
 )
@@ -260,6 +264,22 @@ func TestInt64SerializerWithInt(t *testing.T) {
 	}
 }
 
+func TestJSONValueSerialization(t *testing.T) {
+	value := core.JSONValue(`{"name": "Joe"}`)
+	service, _ := NewSerializationService(config.NewSerializationConfig())
+	data, err := service.ToData(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.GetType() != JSONSerializationType {
+		t.Errorf("ToData() returns type %d expected %d", data.GetType(), JSONSerializationType)
+	}
+	ret, err := service.ToObject(data)
+	if err != nil || ret != value {
+		t.Errorf("ToObject() returns %v, %v expected %v", ret, err, value)
+	}
+}
+
 func TestInt64ArraySerializerWithIntArray(t *testing.T) {
 	var ids = []int{15, 10, 20, 12, 35}
 	config := config.NewSerializationConfig()
@@ -297,3 +317,90 @@ func TestUndefinedDataDeserialization(t *testing.T) {
 	_, err := s.ToObject(data)
 	assert.ErrorNotNil(t, err, "err should not be nil")
 }
+
+func TestReliableTopicMessageSerialization(t *testing.T) {
+	service, _ := NewSerializationService(config.NewSerializationConfig())
+	payload, _ := service.ToData("message")
+	data, err := service.ToData(reliabletopic.NewMessage(payload, 1000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	object, err := service.ToObject(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message, ok := object.(*reliabletopic.Message)
+	if !ok {
+		t.Fatalf("expected *reliabletopic.Message, got %T", object)
+	}
+	if message.PublishTime() != 1000 || message.PublisherAddress() != nil {
+		t.Errorf("unexpected message %+v", message)
+	}
+	if value, _ := service.ToObject(message.Payload().(*Data)); value != "message" {
+		t.Errorf("expected payload message, got %v", value)
+	}
+}
+
+func TestEventJournalMapEventDeserialization(t *testing.T) {
+	service, _ := NewSerializationService(config.NewSerializationConfig())
+	key, _ := service.ToData("key")
+	value, _ := service.ToData("value")
+	dataOutput := NewPositionalObjectDataOutput(1, service, service.serializationConfig.IsBigEndian())
+	dataOutput.WriteInt32(0) // partition
+	dataOutput.WriteInt32(ConstantTypeDataSerializable)
+	dataOutput.WriteBool(true)
+	dataOutput.WriteInt32(eventjournal.MapFactoryID)
+	dataOutput.WriteInt32(142)
+	dataOutput.WriteInt32(1) // added
+	dataOutput.WriteData(key)
+	dataOutput.WriteData(value)
+	dataOutput.WriteData(nil)
+	object, err := service.ToObject(&Data{dataOutput.buffer})
+	if err != nil {
+		t.Fatal(err)
+	}
+	event, ok := object.(*eventjournal.MapEvent)
+	if !ok {
+		t.Fatalf("expected *eventjournal.MapEvent, got %T", object)
+	}
+	if event.EventType() != 1 || event.OldValue() != nil {
+		t.Errorf("unexpected event %+v", event)
+	}
+	if eventKey, _ := service.ToObject(event.Key().(*Data)); eventKey != "key" {
+		t.Errorf("expected key key, got %v", eventKey)
+	}
+	if eventValue, _ := service.ToObject(event.NewValue().(*Data)); eventValue != "value" {
+		t.Errorf("expected value value, got %v", eventValue)
+	}
+}
+
+type testPair struct {
+	key, value interface{}
+}
+
+func (p *testPair) Key() interface{} {
+	return p.key
+}
+
+func (p *testPair) Value() interface{} {
+	return p.value
+}
+
+func TestPagingPredicateSerialization(t *testing.T) {
+	service, _ := NewSerializationService(config.NewSerializationConfig())
+	paging := predicate.NewPaging(predicate.NewEqual("this", int32(1)), 2, nil)
+	paging.SortedPage([]core.Pair{&testPair{"a", int32(1)}, &testPair{"b", int32(2)}})
+	paging.NextPage()
+	paging.SetIterationType(predicate.IterationTypeValue)
+	data, err := service.ToData(paging)
+	if err != nil {
+		t.Fatal(err)
+	}
+	object, err := service.ToObject(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(object, paging) {
+		t.Errorf("expected %+v got %+v", paging, object)
+	}
+}
@@ -0,0 +1,464 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serialization
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/hazelcast/hazelcast-go-client/config"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	internalClassDef "github.com/hazelcast/hazelcast-go-client/internal/serialization/classdef"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+	"github.com/hazelcast/hazelcast-go-client/serialization/classdef"
+)
+
+const structTag = "hz"
+
+// structArrayTypes maps the element types of the supported slice fields to their portable field types.
+var structArrayTypes = map[reflect.Type]int32{
+	reflect.TypeOf(byte(0)):    internalClassDef.TypeByteArray,
+	reflect.TypeOf(false):      internalClassDef.TypeBoolArray,
+	reflect.TypeOf(uint16(0)):  internalClassDef.TypeUint16Array,
+	reflect.TypeOf(int16(0)):   internalClassDef.TypeInt16Array,
+	reflect.TypeOf(int32(0)):   internalClassDef.TypeInt32Array,
+	reflect.TypeOf(int64(0)):   internalClassDef.TypeInt64Array,
+	reflect.TypeOf(float32(0)): internalClassDef.TypeFloat32Array,
+	reflect.TypeOf(float64(0)): internalClassDef.TypeFloat64Array,
+	reflect.TypeOf(""):         internalClassDef.TypeUTFArray,
+}
+
+type structKey struct {
+	factoryID int32
+	classID   int32
+}
+
+type structField struct {
+	name      string
+	index     int
+	fieldType int32
+
+	// nested is the struct class of a portable or a portable array field.
+	nested *structClass
+
+	// pointer is true if the portable field, or the elements of the portable array field, are pointers.
+	pointer bool
+}
+
+// structClass describes how a struct type registered with config.SerializationConfig.AddStruct
+// is written as a Portable.
+type structClass struct {
+	typ       reflect.Type
+	pointer   bool
+	factoryID int32
+	classID   int32
+	fields    []*structField
+}
+
+type structRegistry struct {
+	byType map[reflect.Type]*structClass
+	byKey  map[structKey]*structClass
+}
+
+func newStructRegistry(structs map[reflect.Type]config.StructID) (*structRegistry, error) {
+	registry := &structRegistry{
+		byType: make(map[reflect.Type]*structClass),
+		byKey:  make(map[structKey]*structClass),
+	}
+	for typ, id := range structs {
+		class := &structClass{typ: typ, factoryID: id.FactoryID, classID: id.ClassID}
+		if typ.Kind() == reflect.Ptr {
+			class.typ = typ.Elem()
+			class.pointer = true
+		}
+		registry.byType[class.typ] = class
+		registry.byKey[structKey{id.FactoryID, id.ClassID}] = class
+	}
+	for _, class := range registry.byType {
+		if err := registry.parseFields(class); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
+
+func (r *structRegistry) parseFields(class *structClass) error {
+	for i := 0; i < class.typ.NumField(); i++ {
+		field := class.typ.Field(i)
+		name, ok := field.Tag.Lookup(structTag)
+		if !ok || name == "-" {
+			continue
+		}
+		if field.PkgPath != "" {
+			return core.NewHazelcastSerializationError(fmt.Sprintf("tagged field %s of %v is not exported",
+				field.Name, class.typ), nil)
+		}
+		if name == "" {
+			name = field.Name
+		}
+		structField := &structField{name: name, index: i, fieldType: -1}
+		switch typ := field.Type; typ.Kind() {
+		case reflect.Uint8:
+			structField.fieldType = internalClassDef.TypeByte
+		case reflect.Bool:
+			structField.fieldType = internalClassDef.TypeBool
+		case reflect.Uint16:
+			structField.fieldType = internalClassDef.TypeUint16
+		case reflect.Int16:
+			structField.fieldType = internalClassDef.TypeInt16
+		case reflect.Int32:
+			structField.fieldType = internalClassDef.TypeInt32
+		case reflect.Int64, reflect.Int:
+			structField.fieldType = internalClassDef.TypeInt64
+		case reflect.Float32:
+			structField.fieldType = internalClassDef.TypeFloat32
+		case reflect.Float64:
+			structField.fieldType = internalClassDef.TypeFloat64
+		case reflect.String:
+			structField.fieldType = internalClassDef.TypeUTF
+		case reflect.Struct, reflect.Ptr:
+			structField.nested, structField.pointer = r.lookUp(typ)
+			if structField.nested != nil {
+				structField.fieldType = internalClassDef.TypePortable
+			}
+		case reflect.Slice:
+			if fieldType, ok := structArrayTypes[typ.Elem()]; ok {
+				structField.fieldType = fieldType
+			} else if structField.nested, structField.pointer = r.lookUp(typ.Elem()); structField.nested != nil {
+				structField.fieldType = internalClassDef.TypePortableArray
+			}
+		}
+		if structField.fieldType < 0 {
+			return core.NewHazelcastSerializationError(fmt.Sprintf("field %s of %v has an unsupported type %v",
+				field.Name, class.typ, field.Type), nil)
+		}
+		class.fields = append(class.fields, structField)
+	}
+	return nil
+}
+
+// lookUp returns the struct class of the given struct type or pointer to struct type.
+func (r *structRegistry) lookUp(typ reflect.Type) (class *structClass, pointer bool) {
+	if typ.Kind() == reflect.Ptr {
+		return r.byType[typ.Elem()], true
+	}
+	return r.byType[typ], false
+}
+
+// classDefinitions generates the class definitions of the registered structs with the given version.
+func (r *structRegistry) classDefinitions(version int32) ([]serialization.ClassDefinition, error) {
+	classDefinitions := make([]serialization.ClassDefinition, 0, len(r.byType))
+	for _, class := range r.byType {
+		builder := classdef.NewClassDefinitionBuilder(class.factoryID, class.classID, version)
+		for _, field := range class.fields {
+			if err := field.addTo(builder, version); err != nil {
+				return nil, err
+			}
+		}
+		classDefinitions = append(classDefinitions, builder.Build())
+	}
+	return classDefinitions, nil
+}
+
+func (f *structField) addTo(builder *classdef.ClassDefinitionBuilder, version int32) error {
+	switch f.fieldType {
+	case internalClassDef.TypeByte:
+		return builder.AddByteField(f.name)
+	case internalClassDef.TypeBool:
+		return builder.AddBoolField(f.name)
+	case internalClassDef.TypeUint16:
+		return builder.AddUInt16Field(f.name)
+	case internalClassDef.TypeInt16:
+		return builder.AddInt16Field(f.name)
+	case internalClassDef.TypeInt32:
+		return builder.AddInt32Field(f.name)
+	case internalClassDef.TypeInt64:
+		return builder.AddInt64Field(f.name)
+	case internalClassDef.TypeFloat32:
+		return builder.AddFloat32Field(f.name)
+	case internalClassDef.TypeFloat64:
+		return builder.AddFloat64Field(f.name)
+	case internalClassDef.TypeUTF:
+		return builder.AddUTFField(f.name)
+	case internalClassDef.TypeByteArray:
+		return builder.AddByteArrayField(f.name)
+	case internalClassDef.TypeBoolArray:
+		return builder.AddBoolArrayField(f.name)
+	case internalClassDef.TypeUint16Array:
+		return builder.AddUInt16ArrayField(f.name)
+	case internalClassDef.TypeInt16Array:
+		return builder.AddInt16ArrayField(f.name)
+	case internalClassDef.TypeInt32Array:
+		return builder.AddInt32ArrayField(f.name)
+	case internalClassDef.TypeInt64Array:
+		return builder.AddInt64ArrayField(f.name)
+	case internalClassDef.TypeFloat32Array:
+		return builder.AddFloat32ArrayField(f.name)
+	case internalClassDef.TypeFloat64Array:
+		return builder.AddFloat64ArrayField(f.name)
+	case internalClassDef.TypeUTFArray:
+		return builder.AddUTFArrayField(f.name)
+	case internalClassDef.TypePortable:
+		return builder.AddPortableField(f.name, f.nested.classDefinitionRef(version))
+	default:
+		return builder.AddPortableArrayField(f.name, f.nested.classDefinitionRef(version))
+	}
+}
+
+// portable returns the Portable for the given registered struct or pointer to struct,
+// or nil if it is not registered.
+func (r *structRegistry) portable(object interface{}) (serialization.Portable, error) {
+	class, pointer := r.lookUp(reflect.TypeOf(object))
+	if class == nil {
+		return nil, nil
+	}
+	value := reflect.ValueOf(object)
+	if pointer {
+		if value.IsNil() {
+			return nil, core.NewHazelcastNilPointerError(fmt.Sprintf("nil %v cannot be serialized", value.Type()), nil)
+		}
+		value = value.Elem()
+	}
+	return &structPortable{class: class, value: value}, nil
+}
+
+func (r *structRegistry) create(factoryID int32, classID int32) *structPortable {
+	class := r.byKey[structKey{factoryID, classID}]
+	if class == nil {
+		return nil
+	}
+	return &structPortable{class: class, value: reflect.New(class.typ).Elem()}
+}
+
+// classDefinitionRef returns a class definition that only identifies the struct class,
+// which is enough for adding the portable fields of its type.
+func (c *structClass) classDefinitionRef(version int32) serialization.ClassDefinition {
+	return internalClassDef.NewClassDefinitionImpl(c.factoryID, c.classID, version)
+}
+
+// structPortable writes and reads the tagged fields of a registered struct as a Portable.
+type structPortable struct {
+	class *structClass
+	value reflect.Value
+}
+
+func (p *structPortable) FactoryID() int32 {
+	return p.class.factoryID
+}
+
+func (p *structPortable) ClassID() int32 {
+	return p.class.classID
+}
+
+// object returns the struct as the registered type.
+func (p *structPortable) object() interface{} {
+	if p.class.pointer {
+		return p.value.Addr().Interface()
+	}
+	return p.value.Interface()
+}
+
+func (p *structPortable) WritePortable(writer serialization.PortableWriter) error {
+	for _, field := range p.class.fields {
+		value := p.value.Field(field.index)
+		switch field.fieldType {
+		case internalClassDef.TypeByte:
+			writer.WriteByte(field.name, byte(value.Uint()))
+		case internalClassDef.TypeBool:
+			writer.WriteBool(field.name, value.Bool())
+		case internalClassDef.TypeUint16:
+			writer.WriteUInt16(field.name, uint16(value.Uint()))
+		case internalClassDef.TypeInt16:
+			writer.WriteInt16(field.name, int16(value.Int()))
+		case internalClassDef.TypeInt32:
+			writer.WriteInt32(field.name, int32(value.Int()))
+		case internalClassDef.TypeInt64:
+			writer.WriteInt64(field.name, value.Int())
+		case internalClassDef.TypeFloat32:
+			writer.WriteFloat32(field.name, float32(value.Float()))
+		case internalClassDef.TypeFloat64:
+			writer.WriteFloat64(field.name, value.Float())
+		case internalClassDef.TypeUTF:
+			writer.WriteUTF(field.name, value.String())
+		case internalClassDef.TypeByteArray:
+			writer.WriteByteArray(field.name, value.Bytes())
+		case internalClassDef.TypeBoolArray:
+			writer.WriteBoolArray(field.name, arrayOf(value).([]bool))
+		case internalClassDef.TypeUint16Array:
+			writer.WriteUInt16Array(field.name, arrayOf(value).([]uint16))
+		case internalClassDef.TypeInt16Array:
+			writer.WriteInt16Array(field.name, arrayOf(value).([]int16))
+		case internalClassDef.TypeInt32Array:
+			writer.WriteInt32Array(field.name, arrayOf(value).([]int32))
+		case internalClassDef.TypeInt64Array:
+			writer.WriteInt64Array(field.name, arrayOf(value).([]int64))
+		case internalClassDef.TypeFloat32Array:
+			writer.WriteFloat32Array(field.name, arrayOf(value).([]float32))
+		case internalClassDef.TypeFloat64Array:
+			writer.WriteFloat64Array(field.name, arrayOf(value).([]float64))
+		case internalClassDef.TypeUTFArray:
+			writer.WriteUTFArray(field.name, arrayOf(value).([]string))
+		case internalClassDef.TypePortable:
+			if err := writer.WritePortable(field.name, field.portable(value)); err != nil {
+				return err
+			}
+		case internalClassDef.TypePortableArray:
+			var portables []serialization.Portable
+			if !value.IsNil() {
+				portables = make([]serialization.Portable, value.Len())
+				for i := range portables {
+					if portables[i] = field.portable(value.Index(i)); portables[i] == nil {
+						return core.NewHazelcastNilPointerError(fmt.Sprintf("field %s of %v has a nil element",
+							field.name, p.class.typ), nil)
+					}
+				}
+			}
+			if err := writer.WritePortableArray(field.name, portables); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (p *structPortable) ReadPortable(reader serialization.PortableReader) error {
+	for _, field := range p.class.fields {
+		value := p.value.Field(field.index)
+		var err error
+		switch field.fieldType {
+		case internalClassDef.TypeByte:
+			var v byte
+			v, err = reader.ReadByte(field.name)
+			value.SetUint(uint64(v))
+		case internalClassDef.TypeBool:
+			var v bool
+			v, err = reader.ReadBool(field.name)
+			value.SetBool(v)
+		case internalClassDef.TypeUint16:
+			var v uint16
+			v, err = reader.ReadUInt16(field.name)
+			value.SetUint(uint64(v))
+		case internalClassDef.TypeInt16:
+			var v int16
+			v, err = reader.ReadInt16(field.name)
+			value.SetInt(int64(v))
+		case internalClassDef.TypeInt32:
+			var v int32
+			v, err = reader.ReadInt32(field.name)
+			value.SetInt(int64(v))
+		case internalClassDef.TypeInt64:
+			var v int64
+			v, err = reader.ReadInt64(field.name)
+			value.SetInt(v)
+		case internalClassDef.TypeFloat32:
+			var v float32
+			v, err = reader.ReadFloat32(field.name)
+			value.SetFloat(float64(v))
+		case internalClassDef.TypeFloat64:
+			var v float64
+			v, err = reader.ReadFloat64(field.name)
+			value.SetFloat(v)
+		case internalClassDef.TypeUTF:
+			var v string
+			v, err = reader.ReadUTF(field.name)
+			value.SetString(v)
+		case internalClassDef.TypeByteArray:
+			err = setArray(value)(reader.ReadByteArray(field.name))
+		case internalClassDef.TypeBoolArray:
+			err = setArray(value)(reader.ReadBoolArray(field.name))
+		case internalClassDef.TypeUint16Array:
+			err = setArray(value)(reader.ReadUInt16Array(field.name))
+		case internalClassDef.TypeInt16Array:
+			err = setArray(value)(reader.ReadInt16Array(field.name))
+		case internalClassDef.TypeInt32Array:
+			err = setArray(value)(reader.ReadInt32Array(field.name))
+		case internalClassDef.TypeInt64Array:
+			err = setArray(value)(reader.ReadInt64Array(field.name))
+		case internalClassDef.TypeFloat32Array:
+			err = setArray(value)(reader.ReadFloat32Array(field.name))
+		case internalClassDef.TypeFloat64Array:
+			err = setArray(value)(reader.ReadFloat64Array(field.name))
+		case internalClassDef.TypeUTFArray:
+			err = setArray(value)(reader.ReadUTFArray(field.name))
+		case internalClassDef.TypePortable:
+			var portable serialization.Portable
+			portable, err = reader.ReadPortable(field.name)
+			if err == nil {
+				err = field.set(value, portable)
+			}
+		case internalClassDef.TypePortableArray:
+			var portables []serialization.Portable
+			portables, err = reader.ReadPortableArray(field.name)
+			if err == nil && portables != nil {
+				slice := reflect.MakeSlice(value.Type(), len(portables), len(portables))
+				for i, portable := range portables {
+					if err = field.set(slice.Index(i), portable); err != nil {
+						break
+					}
+				}
+				value.Set(slice)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// portable returns the Portable of the given value of a portable field or a portable array element.
+func (f *structField) portable(value reflect.Value) serialization.Portable {
+	if f.pointer {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	return &structPortable{class: f.nested, value: value}
+}
+
+// set sets the given value of a portable field or a portable array element to the read Portable.
+func (f *structField) set(value reflect.Value, portable serialization.Portable) error {
+	if portable == nil {
+		value.Set(reflect.Zero(value.Type()))
+		return nil
+	}
+	structPortable, ok := portable.(*structPortable)
+	if !ok || structPortable.class != f.nested {
+		return core.NewHazelcastSerializationError(fmt.Sprintf("field %s cannot be set to %v", f.name,
+			reflect.TypeOf(portable)), nil)
+	}
+	if f.pointer {
+		value.Set(structPortable.value.Addr())
+	} else {
+		value.Set(structPortable.value)
+	}
+	return nil
+}
+
+// arrayOf returns the given slice field as a slice of its unnamed element type.
+func arrayOf(value reflect.Value) interface{} {
+	return value.Convert(reflect.SliceOf(value.Type().Elem())).Interface()
+}
+
+// setArray returns a function that sets the given slice field to the read array.
+func setArray(value reflect.Value) func(array interface{}, err error) error {
+	return func(array interface{}, err error) error {
+		if err == nil {
+			value.Set(reflect.ValueOf(array).Convert(value.Type()))
+		}
+		return err
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serialization
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client/config"
+	internalClassDef "github.com/hazelcast/hazelcast-go-client/internal/serialization/classdef"
+)
+
+type tags []string
+
+type structAddress struct {
+	City   string `hz:"city"`
+	Street string
+}
+
+type structEmployee struct {
+	Name      string           `hz:"name"`
+	Age       int32            `hz:"age"`
+	ID        int              `hz:"id"`
+	Salary    float64          `hz:"salary"`
+	Active    bool             `hz:"active"`
+	Level     byte             `hz:"level"`
+	Code      uint16           `hz:"code"`
+	Rank      int16            `hz:"rank"`
+	Ratio     float32          `hz:"ratio"`
+	Tags      tags             `hz:"tags"`
+	Scores    []int64          `hz:"scores"`
+	Address   structAddress    `hz:"address"`
+	Previous  *structAddress   `hz:"previous"`
+	Addresses []*structAddress `hz:"addresses"`
+	Manager   *structEmployee  `hz:"manager"`
+	Ignored   string           `hz:"-"`
+	Untagged  string
+}
+
+func newStructService(t *testing.T) *Service {
+	serializationConfig := config.NewSerializationConfig()
+	if err := serializationConfig.AddStruct(reflect.TypeOf(&structEmployee{}), 1, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := serializationConfig.AddStruct(reflect.TypeOf(structAddress{}), 1, 2); err != nil {
+		t.Fatal(err)
+	}
+	service, err := NewSerializationService(serializationConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return service
+}
+
+func TestStructPortable_ToDataToObject(t *testing.T) {
+	service := newStructService(t)
+	employee := &structEmployee{Name: "Joe", Age: 30, ID: 7, Salary: 1500.5, Active: true, Level: 3, Code: 4,
+		Rank: -5, Ratio: 0.5, Tags: tags{"a", "b"}, Scores: []int64{1, 2}, Address: structAddress{City: "Istanbul"},
+		Addresses: []*structAddress{{City: "Ankara"}, {City: "Izmir"}},
+		Manager:   &structEmployee{Name: "Jane"}, Ignored: "ignored", Untagged: "untagged"}
+	data, err := service.ToData(employee)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data.GetType() != ConstantTypePortable {
+		t.Errorf("expected the portable type, got %d", data.GetType())
+	}
+	object, err := service.ToObject(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := *employee
+	expected.Ignored = ""
+	expected.Untagged = ""
+	if !reflect.DeepEqual(object, &expected) {
+		t.Errorf("expected %+v, got %+v", &expected, object)
+	}
+}
+
+func TestStructPortable_ValueType(t *testing.T) {
+	service := newStructService(t)
+	data, err := service.ToData(&structAddress{City: "Izmir", Street: "not serialized"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	object, err := service.ToObject(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if object != (structAddress{City: "Izmir"}) {
+		t.Errorf("unexpected object: %+v", object)
+	}
+}
+
+func TestStructPortable_ClassDefinition(t *testing.T) {
+	service := newStructService(t)
+	portableSerializer := service.registry[ConstantTypePortable].(*PortableSerializer)
+	classDefinition := portableSerializer.portableContext.LookUpClassDefinition(1, 1, 0)
+	if classDefinition == nil {
+		t.Fatal("class definition is not registered")
+	}
+	if classDefinition.FieldCount() != 15 {
+		t.Errorf("expected 15 fields, got %d", classDefinition.FieldCount())
+	}
+	expected := map[string]int32{
+		"name":      internalClassDef.TypeUTF,
+		"id":        internalClassDef.TypeInt64,
+		"tags":      internalClassDef.TypeUTFArray,
+		"address":   internalClassDef.TypePortable,
+		"addresses": internalClassDef.TypePortableArray,
+	}
+	for name, fieldType := range expected {
+		if field := classDefinition.Field(name); field == nil || field.Type() != fieldType {
+			t.Errorf("unexpected definition of field %s: %v", name, field)
+		}
+	}
+	if field := classDefinition.Field("address"); field.FactoryID() != 1 || field.ClassID() != 2 {
+		t.Errorf("unexpected nested class of field address: %v", field)
+	}
+}
+
+func TestStructPortable_UnsupportedField(t *testing.T) {
+	type unsupported struct {
+		Values map[string]string `hz:"values"`
+	}
+	serializationConfig := config.NewSerializationConfig()
+	if err := serializationConfig.AddStruct(reflect.TypeOf(unsupported{}), 1, 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewSerializationService(serializationConfig); err == nil {
+		t.Error("expected an error for an unsupported field type")
+	}
+}
+
+func TestStructPortable_NilPointer(t *testing.T) {
+	service := newStructService(t)
+	if _, err := service.ToData((*structEmployee)(nil)); err == nil {
+		t.Error("expected an error for a nil pointer")
+	}
+	if _, err := service.ToData(&structEmployee{Addresses: []*structAddress{nil}}); err == nil {
+		t.Error("expected an error for a nil portable array element")
+	}
+}
+
+func TestStructPortable_AddStructErrors(t *testing.T) {
+	serializationConfig := config.NewSerializationConfig()
+	if err := serializationConfig.AddStruct(reflect.TypeOf(structAddress{}), 1, 2); err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		name    string
+		typ     reflect.Type
+		classID int32
+	}{
+		{"notStruct", reflect.TypeOf(""), 3},
+		{"zeroClassID", reflect.TypeOf(structEmployee{}), 0},
+		{"sameIDs", reflect.TypeOf(structEmployee{}), 2},
+		{"pointerOfRegistered", reflect.TypeOf(&structAddress{}), 3},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := serializationConfig.AddStruct(tc.typ, 1, tc.classID); err == nil {
+				t.Errorf("expected an error for %v", tc.typ)
+			}
+		})
+	}
+}
@@ -15,6 +15,9 @@
 package internal
 
 import (
+	"context"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
 	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
 )
@@ -155,3 +158,7 @@ func (sp *setProxy) createEventHandler(listener interface{}) func(clientMessage
 		})
 	}
 }
+
+func (sp *setProxy) WithContext(ctx context.Context) core.Set {
+	return &setProxy{sp.partitionSpecificProxy.withContext(ctx)}
+}
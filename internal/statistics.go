@@ -0,0 +1,224 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/config/property"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/internal/timeutil"
+)
+
+const (
+	statisticsClientType    = "GO"
+	statisticsClientVersion = "ALPHA"
+)
+
+// statisticsService collects the statistics of the client.
+// If the statistics are enabled via property.StatisticsEnabled, they are sent to the cluster periodically
+// over the owner connection.
+type statisticsService struct {
+	retries           int64
+	reconnects        int64
+	heartbeatFailures int64
+	client            *HazelcastClient
+	latenciesMu       sync.Mutex // guards latencies
+	latencies         map[bufutil.MessageType]*core.LatencyStatistics
+	mapsMu            sync.Mutex // guards maps
+	maps              map[string]map[bufutil.MessageType]int64
+	cancel            chan struct{}
+}
+
+func newStatisticsService(client *HazelcastClient) *statisticsService {
+	return &statisticsService{
+		client:    client,
+		latencies: make(map[bufutil.MessageType]*core.LatencyStatistics),
+		maps:      make(map[string]map[bufutil.MessageType]int64),
+		cancel:    make(chan struct{}),
+	}
+}
+
+func (ss *statisticsService) start() {
+	if !ss.client.properties.GetBoolean(property.StatisticsEnabled) {
+		return
+	}
+	period := ss.client.properties.GetPositiveDuration(property.StatisticsPeriodSeconds)
+	ss.client.logger.Info("Client statistics are enabled with a period of ", period)
+	go func() {
+		ticker := time.NewTicker(period)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				ss.publish()
+			case <-ss.cancel:
+				return
+			}
+		}
+	}()
+}
+
+func (ss *statisticsService) recordLatency(messageType bufutil.MessageType, latency time.Duration) {
+	ss.latenciesMu.Lock()
+	defer ss.latenciesMu.Unlock()
+	latencyStats, found := ss.latencies[messageType]
+	if !found {
+		latencyStats = &core.LatencyStatistics{}
+		ss.latencies[messageType] = latencyStats
+	}
+	latencyStats.Count++
+	latencyStats.Total += latency
+	if latency > latencyStats.Max {
+		latencyStats.Max = latency
+	}
+}
+
+func (ss *statisticsService) recordMapOperation(name string, messageType bufutil.MessageType) {
+	ss.mapsMu.Lock()
+	defer ss.mapsMu.Unlock()
+	operations, found := ss.maps[name]
+	if !found {
+		operations = make(map[bufutil.MessageType]int64)
+		ss.maps[name] = operations
+	}
+	operations[messageType]++
+}
+
+func (ss *statisticsService) incrementRetries() {
+	atomic.AddInt64(&ss.retries, 1)
+}
+
+func (ss *statisticsService) incrementReconnects() {
+	atomic.AddInt64(&ss.reconnects, 1)
+}
+
+func (ss *statisticsService) incrementHeartbeatFailures() {
+	atomic.AddInt64(&ss.heartbeatFailures, 1)
+}
+
+func (ss *statisticsService) snapshot() core.Statistics {
+	stats := core.Statistics{
+		Timestamp:           time.Now(),
+		PendingInvocations:  ss.client.InvocationService.pendingInvocationCount(),
+		InvocationRetries:   atomic.LoadInt64(&ss.retries),
+		InvocationLatencies: make(map[string]core.LatencyStatistics),
+		Reconnects:          atomic.LoadInt64(&ss.reconnects),
+		HeartbeatFailures:   atomic.LoadInt64(&ss.heartbeatFailures),
+		PendingEvents:       ss.client.EventExecutor.pendingEventCount(),
+		DroppedEvents:       ss.client.EventExecutor.droppedEventCount(),
+		Maps:                make(map[string]core.MapStatistics),
+	}
+	for _, connection := range ss.client.ConnectionManager.getActiveConnections() {
+		stats.Connections = append(stats.Connections, connection.statistics())
+	}
+	sort.Slice(stats.Connections, func(i, j int) bool {
+		return stats.Connections[i].ConnectionID < stats.Connections[j].ConnectionID
+	})
+	ss.latenciesMu.Lock()
+	for messageType, latencyStats := range ss.latencies {
+		stats.InvocationLatencies[proto.MessageTypeName(messageType)] = *latencyStats
+	}
+	ss.latenciesMu.Unlock()
+	ss.mapsMu.Lock()
+	for name, operations := range ss.maps {
+		mapStats := core.MapStatistics{Operations: make(map[string]int64, len(operations))}
+		for messageType, count := range operations {
+			mapStats.Operations[proto.MessageTypeName(messageType)] = count
+		}
+		stats.Maps[name] = mapStats
+	}
+	ss.mapsMu.Unlock()
+	return stats
+}
+
+func (ss *statisticsService) publish() {
+	ownerConnection := ss.client.ConnectionManager.getOwnerConnection()
+	if ownerConnection == nil {
+		ss.client.logger.Trace("Statistics are not sent to the cluster since there is no owner connection")
+		return
+	}
+	request := proto.ClientStatisticsEncodeRequest(ss.collectClusterStatistics(ownerConnection))
+	ss.client.InvocationService.invokeOnConnection(request, ownerConnection).AndThen(
+		func(response *proto.ClientMessage, err error) {
+			if err != nil {
+				ss.client.logger.Debug("Statistics could not be sent to the cluster: ", err)
+			}
+		})
+}
+
+// collectClusterStatistics returns the statistics in the format that the members expect:
+// comma separated key=value pairs, where the special characters in the keys and values are escaped.
+func (ss *statisticsService) collectClusterStatistics(ownerConnection *Connection) string {
+	builder := &statisticsBuilder{}
+	builder.add("lastStatisticsCollectionTime", timeutil.ConvertUnixTimeToMillis(time.Now()))
+	builder.add("enterprise", false)
+	builder.add("clientType", statisticsClientType)
+	builder.add("clientVersion", statisticsClientVersion)
+	builder.add("clusterConnectionTimestamp", timeutil.ConvertUnixTimeToMillis(ownerConnection.startTime))
+	builder.add("clientAddress", ownerConnection.socket.LocalAddr().String())
+
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	builder.add("runtime.availableProcessors", runtime.NumCPU())
+	builder.add("runtime.totalMemory", memStats.Sys)
+	builder.add("runtime.usedMemory", memStats.HeapAlloc)
+	builder.add("runtime.freeMemory", memStats.HeapIdle)
+
+	for _, nearCache := range ss.client.NearCacheManager.nearCacheList() {
+		prefix := "nc." + escapeStatistic(nearCache.Name()) + "."
+		nearCacheStats := nearCache.Stats()
+		builder.add(prefix+"creationTime", timeutil.ConvertUnixTimeToMillis(nearCacheStats.CreationTime()))
+		builder.add(prefix+"evictions", nearCacheStats.Evictions())
+		builder.add(prefix+"expirations", nearCacheStats.Expirations())
+		builder.add(prefix+"hits", nearCacheStats.Hits())
+		builder.add(prefix+"misses", nearCacheStats.Misses())
+		builder.add(prefix+"ownedEntryCount", nearCacheStats.OwnedEntryCount())
+		builder.add(prefix+"invalidations", nearCacheStats.Invalidations())
+	}
+	return builder.String()
+}
+
+func (ss *statisticsService) shutdown() {
+	close(ss.cancel)
+}
+
+type statisticsBuilder struct {
+	strings.Builder
+}
+
+// add appends the given statistic. The key should already be escaped, since it might contain a prefix.
+func (sb *statisticsBuilder) add(key string, value interface{}) {
+	if sb.Len() > 0 {
+		sb.WriteByte(',')
+	}
+	sb.WriteString(key)
+	sb.WriteByte('=')
+	sb.WriteString(escapeStatistic(fmt.Sprint(value)))
+}
+
+var statisticsEscaper = strings.NewReplacer(`\`, `\\`, `,`, `\,`, `=`, `\=`)
+
+func escapeStatistic(value string) string {
+	return statisticsEscaper.Replace(value)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+func newTestStatisticsService() *statisticsService {
+	service := newTestInvocationService()
+	service.client.ConnectionManager = &connectionManagerImpl{connections: make(map[string]*Connection)}
+	service.client.EventExecutor = newTestEventExecutor(nil)
+	service.client.StatisticsService = newStatisticsService(service.client)
+	return service.client.StatisticsService
+}
+
+func TestStatisticsServiceLatencies(t *testing.T) {
+	service := newTestStatisticsService()
+	messageType := proto.MapSizeEncodeRequest("test").MessageType()
+	service.recordLatency(messageType, 10*time.Millisecond)
+	service.recordLatency(messageType, 30*time.Millisecond)
+	latency, found := service.snapshot().InvocationLatencies["MapSize"]
+	if !found {
+		t.Fatal("expected the latencies of MapSize")
+	}
+	if latency.Count != 2 || latency.Total != 40*time.Millisecond || latency.Max != 30*time.Millisecond {
+		t.Fatalf("unexpected latencies %+v", latency)
+	}
+	if latency.Mean() != 20*time.Millisecond {
+		t.Fatalf("expected a mean of 20ms, got %s", latency.Mean())
+	}
+}
+
+func TestStatisticsServiceMapOperations(t *testing.T) {
+	service := newTestStatisticsService()
+	sizeType := proto.MapSizeEncodeRequest("test").MessageType()
+	clearType := proto.MapClearEncodeRequest("test").MessageType()
+	service.recordMapOperation("test", sizeType)
+	service.recordMapOperation("test", sizeType)
+	service.recordMapOperation("test", clearType)
+	service.recordMapOperation("other", clearType)
+	stats := service.snapshot()
+	if operations := stats.Maps["test"].Operations; operations["MapSize"] != 2 || operations["MapClear"] != 1 {
+		t.Fatalf("unexpected operations of map test %v", operations)
+	}
+	if operations := stats.Maps["other"].Operations; len(operations) != 1 || operations["MapClear"] != 1 {
+		t.Fatalf("unexpected operations of map other %v", operations)
+	}
+}
+
+func TestStatisticsServiceCounters(t *testing.T) {
+	service := newTestStatisticsService()
+	newRegisteredTestInvocation(service.client.InvocationService.(*invocationServiceImpl))
+	service.incrementRetries()
+	service.incrementReconnects()
+	service.incrementReconnects()
+	service.incrementHeartbeatFailures()
+	stats := service.snapshot()
+	if stats.PendingInvocations != 1 || stats.InvocationRetries != 1 || stats.Reconnects != 2 ||
+		stats.HeartbeatFailures != 1 {
+		t.Fatalf("unexpected statistics %+v", stats)
+	}
+}
+
+func TestStatisticsBuilderEscapesValues(t *testing.T) {
+	builder := &statisticsBuilder{}
+	builder.add("clientType", "GO")
+	builder.add("nc."+escapeStatistic("a,b=c")+".hits", 3)
+	builder.add("path", `x\y`)
+	expected := `clientType=GO,nc.a\,b\=c.hits=3,path=x\\y`
+	if builder.String() != expected {
+		t.Fatalf("expected %s, got %s", expected, builder.String())
+	}
+}
+
+func TestMessageTypeName(t *testing.T) {
+	if name := proto.MessageTypeName(proto.ClientPingEncodeRequest().MessageType()); name != "ClientPing" {
+		t.Fatalf("expected ClientPing, got %s", name)
+	}
+	if name := proto.MessageTypeName(0x7fff); name != "0x7fff" {
+		t.Fatalf("expected 0x7fff, got %s", name)
+	}
+}
@@ -44,3 +44,10 @@ func ConvertMillisToUnixTime(timeInMillis int64) time.Time {
 	}
 	return time.Unix(0, timeInMillis*int64(time.Millisecond))
 }
+
+func ConvertUnixTimeToMillis(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano() / int64(time.Millisecond)
+}
@@ -15,6 +15,8 @@
 package internal
 
 import (
+	"context"
+
 	"github.com/hazelcast/hazelcast-go-client/core"
 	"github.com/hazelcast/hazelcast-go-client/internal/proto"
 	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
@@ -71,3 +73,7 @@ func (tp *topicProxy) createEventHandler(messageListener core.MessageListener) f
 		})
 	}
 }
+
+func (tp *topicProxy) WithContext(ctx context.Context) core.Topic {
+	return &topicProxy{tp.partitionSpecificProxy.withContext(ctx)}
+}
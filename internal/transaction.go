@@ -0,0 +1,213 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/internal/timeutil"
+)
+
+type transactionState int32
+
+const (
+	transactionStateNotStarted transactionState = iota
+	transactionStateActive
+	transactionStateCommitted
+	transactionStateCommitFailed
+	transactionStateRolledBack
+)
+
+type transactionContext struct {
+	client     *HazelcastClient
+	options    *core.TransactionOptions
+	connection *Connection
+	txnID      string
+	state      transactionState
+	startTime  time.Time
+	proxies    map[string]core.DistributedObject
+}
+
+func newTransactionContext(client *HazelcastClient, options *core.TransactionOptions) *transactionContext {
+	if options == nil {
+		options = core.NewTransactionOptions()
+	}
+	return &transactionContext{
+		client:  client,
+		options: options,
+		proxies: make(map[string]core.DistributedObject),
+	}
+}
+
+func (tc *transactionContext) Begin() error {
+	if tc.state != transactionStateNotStarted {
+		return core.NewHazelcastIllegalStateError("transaction is already started", nil)
+	}
+	connection, err := tc.connect()
+	if err != nil {
+		return err
+	}
+	request := proto.TransactionCreateEncodeRequest(timeutil.GetTimeInMilliSeconds(tc.options.Timeout()),
+		tc.options.Durability(), int32(tc.options.TransactionType()), threadID)
+	responseMessage, err := tc.client.InvocationService.invokeOnConnection(request, connection).Result()
+	if err != nil {
+		return err
+	}
+	tc.connection = connection
+	tc.txnID = proto.TransactionCreateDecodeResponse(responseMessage)()
+	tc.startTime = time.Now()
+	tc.state = transactionStateActive
+	return nil
+}
+
+// connect returns the connection that the transaction is bound to. In the smart mode it is a connection
+// to a member chosen by the load balancer, otherwise it is the owner connection.
+func (tc *transactionContext) connect() (*Connection, error) {
+	if !tc.client.ClientConfig.NetworkConfig().IsSmartRouting() {
+		connection := tc.client.ConnectionManager.getOwnerConnection()
+		if connection == nil {
+			return nil, core.NewHazelcastIOError("no owner connection found to begin the transaction", nil)
+		}
+		return connection, nil
+	}
+	address := nextAddress(tc.client.LoadBalancer)
+	if address == nil {
+		return nil, core.NewHazelcastIOError("no address found to begin the transaction", nil)
+	}
+	return tc.client.ConnectionManager.getOrConnect(address, false)
+}
+
+func (tc *transactionContext) Commit() error {
+	if err := tc.checkActive(); err != nil {
+		return err
+	}
+	if time.Since(tc.startTime) > tc.options.Timeout() {
+		tc.state = transactionStateCommitFailed
+		return core.NewHazelcastTransactionError("transaction is timed out", nil)
+	}
+	request := proto.TransactionCommitEncodeRequest(tc.txnID, threadID)
+	_, err := tc.client.InvocationService.invokeOnConnection(request, tc.connection).Result()
+	if err != nil {
+		tc.state = transactionStateCommitFailed
+		return err
+	}
+	tc.state = transactionStateCommitted
+	return nil
+}
+
+// Rollback rolls back an active transaction, or a transaction whose commit has failed.
+func (tc *transactionContext) Rollback() error {
+	if tc.state != transactionStateActive && tc.state != transactionStateCommitFailed {
+		return core.NewHazelcastIllegalStateError("transaction is not active", nil)
+	}
+	request := proto.TransactionRollbackEncodeRequest(tc.txnID, threadID)
+	_, err := tc.client.InvocationService.invokeOnConnection(request, tc.connection).Result()
+	tc.state = transactionStateRolledBack
+	return err
+}
+
+func (tc *transactionContext) TxnID() string {
+	return tc.txnID
+}
+
+func (tc *transactionContext) GetMap(name string) (core.TransactionalMap, error) {
+	txnProxy, err := tc.getProxy(bufutil.ServiceNameMap, name)
+	if err != nil {
+		return nil, err
+	}
+	return txnProxy.(core.TransactionalMap), nil
+}
+
+func (tc *transactionContext) GetQueue(name string) (core.TransactionalQueue, error) {
+	txnProxy, err := tc.getProxy(bufutil.ServiceNameQueue, name)
+	if err != nil {
+		return nil, err
+	}
+	return txnProxy.(core.TransactionalQueue), nil
+}
+
+func (tc *transactionContext) GetList(name string) (core.TransactionalList, error) {
+	txnProxy, err := tc.getProxy(bufutil.ServiceNameList, name)
+	if err != nil {
+		return nil, err
+	}
+	return txnProxy.(core.TransactionalList), nil
+}
+
+func (tc *transactionContext) GetSet(name string) (core.TransactionalSet, error) {
+	txnProxy, err := tc.getProxy(bufutil.ServiceNameSet, name)
+	if err != nil {
+		return nil, err
+	}
+	return txnProxy.(core.TransactionalSet), nil
+}
+
+func (tc *transactionContext) GetMultiMap(name string) (core.TransactionalMultiMap, error) {
+	txnProxy, err := tc.getProxy(bufutil.ServiceNameMultiMap, name)
+	if err != nil {
+		return nil, err
+	}
+	return txnProxy.(core.TransactionalMultiMap), nil
+}
+
+func (tc *transactionContext) getProxy(serviceName string, name string) (core.DistributedObject, error) {
+	if err := tc.checkActive(); err != nil {
+		return nil, err
+	}
+	key := serviceName + name
+	if txnProxy, found := tc.proxies[key]; found {
+		return txnProxy, nil
+	}
+	base := &transactionalProxy{proxy: newProxy(tc.client, serviceName, name), txn: tc}
+	var txnProxy core.DistributedObject
+	switch serviceName {
+	case bufutil.ServiceNameMap:
+		txnProxy = &transactionalMapProxy{base}
+	case bufutil.ServiceNameQueue:
+		txnProxy = &transactionalQueueProxy{base}
+	case bufutil.ServiceNameList:
+		txnProxy = &transactionalListProxy{base}
+	case bufutil.ServiceNameSet:
+		txnProxy = &transactionalSetProxy{base}
+	case bufutil.ServiceNameMultiMap:
+		txnProxy = &transactionalMultiMapProxy{base}
+	}
+	tc.proxies[key] = txnProxy
+	return txnProxy, nil
+}
+
+func (tc *transactionContext) checkActive() error {
+	if tc.state != transactionStateActive {
+		return core.NewHazelcastTransactionError("transaction is not active", nil)
+	}
+	return nil
+}
+
+// transactionalProxy is the base of the transactional proxies. Its invocations are sent over
+// the connection that the transaction was started on.
+type transactionalProxy struct {
+	*proxy
+	txn *transactionContext
+}
+
+func (tp *transactionalProxy) invoke(request *proto.ClientMessage) (*proto.ClientMessage, error) {
+	if err := tp.txn.checkActive(); err != nil {
+		return nil, err
+	}
+	return tp.result(tp.client.InvocationService.invokeOnConnection(request, tp.txn.connection))
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client/config"
+	"github.com/hazelcast/hazelcast-go-client/config/property"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+func TestTransactionContext_DefaultOptions(t *testing.T) {
+	tc := newTransactionContext(nil, nil)
+	if tc.options.Timeout() != core.NewTransactionOptions().Timeout() {
+		t.Errorf("expected default timeout, got %v", tc.options.Timeout())
+	}
+	if tc.options.TransactionType() != core.TransactionTypeTwoPhase {
+		t.Errorf("expected two phase transaction, got %v", tc.options.TransactionType())
+	}
+}
+
+func TestTransactionContext_CommitWhenNotStarted(t *testing.T) {
+	tc := newTransactionContext(nil, nil)
+	if _, ok := tc.Commit().(*core.HazelcastTransactionError); !ok {
+		t.Error("Commit should return HazelcastTransactionError when the transaction is not started")
+	}
+}
+
+func TestTransactionContext_RollbackWhenNotStarted(t *testing.T) {
+	tc := newTransactionContext(nil, nil)
+	if _, ok := tc.Rollback().(*core.HazelcastIllegalStateError); !ok {
+		t.Error("Rollback should return HazelcastIllegalStateError when the transaction is not started")
+	}
+}
+
+func TestTransactionContext_GetMapWhenNotStarted(t *testing.T) {
+	tc := newTransactionContext(nil, nil)
+	if _, err := tc.GetMap("map"); err == nil {
+		t.Error("GetMap should fail when the transaction is not started")
+	}
+}
+
+func TestTransactionContext_BeginTwice(t *testing.T) {
+	tc := newTransactionContext(nil, nil)
+	tc.state = transactionStateActive
+	if _, ok := tc.Begin().(*core.HazelcastIllegalStateError); !ok {
+		t.Error("Begin should return HazelcastIllegalStateError when the transaction is already started")
+	}
+}
+
+func TestTransactionContext_ProxyIsCached(t *testing.T) {
+	tc := newTransactionContext(nil, nil)
+	tc.state = transactionStateActive
+	mp1, _ := tc.GetMap("map")
+	mp2, _ := tc.GetMap("map")
+	if mp1 != mp2 {
+		t.Error("GetMap should return the same proxy for the same name")
+	}
+}
+
+// ownerOnlyConnectionManager returns the owner connection and fails the connections to the other members.
+type ownerOnlyConnectionManager struct {
+	connectionManager
+	owner     *Connection
+	connected []core.Address
+}
+
+func (cm *ownerOnlyConnectionManager) getOwnerConnection() *Connection {
+	return cm.owner
+}
+
+func (cm *ownerOnlyConnectionManager) getOrConnect(address core.Address, asOwner bool) (*Connection, error) {
+	cm.connected = append(cm.connected, address)
+	return nil, core.NewHazelcastIOError("unexpected connection", nil)
+}
+
+// connectionRecordingInvocationService records the connections of the invocations and fails them.
+type connectionRecordingInvocationService struct {
+	invocationService
+	client      *HazelcastClient
+	connections []*Connection
+}
+
+func (s *connectionRecordingInvocationService) invokeOnConnection(request *proto.ClientMessage,
+	connection *Connection) invocationResult {
+	s.connections = append(s.connections, connection)
+	invocation := newInvocation(request, -1, nil, connection, s.client)
+	invocation.complete(core.NewHazelcastIOError("invocation failed", nil))
+	return invocation
+}
+
+func TestTransactionContext_BeginUsesOwnerConnectionWhenNotSmart(t *testing.T) {
+	clientConfig := config.New()
+	clientConfig.NetworkConfig().SetSmartRouting(false)
+	client := &HazelcastClient{
+		ClientConfig: clientConfig,
+		properties:   property.NewHazelcastProperties(config.Properties{}),
+	}
+	connectionManager := &ownerOnlyConnectionManager{owner: &Connection{}}
+	invocationService := &connectionRecordingInvocationService{client: client}
+	client.ConnectionManager = connectionManager
+	client.InvocationService = invocationService
+	tc := newTransactionContext(client, nil)
+	if _, ok := tc.Begin().(*core.HazelcastIOError); !ok {
+		t.Fatal("Begin should return the error of the invocation")
+	}
+	if len(connectionManager.connected) != 0 {
+		t.Errorf("no new connection should be opened in the unisocket mode, connected to %v", connectionManager.connected)
+	}
+	if len(invocationService.connections) != 1 || invocationService.connections[0] != connectionManager.owner {
+		t.Errorf("transaction should be created on the owner connection, used %v", invocationService.connections)
+	}
+}
+
+func TestTransactionContext_BeginWithoutOwnerConnection(t *testing.T) {
+	clientConfig := config.New()
+	clientConfig.NetworkConfig().SetSmartRouting(false)
+	client := &HazelcastClient{
+		ClientConfig:      clientConfig,
+		ConnectionManager: &ownerOnlyConnectionManager{},
+	}
+	tc := newTransactionContext(client, nil)
+	if _, ok := tc.Begin().(*core.HazelcastIOError); !ok {
+		t.Fatal("Begin should return HazelcastIOError when there is no owner connection")
+	}
+	if tc.state != transactionStateNotStarted {
+		t.Error("transaction should not be started when there is no owner connection")
+	}
+}
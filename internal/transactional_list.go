@@ -0,0 +1,49 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+type transactionalListProxy struct {
+	*transactionalProxy
+}
+
+func (tlp *transactionalListProxy) Add(item interface{}) (changed bool, err error) {
+	itemData, err := tlp.validateAndSerialize(item)
+	if err != nil {
+		return false, err
+	}
+	request := proto.TransactionalListAddEncodeRequest(tlp.name, tlp.txn.txnID, threadID, itemData)
+	responseMessage, err := tlp.invoke(request)
+	return tlp.decodeToBoolAndError(responseMessage, err, proto.TransactionalListAddDecodeResponse)
+}
+
+func (tlp *transactionalListProxy) Remove(item interface{}) (removed bool, err error) {
+	itemData, err := tlp.validateAndSerialize(item)
+	if err != nil {
+		return false, err
+	}
+	request := proto.TransactionalListRemoveEncodeRequest(tlp.name, tlp.txn.txnID, threadID, itemData)
+	responseMessage, err := tlp.invoke(request)
+	return tlp.decodeToBoolAndError(responseMessage, err, proto.TransactionalListRemoveDecodeResponse)
+}
+
+func (tlp *transactionalListProxy) Size() (size int32, err error) {
+	request := proto.TransactionalListSizeEncodeRequest(tlp.name, tlp.txn.txnID, threadID)
+	responseMessage, err := tlp.invoke(request)
+	return tlp.decodeToInt32AndError(responseMessage, err, proto.TransactionalListSizeDecodeResponse)
+}
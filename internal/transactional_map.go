@@ -0,0 +1,198 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/timeutil"
+)
+
+type transactionalMapProxy struct {
+	*transactionalProxy
+}
+
+func (tmp *transactionalMapProxy) ContainsKey(key interface{}) (found bool, err error) {
+	keyData, err := tmp.validateAndSerialize(key)
+	if err != nil {
+		return false, err
+	}
+	request := proto.TransactionalMapContainsKeyEncodeRequest(tmp.name, tmp.txn.txnID, threadID, keyData)
+	responseMessage, err := tmp.invoke(request)
+	return tmp.decodeToBoolAndError(responseMessage, err, proto.TransactionalMapContainsKeyDecodeResponse)
+}
+
+func (tmp *transactionalMapProxy) Get(key interface{}) (value interface{}, err error) {
+	keyData, err := tmp.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	request := proto.TransactionalMapGetEncodeRequest(tmp.name, tmp.txn.txnID, threadID, keyData)
+	responseMessage, err := tmp.invoke(request)
+	return tmp.decodeToObjectAndError(responseMessage, err, proto.TransactionalMapGetDecodeResponse)
+}
+
+func (tmp *transactionalMapProxy) GetForUpdate(key interface{}) (value interface{}, err error) {
+	keyData, err := tmp.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	request := proto.TransactionalMapGetForUpdateEncodeRequest(tmp.name, tmp.txn.txnID, threadID, keyData)
+	responseMessage, err := tmp.invoke(request)
+	return tmp.decodeToObjectAndError(responseMessage, err, proto.TransactionalMapGetForUpdateDecodeResponse)
+}
+
+func (tmp *transactionalMapProxy) Size() (size int32, err error) {
+	request := proto.TransactionalMapSizeEncodeRequest(tmp.name, tmp.txn.txnID, threadID)
+	responseMessage, err := tmp.invoke(request)
+	return tmp.decodeToInt32AndError(responseMessage, err, proto.TransactionalMapSizeDecodeResponse)
+}
+
+func (tmp *transactionalMapProxy) IsEmpty() (empty bool, err error) {
+	request := proto.TransactionalMapIsEmptyEncodeRequest(tmp.name, tmp.txn.txnID, threadID)
+	responseMessage, err := tmp.invoke(request)
+	return tmp.decodeToBoolAndError(responseMessage, err, proto.TransactionalMapIsEmptyDecodeResponse)
+}
+
+func (tmp *transactionalMapProxy) Put(key interface{}, value interface{}) (oldValue interface{}, err error) {
+	return tmp.PutWithTTL(key, value, ttlUnlimited)
+}
+
+func (tmp *transactionalMapProxy) PutWithTTL(key interface{}, value interface{},
+	ttl time.Duration) (oldValue interface{}, err error) {
+	keyData, valueData, err := tmp.validateAndSerialize2(key, value)
+	if err != nil {
+		return nil, err
+	}
+	ttlInMilliSeconds := timeutil.GetTimeInMilliSeconds(ttl)
+	request := proto.TransactionalMapPutEncodeRequest(tmp.name, tmp.txn.txnID, threadID, keyData, valueData,
+		ttlInMilliSeconds)
+	responseMessage, err := tmp.invoke(request)
+	return tmp.decodeToObjectAndError(responseMessage, err, proto.TransactionalMapPutDecodeResponse)
+}
+
+func (tmp *transactionalMapProxy) Set(key interface{}, value interface{}) (err error) {
+	keyData, valueData, err := tmp.validateAndSerialize2(key, value)
+	if err != nil {
+		return err
+	}
+	request := proto.TransactionalMapSetEncodeRequest(tmp.name, tmp.txn.txnID, threadID, keyData, valueData)
+	_, err = tmp.invoke(request)
+	return err
+}
+
+func (tmp *transactionalMapProxy) PutIfAbsent(key interface{}, value interface{}) (oldValue interface{}, err error) {
+	keyData, valueData, err := tmp.validateAndSerialize2(key, value)
+	if err != nil {
+		return nil, err
+	}
+	request := proto.TransactionalMapPutIfAbsentEncodeRequest(tmp.name, tmp.txn.txnID, threadID, keyData, valueData)
+	responseMessage, err := tmp.invoke(request)
+	return tmp.decodeToObjectAndError(responseMessage, err, proto.TransactionalMapPutIfAbsentDecodeResponse)
+}
+
+func (tmp *transactionalMapProxy) Replace(key interface{}, value interface{}) (oldValue interface{}, err error) {
+	keyData, valueData, err := tmp.validateAndSerialize2(key, value)
+	if err != nil {
+		return nil, err
+	}
+	request := proto.TransactionalMapReplaceEncodeRequest(tmp.name, tmp.txn.txnID, threadID, keyData, valueData)
+	responseMessage, err := tmp.invoke(request)
+	return tmp.decodeToObjectAndError(responseMessage, err, proto.TransactionalMapReplaceDecodeResponse)
+}
+
+func (tmp *transactionalMapProxy) ReplaceIfSame(key interface{}, oldValue interface{},
+	newValue interface{}) (replaced bool, err error) {
+	keyData, oldValueData, newValueData, err := tmp.validateAndSerialize3(key, oldValue, newValue)
+	if err != nil {
+		return false, err
+	}
+	request := proto.TransactionalMapReplaceIfSameEncodeRequest(tmp.name, tmp.txn.txnID, threadID, keyData,
+		oldValueData, newValueData)
+	responseMessage, err := tmp.invoke(request)
+	return tmp.decodeToBoolAndError(responseMessage, err, proto.TransactionalMapReplaceIfSameDecodeResponse)
+}
+
+func (tmp *transactionalMapProxy) Remove(key interface{}) (value interface{}, err error) {
+	keyData, err := tmp.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	request := proto.TransactionalMapRemoveEncodeRequest(tmp.name, tmp.txn.txnID, threadID, keyData)
+	responseMessage, err := tmp.invoke(request)
+	return tmp.decodeToObjectAndError(responseMessage, err, proto.TransactionalMapRemoveDecodeResponse)
+}
+
+func (tmp *transactionalMapProxy) RemoveIfSame(key interface{}, value interface{}) (removed bool, err error) {
+	keyData, valueData, err := tmp.validateAndSerialize2(key, value)
+	if err != nil {
+		return false, err
+	}
+	request := proto.TransactionalMapRemoveIfSameEncodeRequest(tmp.name, tmp.txn.txnID, threadID, keyData, valueData)
+	responseMessage, err := tmp.invoke(request)
+	return tmp.decodeToBoolAndError(responseMessage, err, proto.TransactionalMapRemoveIfSameDecodeResponse)
+}
+
+func (tmp *transactionalMapProxy) Delete(key interface{}) (err error) {
+	keyData, err := tmp.validateAndSerialize(key)
+	if err != nil {
+		return err
+	}
+	request := proto.TransactionalMapDeleteEncodeRequest(tmp.name, tmp.txn.txnID, threadID, keyData)
+	_, err = tmp.invoke(request)
+	return err
+}
+
+func (tmp *transactionalMapProxy) KeySet() (keySet []interface{}, err error) {
+	request := proto.TransactionalMapKeySetEncodeRequest(tmp.name, tmp.txn.txnID, threadID)
+	responseMessage, err := tmp.invoke(request)
+	return tmp.decodeToInterfaceSliceAndError(responseMessage, err, proto.TransactionalMapKeySetDecodeResponse)
+}
+
+func (tmp *transactionalMapProxy) KeySetWithPredicate(predicate interface{}) (keySet []interface{}, err error) {
+	if _, ok := asPagingPredicate(predicate); ok {
+		return nil, core.NewHazelcastIllegalArgumentError("paging predicates are not supported by transactional maps", nil)
+	}
+	predicateData, err := tmp.validateAndSerializePredicate(predicate)
+	if err != nil {
+		return nil, err
+	}
+	request := proto.TransactionalMapKeySetWithPredicateEncodeRequest(tmp.name, tmp.txn.txnID, threadID, predicateData)
+	responseMessage, err := tmp.invoke(request)
+	return tmp.decodeToInterfaceSliceAndError(responseMessage, err,
+		proto.TransactionalMapKeySetWithPredicateDecodeResponse)
+}
+
+func (tmp *transactionalMapProxy) Values() (values []interface{}, err error) {
+	request := proto.TransactionalMapValuesEncodeRequest(tmp.name, tmp.txn.txnID, threadID)
+	responseMessage, err := tmp.invoke(request)
+	return tmp.decodeToInterfaceSliceAndError(responseMessage, err, proto.TransactionalMapValuesDecodeResponse)
+}
+
+func (tmp *transactionalMapProxy) ValuesWithPredicate(predicate interface{}) (values []interface{}, err error) {
+	if _, ok := asPagingPredicate(predicate); ok {
+		return nil, core.NewHazelcastIllegalArgumentError("paging predicates are not supported by transactional maps", nil)
+	}
+	predicateData, err := tmp.validateAndSerializePredicate(predicate)
+	if err != nil {
+		return nil, err
+	}
+	request := proto.TransactionalMapValuesWithPredicateEncodeRequest(tmp.name, tmp.txn.txnID, threadID, predicateData)
+	responseMessage, err := tmp.invoke(request)
+	return tmp.decodeToInterfaceSliceAndError(responseMessage, err,
+		proto.TransactionalMapValuesWithPredicateDecodeResponse)
+}
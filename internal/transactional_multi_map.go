@@ -0,0 +1,80 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+type transactionalMultiMapProxy struct {
+	*transactionalProxy
+}
+
+func (tmmp *transactionalMultiMapProxy) Put(key interface{}, value interface{}) (increased bool, err error) {
+	keyData, valueData, err := tmmp.validateAndSerialize2(key, value)
+	if err != nil {
+		return false, err
+	}
+	request := proto.TransactionalMultiMapPutEncodeRequest(tmmp.name, tmmp.txn.txnID, threadID, keyData, valueData)
+	responseMessage, err := tmmp.invoke(request)
+	return tmmp.decodeToBoolAndError(responseMessage, err, proto.TransactionalMultiMapPutDecodeResponse)
+}
+
+func (tmmp *transactionalMultiMapProxy) Get(key interface{}) (values []interface{}, err error) {
+	keyData, err := tmmp.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	request := proto.TransactionalMultiMapGetEncodeRequest(tmmp.name, tmmp.txn.txnID, threadID, keyData)
+	responseMessage, err := tmmp.invoke(request)
+	return tmmp.decodeToInterfaceSliceAndError(responseMessage, err, proto.TransactionalMultiMapGetDecodeResponse)
+}
+
+func (tmmp *transactionalMultiMapProxy) Remove(key interface{}, value interface{}) (removed bool, err error) {
+	keyData, valueData, err := tmmp.validateAndSerialize2(key, value)
+	if err != nil {
+		return false, err
+	}
+	request := proto.TransactionalMultiMapRemoveEntryEncodeRequest(tmmp.name, tmmp.txn.txnID, threadID, keyData,
+		valueData)
+	responseMessage, err := tmmp.invoke(request)
+	return tmmp.decodeToBoolAndError(responseMessage, err, proto.TransactionalMultiMapRemoveEntryDecodeResponse)
+}
+
+func (tmmp *transactionalMultiMapProxy) RemoveAll(key interface{}) (oldValues []interface{}, err error) {
+	keyData, err := tmmp.validateAndSerialize(key)
+	if err != nil {
+		return nil, err
+	}
+	request := proto.TransactionalMultiMapRemoveEncodeRequest(tmmp.name, tmmp.txn.txnID, threadID, keyData)
+	responseMessage, err := tmmp.invoke(request)
+	return tmmp.decodeToInterfaceSliceAndError(responseMessage, err, proto.TransactionalMultiMapRemoveDecodeResponse)
+}
+
+func (tmmp *transactionalMultiMapProxy) ValueCount(key interface{}) (valueCount int32, err error) {
+	keyData, err := tmmp.validateAndSerialize(key)
+	if err != nil {
+		return 0, err
+	}
+	request := proto.TransactionalMultiMapValueCountEncodeRequest(tmmp.name, tmmp.txn.txnID, threadID, keyData)
+	responseMessage, err := tmmp.invoke(request)
+	return tmmp.decodeToInt32AndError(responseMessage, err, proto.TransactionalMultiMapValueCountDecodeResponse)
+}
+
+func (tmmp *transactionalMultiMapProxy) Size() (size int32, err error) {
+	request := proto.TransactionalMultiMapSizeEncodeRequest(tmmp.name, tmmp.txn.txnID, threadID)
+	responseMessage, err := tmmp.invoke(request)
+	return tmmp.decodeToInt32AndError(responseMessage, err, proto.TransactionalMultiMapSizeDecodeResponse)
+}
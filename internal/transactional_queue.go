@@ -0,0 +1,76 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/timeutil"
+)
+
+type transactionalQueueProxy struct {
+	*transactionalProxy
+}
+
+func (tqp *transactionalQueueProxy) Offer(item interface{}) (added bool, err error) {
+	return tqp.OfferWithTimeout(item, 0)
+}
+
+func (tqp *transactionalQueueProxy) OfferWithTimeout(item interface{}, timeout time.Duration) (added bool, err error) {
+	itemData, err := tqp.validateAndSerialize(item)
+	if err != nil {
+		return false, err
+	}
+	timeoutInMilliSeconds := timeutil.GetTimeInMilliSeconds(timeout)
+	request := proto.TransactionalQueueOfferEncodeRequest(tqp.name, tqp.txn.txnID, threadID, itemData,
+		timeoutInMilliSeconds)
+	responseMessage, err := tqp.invoke(request)
+	return tqp.decodeToBoolAndError(responseMessage, err, proto.TransactionalQueueOfferDecodeResponse)
+}
+
+func (tqp *transactionalQueueProxy) Take() (item interface{}, err error) {
+	request := proto.TransactionalQueueTakeEncodeRequest(tqp.name, tqp.txn.txnID, threadID)
+	responseMessage, err := tqp.invoke(request)
+	return tqp.decodeToObjectAndError(responseMessage, err, proto.TransactionalQueueTakeDecodeResponse)
+}
+
+func (tqp *transactionalQueueProxy) Poll() (item interface{}, err error) {
+	return tqp.PollWithTimeout(0)
+}
+
+func (tqp *transactionalQueueProxy) PollWithTimeout(timeout time.Duration) (item interface{}, err error) {
+	timeoutInMilliSeconds := timeutil.GetTimeInMilliSeconds(timeout)
+	request := proto.TransactionalQueuePollEncodeRequest(tqp.name, tqp.txn.txnID, threadID, timeoutInMilliSeconds)
+	responseMessage, err := tqp.invoke(request)
+	return tqp.decodeToObjectAndError(responseMessage, err, proto.TransactionalQueuePollDecodeResponse)
+}
+
+func (tqp *transactionalQueueProxy) Peek() (item interface{}, err error) {
+	return tqp.PeekWithTimeout(0)
+}
+
+func (tqp *transactionalQueueProxy) PeekWithTimeout(timeout time.Duration) (item interface{}, err error) {
+	timeoutInMilliSeconds := timeutil.GetTimeInMilliSeconds(timeout)
+	request := proto.TransactionalQueuePeekEncodeRequest(tqp.name, tqp.txn.txnID, threadID, timeoutInMilliSeconds)
+	responseMessage, err := tqp.invoke(request)
+	return tqp.decodeToObjectAndError(responseMessage, err, proto.TransactionalQueuePeekDecodeResponse)
+}
+
+func (tqp *transactionalQueueProxy) Size() (size int32, err error) {
+	request := proto.TransactionalQueueSizeEncodeRequest(tqp.name, tqp.txn.txnID, threadID)
+	responseMessage, err := tqp.invoke(request)
+	return tqp.decodeToInt32AndError(responseMessage, err, proto.TransactionalQueueSizeDecodeResponse)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+)
+
+type transactionalSetProxy struct {
+	*transactionalProxy
+}
+
+func (tsp *transactionalSetProxy) Add(item interface{}) (added bool, err error) {
+	itemData, err := tsp.validateAndSerialize(item)
+	if err != nil {
+		return false, err
+	}
+	request := proto.TransactionalSetAddEncodeRequest(tsp.name, tsp.txn.txnID, threadID, itemData)
+	responseMessage, err := tsp.invoke(request)
+	return tsp.decodeToBoolAndError(responseMessage, err, proto.TransactionalSetAddDecodeResponse)
+}
+
+func (tsp *transactionalSetProxy) Remove(item interface{}) (removed bool, err error) {
+	itemData, err := tsp.validateAndSerialize(item)
+	if err != nil {
+		return false, err
+	}
+	request := proto.TransactionalSetRemoveEncodeRequest(tsp.name, tsp.txn.txnID, threadID, itemData)
+	responseMessage, err := tsp.invoke(request)
+	return tsp.decodeToBoolAndError(responseMessage, err, proto.TransactionalSetRemoveDecodeResponse)
+}
+
+func (tsp *transactionalSetProxy) Size() (size int32, err error) {
+	request := proto.TransactionalSetSizeEncodeRequest(tsp.name, tsp.txn.txnID, threadID)
+	responseMessage, err := tsp.invoke(request)
+	return tsp.decodeToInt32AndError(responseMessage, err, proto.TransactionalSetSizeDecodeResponse)
+}
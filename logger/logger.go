@@ -0,0 +1,149 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package logger contains the logging API of the client and its default implementation.
+package logger
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+)
+
+const (
+	// OffLevel disables logging.
+	OffLevel = "off"
+	// ErrorLevel level. Logs only the errors.
+	ErrorLevel = "error"
+	// WarnLevel level. Logs the warnings and the errors.
+	WarnLevel = "warn"
+	// InfoLevel level. Logs the general information about the client, such as lifecycle changes.
+	InfoLevel = "info"
+	// DebugLevel level. Logs the detailed information that is useful for debugging.
+	DebugLevel = "debug"
+	// TraceLevel level. Logs the finest grained information, such as every heartbeat.
+	TraceLevel = "trace"
+)
+
+const (
+	offLevel = iota * 100
+	errorLevel
+	warnLevel
+	infoLevel
+	debugLevel
+	traceLevel
+)
+
+var nameToLevel = map[string]int{
+	OffLevel:   offLevel,
+	ErrorLevel: errorLevel,
+	WarnLevel:  warnLevel,
+	InfoLevel:  infoLevel,
+	DebugLevel: debugLevel,
+	TraceLevel: traceLevel,
+}
+
+// Logger is the interface that the client uses for logging.
+// Implementations should be safe for concurrent use.
+type Logger interface {
+	// Trace logs the given arguments at trace level.
+	Trace(args ...interface{})
+
+	// Debug logs the given arguments at debug level.
+	Debug(args ...interface{})
+
+	// Info logs the given arguments at info level.
+	Info(args ...interface{})
+
+	// Warn logs the given arguments at warn level.
+	Warn(args ...interface{})
+
+	// Error logs the given arguments at error level.
+	Error(args ...interface{})
+}
+
+// DefaultLogger is the default Logger of the client. It writes to the standard error.
+type DefaultLogger struct {
+	logger *log.Logger
+	level  int
+}
+
+// New returns a DefaultLogger with info level.
+func New() *DefaultLogger {
+	defaultLogger, _ := NewWithLevel(InfoLevel)
+	return defaultLogger
+}
+
+// NewWithLevel returns a DefaultLogger with the given level, which should be one of
+// OffLevel, ErrorLevel, WarnLevel, InfoLevel, DebugLevel or TraceLevel.
+// NewWithLevel returns an error if the level is not one of them.
+func NewWithLevel(levelName string) (*DefaultLogger, error) {
+	level, err := GetLogLevel(levelName)
+	if err != nil {
+		return nil, err
+	}
+	return &DefaultLogger{
+		logger: log.New(os.Stderr, "", log.LstdFlags),
+		level:  level,
+	}, nil
+}
+
+// GetLogLevel returns the numeric value of the given level name.
+// GetLogLevel returns an error if the level name is not known.
+func GetLogLevel(levelName string) (int, error) {
+	level, found := nameToLevel[strings.ToLower(levelName)]
+	if !found {
+		return 0, fmt.Errorf("no log level found for %s", levelName)
+	}
+	return level, nil
+}
+
+// SetOutput sets the destination of the logger.
+func (l *DefaultLogger) SetOutput(w io.Writer) {
+	l.logger.SetOutput(w)
+}
+
+// Trace logs the given arguments at trace level.
+func (l *DefaultLogger) Trace(args ...interface{}) {
+	l.log(traceLevel, "TRACE", args...)
+}
+
+// Debug logs the given arguments at debug level.
+func (l *DefaultLogger) Debug(args ...interface{}) {
+	l.log(debugLevel, "DEBUG", args...)
+}
+
+// Info logs the given arguments at info level.
+func (l *DefaultLogger) Info(args ...interface{}) {
+	l.log(infoLevel, "INFO", args...)
+}
+
+// Warn logs the given arguments at warn level.
+func (l *DefaultLogger) Warn(args ...interface{}) {
+	l.log(warnLevel, "WARN", args...)
+}
+
+// Error logs the given arguments at error level.
+func (l *DefaultLogger) Error(args ...interface{}) {
+	l.log(errorLevel, "ERROR", args...)
+}
+
+func (l *DefaultLogger) log(level int, levelName string, args ...interface{}) {
+	if l.level < level {
+		return
+	}
+	l.logger.Print(levelName + ": " + fmt.Sprint(args...))
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestDefaultLoggerLevelFiltering(t *testing.T) {
+	l, err := NewWithLevel(WarnLevel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := &bytes.Buffer{}
+	l.SetOutput(buf)
+	l.Trace("trace message")
+	l.Debug("debug message")
+	l.Info("info message")
+	l.Warn("warn message")
+	l.Error("error message")
+	output := buf.String()
+	for _, filtered := range []string{"trace message", "debug message", "info message"} {
+		if strings.Contains(output, filtered) {
+			t.Errorf("%q should not be logged at warn level", filtered)
+		}
+	}
+	if !strings.Contains(output, "WARN: warn message") {
+		t.Error("warn message should be logged at warn level")
+	}
+	if !strings.Contains(output, "ERROR: error message") {
+		t.Error("error message should be logged at warn level")
+	}
+}
+
+func TestDefaultLoggerJoinsArgumentsWithoutExtraSpaces(t *testing.T) {
+	l, _ := NewWithLevel(InfoLevel)
+	buf := &bytes.Buffer{}
+	l.SetOutput(buf)
+	l.Warn("Ignoring the address ", "1.2.3.4", " from the address provider")
+	if !strings.Contains(buf.String(), "WARN: Ignoring the address 1.2.3.4 from the address provider\n") {
+		t.Errorf("unexpected log output %q", buf.String())
+	}
+}
+
+func TestDefaultLoggerOffLevel(t *testing.T) {
+	l, _ := NewWithLevel(OffLevel)
+	buf := &bytes.Buffer{}
+	l.SetOutput(buf)
+	l.Error("error message")
+	if buf.Len() != 0 {
+		t.Errorf("nothing should be logged at off level, got %q", buf.String())
+	}
+}
+
+func TestNewWithInvalidLevel(t *testing.T) {
+	if _, err := NewWithLevel("verbose"); err == nil {
+		t.Error("NewWithLevel should return an error for an unknown level")
+	}
+}
+
+func TestGetLogLevelIsCaseInsensitive(t *testing.T) {
+	level, err := GetLogLevel("DEBUG")
+	if err != nil || level != debugLevel {
+		t.Errorf("expected debug level, got %d, %v", level, err)
+	}
+}
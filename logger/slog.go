@@ -0,0 +1,71 @@
+//go:build go1.21
+// +build go1.21
+
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// LevelTrace is the slog level that the trace logs are written with, since slog does not define one.
+const LevelTrace = slog.LevelDebug - 4
+
+// SlogLogger is a Logger that writes to a log/slog Logger.
+type SlogLogger struct {
+	logger *slog.Logger
+}
+
+// NewSlogLogger returns a Logger that writes to the given slog Logger.
+// The levels are filtered by the handler of the slog Logger.
+func NewSlogLogger(logger *slog.Logger) *SlogLogger {
+	return &SlogLogger{logger: logger}
+}
+
+// Trace logs the given arguments at LevelTrace.
+func (l *SlogLogger) Trace(args ...interface{}) {
+	l.log(LevelTrace, args...)
+}
+
+// Debug logs the given arguments at slog.LevelDebug.
+func (l *SlogLogger) Debug(args ...interface{}) {
+	l.log(slog.LevelDebug, args...)
+}
+
+// Info logs the given arguments at slog.LevelInfo.
+func (l *SlogLogger) Info(args ...interface{}) {
+	l.log(slog.LevelInfo, args...)
+}
+
+// Warn logs the given arguments at slog.LevelWarn.
+func (l *SlogLogger) Warn(args ...interface{}) {
+	l.log(slog.LevelWarn, args...)
+}
+
+// Error logs the given arguments at slog.LevelError.
+func (l *SlogLogger) Error(args ...interface{}) {
+	l.log(slog.LevelError, args...)
+}
+
+func (l *SlogLogger) log(level slog.Level, args ...interface{}) {
+	ctx := context.Background()
+	if !l.logger.Enabled(ctx, level) {
+		return
+	}
+	l.logger.Log(ctx, level, fmt.Sprint(args...))
+}
@@ -0,0 +1,100 @@
+//go:build go1.21
+// +build go1.21
+
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+)
+
+type logRecord struct {
+	level   slog.Level
+	message string
+}
+
+// recordingHandler is a slog.Handler that records the level and the message of the records
+// at or above its minimum level.
+type recordingHandler struct {
+	minLevel slog.Level
+	records  []logRecord
+}
+
+func (h *recordingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.minLevel
+}
+
+func (h *recordingHandler) Handle(ctx context.Context, record slog.Record) error {
+	h.records = append(h.records, logRecord{level: record.Level, message: record.Message})
+	return nil
+}
+
+func (h *recordingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return h
+}
+
+func (h *recordingHandler) WithGroup(name string) slog.Handler {
+	return h
+}
+
+func TestSlogLoggerLevels(t *testing.T) {
+	handler := &recordingHandler{minLevel: LevelTrace}
+	l := NewSlogLogger(slog.New(handler))
+	l.Trace("trace message")
+	l.Debug("debug message")
+	l.Info("info message")
+	l.Warn("warn message")
+	l.Error("error message")
+	expected := []logRecord{
+		{LevelTrace, "trace message"},
+		{slog.LevelDebug, "debug message"},
+		{slog.LevelInfo, "info message"},
+		{slog.LevelWarn, "warn message"},
+		{slog.LevelError, "error message"},
+	}
+	if len(handler.records) != len(expected) {
+		t.Fatalf("expected %d records, got %v", len(expected), handler.records)
+	}
+	for i, record := range handler.records {
+		if record != expected[i] {
+			t.Errorf("expected %v, got %v", expected[i], record)
+		}
+	}
+}
+
+func TestSlogLoggerLevelFiltering(t *testing.T) {
+	handler := &recordingHandler{minLevel: slog.LevelWarn}
+	l := NewSlogLogger(slog.New(handler))
+	l.Trace("trace message")
+	l.Debug("debug message")
+	l.Info("info message")
+	l.Warn("warn message")
+	if len(handler.records) != 1 || handler.records[0].level != slog.LevelWarn {
+		t.Errorf("only the warn message should be logged, got %v", handler.records)
+	}
+}
+
+func TestSlogLoggerJoinsArgumentsWithoutExtraSpaces(t *testing.T) {
+	handler := &recordingHandler{minLevel: slog.LevelInfo}
+	l := NewSlogLogger(slog.New(handler))
+	l.Warn("Ignoring the address ", "1.2.3.4", " from the address provider")
+	expected := "Ignoring the address 1.2.3.4 from the address provider"
+	if len(handler.records) != 1 || handler.records[0].message != expected {
+		t.Errorf("expected message %q, got %v", expected, handler.records)
+	}
+}
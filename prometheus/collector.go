@@ -0,0 +1,128 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package prometheus exposes the statistics of a Hazelcast client as Prometheus metrics.
+//
+// The collector takes a snapshot of the statistics whenever it is scraped:
+//
+//	client, _ := hazelcast.NewClient()
+//	prometheus.MustRegister(hzprometheus.NewCollector(client))
+//
+// Since the metrics of a collector do not carry a client label, use prometheus.WrapRegistererWith
+// to register the collectors of several clients to the same registry.
+package prometheus
+
+import (
+	"strconv"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+)
+
+const namespace = "hazelcast_client"
+
+// StatisticsSource is implemented by hazelcast.Instance.
+type StatisticsSource interface {
+	Statistics() core.Statistics
+}
+
+var (
+	connectionLabels = []string{"connection_id", "address", "owner"}
+
+	bytesSentDesc = prom.NewDesc(namespace+"_connection_bytes_sent_total",
+		"Number of bytes written to the connection.", connectionLabels, nil)
+	bytesReceivedDesc = prom.NewDesc(namespace+"_connection_bytes_received_total",
+		"Number of bytes read from the connection.", connectionLabels, nil)
+	messagesSentDesc = prom.NewDesc(namespace+"_connection_messages_sent_total",
+		"Number of messages written to the connection.", connectionLabels, nil)
+	messagesReceivedDesc = prom.NewDesc(namespace+"_connection_messages_received_total",
+		"Number of messages read from the connection.", connectionLabels, nil)
+	pendingInvocationsDesc = prom.NewDesc(namespace+"_pending_invocations",
+		"Number of invocations waiting for a response.", nil, nil)
+	invocationRetriesDesc = prom.NewDesc(namespace+"_invocation_retries_total",
+		"Number of times invocations were retried.", nil, nil)
+	invocationLatencyDesc = prom.NewDesc(namespace+"_invocation_latency_seconds",
+		"Latency of the invocations which got a response.", []string{"message_type"}, nil)
+	invocationLatencyMaxDesc = prom.NewDesc(namespace+"_invocation_latency_max_seconds",
+		"Highest latency of the invocations which got a response.", []string{"message_type"}, nil)
+	reconnectsDesc = prom.NewDesc(namespace+"_reconnects_total",
+		"Number of times the client reconnected to the cluster.", nil, nil)
+	heartbeatFailuresDesc = prom.NewDesc(namespace+"_heartbeat_failures_total",
+		"Number of times a connection stopped receiving heartbeats.", nil, nil)
+	pendingEventsDesc = prom.NewDesc(namespace+"_pending_events",
+		"Number of events waiting for the event listeners.", nil, nil)
+	droppedEventsDesc = prom.NewDesc(namespace+"_dropped_events_total",
+		"Number of events dropped because the event queue was full.", nil, nil)
+	mapOperationsDesc = prom.NewDesc(namespace+"_map_operations_total",
+		"Number of operations sent to the cluster for a map.", []string{"map", "message_type"}, nil)
+)
+
+type collector struct {
+	source StatisticsSource
+}
+
+// NewCollector returns a Prometheus collector of the statistics of the given source, which is usually
+// a hazelcast.Instance.
+func NewCollector(source StatisticsSource) prom.Collector {
+	return &collector{source: source}
+}
+
+func (c *collector) Describe(descs chan<- *prom.Desc) {
+	descs <- bytesSentDesc
+	descs <- bytesReceivedDesc
+	descs <- messagesSentDesc
+	descs <- messagesReceivedDesc
+	descs <- pendingInvocationsDesc
+	descs <- invocationRetriesDesc
+	descs <- invocationLatencyDesc
+	descs <- invocationLatencyMaxDesc
+	descs <- reconnectsDesc
+	descs <- heartbeatFailuresDesc
+	descs <- pendingEventsDesc
+	descs <- droppedEventsDesc
+	descs <- mapOperationsDesc
+}
+
+func (c *collector) Collect(metrics chan<- prom.Metric) {
+	stats := c.source.Statistics()
+	for _, connection := range stats.Connections {
+		labels := []string{strconv.FormatInt(connection.ConnectionID, 10), connection.Address,
+			strconv.FormatBool(connection.Owner)}
+		metrics <- prom.MustNewConstMetric(bytesSentDesc, prom.CounterValue, float64(connection.BytesSent), labels...)
+		metrics <- prom.MustNewConstMetric(bytesReceivedDesc, prom.CounterValue, float64(connection.BytesReceived),
+			labels...)
+		metrics <- prom.MustNewConstMetric(messagesSentDesc, prom.CounterValue, float64(connection.MessagesSent),
+			labels...)
+		metrics <- prom.MustNewConstMetric(messagesReceivedDesc, prom.CounterValue,
+			float64(connection.MessagesReceived), labels...)
+	}
+	metrics <- prom.MustNewConstMetric(pendingInvocationsDesc, prom.GaugeValue, float64(stats.PendingInvocations))
+	metrics <- prom.MustNewConstMetric(invocationRetriesDesc, prom.CounterValue, float64(stats.InvocationRetries))
+	for messageType, latency := range stats.InvocationLatencies {
+		metrics <- prom.MustNewConstSummary(invocationLatencyDesc, uint64(latency.Count), latency.Total.Seconds(),
+			nil, messageType)
+		metrics <- prom.MustNewConstMetric(invocationLatencyMaxDesc, prom.GaugeValue, latency.Max.Seconds(),
+			messageType)
+	}
+	metrics <- prom.MustNewConstMetric(reconnectsDesc, prom.CounterValue, float64(stats.Reconnects))
+	metrics <- prom.MustNewConstMetric(heartbeatFailuresDesc, prom.CounterValue, float64(stats.HeartbeatFailures))
+	metrics <- prom.MustNewConstMetric(pendingEventsDesc, prom.GaugeValue, float64(stats.PendingEvents))
+	metrics <- prom.MustNewConstMetric(droppedEventsDesc, prom.CounterValue, float64(stats.DroppedEvents))
+	for name, mapStats := range stats.Maps {
+		for messageType, count := range mapStats.Operations {
+			metrics <- prom.MustNewConstMetric(mapOperationsDesc, prom.CounterValue, float64(count), name, messageType)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"testing"
+	"time"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+)
+
+type staticSource core.Statistics
+
+func (s staticSource) Statistics() core.Statistics {
+	return core.Statistics(s)
+}
+
+func TestCollector(t *testing.T) {
+	source := staticSource{
+		Connections: []core.ConnectionStatistics{
+			{ConnectionID: 1, Address: "127.0.0.1:5701", Owner: true, BytesSent: 100, BytesReceived: 200,
+				MessagesSent: 3, MessagesReceived: 4},
+		},
+		PendingInvocations: 2,
+		InvocationRetries:  5,
+		InvocationLatencies: map[string]core.LatencyStatistics{
+			"MapGet": {Count: 4, Total: 2 * time.Second, Max: time.Second},
+		},
+		Reconnects:        1,
+		HeartbeatFailures: 6,
+		PendingEvents:     8,
+		DroppedEvents:     9,
+		Maps: map[string]core.MapStatistics{
+			"users": {Operations: map[string]int64{"MapPut": 7}},
+		},
+	}
+	registry := prom.NewRegistry()
+	registry.MustRegister(NewCollector(source))
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := make(map[string]float64)
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			switch {
+			case metric.GetCounter() != nil:
+				values[family.GetName()] = metric.GetCounter().GetValue()
+			case metric.GetGauge() != nil:
+				values[family.GetName()] = metric.GetGauge().GetValue()
+			case metric.GetSummary() != nil:
+				values[family.GetName()] = metric.GetSummary().GetSampleSum()
+			}
+		}
+	}
+	expected := map[string]float64{
+		"hazelcast_client_connection_bytes_sent_total":        100,
+		"hazelcast_client_connection_bytes_received_total":    200,
+		"hazelcast_client_connection_messages_sent_total":     3,
+		"hazelcast_client_connection_messages_received_total": 4,
+		"hazelcast_client_pending_invocations":                2,
+		"hazelcast_client_invocation_retries_total":           5,
+		"hazelcast_client_invocation_latency_seconds":         2,
+		"hazelcast_client_invocation_latency_max_seconds":     1,
+		"hazelcast_client_reconnects_total":                   1,
+		"hazelcast_client_heartbeat_failures_total":           6,
+		"hazelcast_client_map_operations_total":               7,
+		"hazelcast_client_pending_events":                     8,
+		"hazelcast_client_dropped_events_total":               9,
+	}
+	for name, value := range expected {
+		if actual, found := values[name]; !found || actual != value {
+			t.Errorf("expected %s to be %v, got %v", name, value, actual)
+		}
+	}
+}
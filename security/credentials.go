@@ -0,0 +1,157 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package security contains the credentials that the client authenticates to the cluster with.
+package security
+
+import (
+	"errors"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+const (
+	// usernamePasswordCredentialsFactoryID and usernamePasswordCredentialsClassID match
+	// com.hazelcast.security.UsernamePasswordCredentials on the members.
+	usernamePasswordCredentialsFactoryID = -1
+	usernamePasswordCredentialsClassID   = 1
+)
+
+// ErrInvalidCredentials is the cause of the HazelcastAuthenticationError that is returned when the cluster
+// rejects the credentials of the client.
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// Credentials are sent to the cluster to authenticate the client.
+// They are serialized with the serialization service of the client, so an implementation should be a
+// Portable, an IdentifiedDataSerializable or have a custom serializer, and the members should be able
+// to deserialize it.
+type Credentials interface {
+	// Principal returns the identity of the client, e.g. a username.
+	Principal() string
+}
+
+// CredentialsFactory creates the credentials for the connections of the client.
+// It is called for every authentication, so that it can return a fresh token when the previous one expired.
+type CredentialsFactory interface {
+	// NewCredentials returns the credentials to authenticate a connection to the member at the given address.
+	NewCredentials(address core.Address) (Credentials, error)
+}
+
+type staticCredentialsFactory struct {
+	credentials Credentials
+}
+
+// NewStaticCredentialsFactory returns a CredentialsFactory which always returns the given credentials.
+func NewStaticCredentialsFactory(credentials Credentials) CredentialsFactory {
+	return &staticCredentialsFactory{credentials: credentials}
+}
+
+func (f *staticCredentialsFactory) NewCredentials(address core.Address) (Credentials, error) {
+	return f.credentials, nil
+}
+
+// UsernamePasswordCredentials are authenticated by the login modules of the cluster, or compared with
+// the group name and password of the cluster if the security is not enabled.
+type UsernamePasswordCredentials struct {
+	username string
+	password []byte
+}
+
+// NewUsernamePasswordCredentials returns UsernamePasswordCredentials with the given username and password.
+func NewUsernamePasswordCredentials(username string, password string) *UsernamePasswordCredentials {
+	return &UsernamePasswordCredentials{username: username, password: []byte(password)}
+}
+
+// Principal returns the username.
+func (c *UsernamePasswordCredentials) Principal() string {
+	return c.username
+}
+
+// Password returns the password.
+func (c *UsernamePasswordCredentials) Password() string {
+	return string(c.password)
+}
+
+func (c *UsernamePasswordCredentials) FactoryID() int32 {
+	return usernamePasswordCredentialsFactoryID
+}
+
+func (c *UsernamePasswordCredentials) ClassID() int32 {
+	return usernamePasswordCredentialsClassID
+}
+
+func (c *UsernamePasswordCredentials) WritePortable(writer serialization.PortableWriter) error {
+	writer.WriteUTF("principal", c.username)
+	// The endpoint is set by the member that receives the credentials.
+	writer.WriteUTF("endpoint", "")
+	writer.WriteByteArray("pwd", c.password)
+	return nil
+}
+
+func (c *UsernamePasswordCredentials) ReadPortable(reader serialization.PortableReader) error {
+	var err error
+	if c.username, err = reader.ReadUTF("principal"); err != nil {
+		return err
+	}
+	if _, err = reader.ReadUTF("endpoint"); err != nil {
+		return err
+	}
+	c.password, err = reader.ReadByteArray("pwd")
+	return err
+}
+
+// TokenCredentials carry an opaque token, e.g. a JWT or a Kerberos ticket.
+// The members should have a DataSerializableFactory registered with the factory ID of the credentials,
+// which creates a class that reads the token as a byte array.
+type TokenCredentials struct {
+	token     []byte
+	factoryID int32
+	classID   int32
+}
+
+// NewTokenCredentials returns TokenCredentials with the given token which are serialized
+// as an IdentifiedDataSerializable with the given factory ID and class ID.
+func NewTokenCredentials(token []byte, factoryID int32, classID int32) *TokenCredentials {
+	return &TokenCredentials{token: token, factoryID: factoryID, classID: classID}
+}
+
+// Principal returns "<token>", since the identity is only known after the token is validated by the cluster.
+func (c *TokenCredentials) Principal() string {
+	return "<token>"
+}
+
+// Token returns the token.
+func (c *TokenCredentials) Token() []byte {
+	return c.token
+}
+
+func (c *TokenCredentials) FactoryID() int32 {
+	return c.factoryID
+}
+
+func (c *TokenCredentials) ClassID() int32 {
+	return c.classID
+}
+
+func (c *TokenCredentials) WriteData(output serialization.DataOutput) error {
+	output.WriteByteArray(c.token)
+	return nil
+}
+
+func (c *TokenCredentials) ReadData(input serialization.DataInput) error {
+	var err error
+	c.token, err = input.ReadByteArray()
+	return err
+}
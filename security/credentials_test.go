@@ -0,0 +1,82 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package security_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client/config"
+	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
+	"github.com/hazelcast/hazelcast-go-client/security"
+	api "github.com/hazelcast/hazelcast-go-client/serialization"
+)
+
+type credentialsPortableFactory struct{}
+
+func (credentialsPortableFactory) Create(classID int32) api.Portable {
+	return &security.UsernamePasswordCredentials{}
+}
+
+type credentialsDataSerializableFactory struct{}
+
+func (credentialsDataSerializableFactory) Create(id int32) api.IdentifiedDataSerializable {
+	return security.NewTokenCredentials(nil, 7, id)
+}
+
+func TestUsernamePasswordCredentialsSerialization(t *testing.T) {
+	serializationConfig := config.NewSerializationConfig()
+	serializationConfig.AddPortableFactory(-1, credentialsPortableFactory{})
+	service, _ := serialization.NewSerializationService(serializationConfig)
+	data, err := service.ToData(security.NewUsernamePasswordCredentials("user", "secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	object, err := service.ToObject(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	credentials := object.(*security.UsernamePasswordCredentials)
+	if credentials.Principal() != "user" || credentials.Password() != "secret" {
+		t.Fatalf("unexpected credentials %s/%s", credentials.Principal(), credentials.Password())
+	}
+}
+
+func TestTokenCredentialsSerialization(t *testing.T) {
+	serializationConfig := config.NewSerializationConfig()
+	serializationConfig.AddDataSerializableFactory(7, credentialsDataSerializableFactory{})
+	service, _ := serialization.NewSerializationService(serializationConfig)
+	token := []byte("token")
+	data, err := service.ToData(security.NewTokenCredentials(token, 7, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	object, err := service.ToObject(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	credentials := object.(*security.TokenCredentials)
+	if !bytes.Equal(credentials.Token(), token) || credentials.ClassID() != 3 {
+		t.Fatalf("unexpected credentials %v", credentials)
+	}
+}
+
+func TestStaticCredentialsFactory(t *testing.T) {
+	credentials := security.NewUsernamePasswordCredentials("user", "secret")
+	factory := security.NewStaticCredentialsFactory(credentials)
+	if actual, err := factory.NewCredentials(nil); err != nil || actual != credentials {
+		t.Fatalf("expected the static credentials, got %v, %v", actual, err)
+	}
+}
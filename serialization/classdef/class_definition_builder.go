@@ -9,6 +9,30 @@ import (
 	"github.com/hazelcast/hazelcast-go-client/serialization"
 )
 
+// The field types, as returned by serialization.FieldDefinition.Type.
+const (
+	TypePortable      = classdef.TypePortable
+	TypeByte          = classdef.TypeByte
+	TypeBool          = classdef.TypeBool
+	TypeUint16        = classdef.TypeUint16
+	TypeInt16         = classdef.TypeInt16
+	TypeInt32         = classdef.TypeInt32
+	TypeInt64         = classdef.TypeInt64
+	TypeFloat32       = classdef.TypeFloat32
+	TypeFloat64       = classdef.TypeFloat64
+	TypeUTF           = classdef.TypeUTF
+	TypePortableArray = classdef.TypePortableArray
+	TypeByteArray     = classdef.TypeByteArray
+	TypeBoolArray     = classdef.TypeBoolArray
+	TypeUint16Array   = classdef.TypeUint16Array
+	TypeInt16Array    = classdef.TypeInt16Array
+	TypeInt32Array    = classdef.TypeInt32Array
+	TypeInt64Array    = classdef.TypeInt64Array
+	TypeFloat32Array  = classdef.TypeFloat32Array
+	TypeFloat64Array  = classdef.TypeFloat64Array
+	TypeUTFArray      = classdef.TypeUTFArray
+)
+
 // ClassDefinitionBuilder is used to build and register class definitions manually.
 type ClassDefinitionBuilder struct {
 	factoryID        int32
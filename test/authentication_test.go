@@ -0,0 +1,54 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/security"
+	"github.com/hazelcast/hazelcast-go-client/test/assert"
+)
+
+func TestAuthenticationWithCustomCredentials(t *testing.T) {
+	cluster, _ = remoteController.CreateCluster("", DefaultServerConfig)
+	defer remoteController.ShutdownCluster(cluster.ID)
+	remoteController.StartMember(cluster.ID)
+	config := hazelcast.NewConfig()
+	config.SetCredentials(security.NewUsernamePasswordCredentials(config.GroupConfig().Name(),
+		config.GroupConfig().Password()))
+	client, err := hazelcast.NewClientWithConfig(config)
+	assert.ErrorNil(t, err)
+	defer client.Shutdown()
+	mp, _ := client.GetMap("authenticationMap")
+	_, err = mp.Put("key", "value")
+	assert.ErrorNil(t, err)
+}
+
+func TestAuthenticationWithInvalidCustomCredentials(t *testing.T) {
+	cluster, _ = remoteController.CreateCluster("", DefaultServerConfig)
+	defer remoteController.ShutdownCluster(cluster.ID)
+	remoteController.StartMember(cluster.ID)
+	config := hazelcast.NewConfig()
+	config.SetCredentials(security.NewUsernamePasswordCredentials(config.GroupConfig().Name(), "invalid"))
+	_, err := hazelcast.NewClientWithConfig(config)
+	authenticationError, ok := err.(*core.HazelcastAuthenticationError)
+	if !ok {
+		t.Fatalf("expected HazelcastAuthenticationError, got %v", err)
+	}
+	assert.Equalf(t, nil, security.ErrInvalidCredentials, authenticationError.Cause(),
+		"Authentication error cause failed")
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/discovery"
+	"github.com/hazelcast/hazelcast-go-client/test/assert"
+)
+
+type countingAddressProvider struct {
+	discovery.AddressProvider
+	calls int
+}
+
+func (p *countingAddressProvider) LoadAddresses() ([]string, error) {
+	p.calls++
+	return p.AddressProvider.LoadAddresses()
+}
+
+func TestAddressProvider(t *testing.T) {
+	cluster, _ = remoteController.CreateCluster("", DefaultServerConfig)
+	defer remoteController.ShutdownCluster(cluster.ID)
+	remoteController.StartMember(cluster.ID)
+	provider := &countingAddressProvider{AddressProvider: discovery.NewStaticAddressProvider("127.0.0.1:5701")}
+	config := hazelcast.NewConfig()
+	config.NetworkConfig().SetAddressProvider(provider)
+	client, err := hazelcast.NewClientWithConfig(config)
+	assert.ErrorNil(t, err)
+	defer client.Shutdown()
+	assert.Equalf(t, nil, 1, provider.calls, "AddressProvider should be called on connect")
+	assert.Equalf(t, nil, 1, len(client.GetCluster().GetMembers()), "AddressProvider connection failed")
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atomiclong
+
+import (
+	"context"
+	"log"
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/rc"
+	"github.com/hazelcast/hazelcast-go-client/test"
+	"github.com/hazelcast/hazelcast-go-client/test/assert"
+)
+
+var atomicLong core.AtomicLong
+var client hazelcast.Instance
+
+const atomicLongName = "myAtomicLong"
+
+var remoteController *rc.RemoteControllerClient
+var cluster *rc.Cluster
+var err error
+
+func TestMain(m *testing.M) {
+	remoteController, err = rc.NewRemoteControllerClient("localhost:9701")
+	if remoteController == nil || err != nil {
+		log.Fatal("create remote controller failed:", err)
+	}
+	cluster, err = remoteController.CreateCluster("", test.DefaultServerConfig)
+	remoteController.StartMember(cluster.ID)
+	client, _ = hazelcast.NewClient()
+	atomicLong, _ = client.GetAtomicLong(atomicLongName)
+	m.Run()
+	atomicLong.Destroy()
+	client.Shutdown()
+	remoteController.ShutdownCluster(cluster.ID)
+}
+
+func destroyAndCreate() {
+	atomicLong.Destroy()
+	atomicLong, _ = client.GetAtomicLong(atomicLongName)
+}
+
+func TestAtomicLong_Name(t *testing.T) {
+	if atomicLongName != atomicLong.Name() {
+		t.Error("AtomicLong.Name failed")
+	}
+}
+
+func TestAtomicLong_ServiceName(t *testing.T) {
+	serviceName := bufutil.ServiceNameAtomicLong
+	if serviceName != atomicLong.ServiceName() {
+		t.Error("AtomicLong.ServiceName failed")
+	}
+}
+
+func TestAtomicLong_Destroy(t *testing.T) {
+	atomicLong.Set(5)
+	atomicLong.Destroy()
+	atomicLong, _ = client.GetAtomicLong(atomicLongName)
+	res, err := atomicLong.Get()
+	assert.Equalf(t, err, res, int64(0), "AtomicLong.Destroy failed")
+}
+
+func TestAtomicLong_SetAndGet(t *testing.T) {
+	defer destroyAndCreate()
+	err := atomicLong.Set(5)
+	assert.ErrorNil(t, err)
+	value, err := atomicLong.Get()
+	assert.Equalf(t, err, value, int64(5), "AtomicLong.Set failed")
+}
+
+func TestAtomicLong_WithContext(t *testing.T) {
+	defer destroyAndCreate()
+	ctx, cancel := context.WithTimeout(context.Background(), test.Timeout)
+	defer cancel()
+	value, err := atomicLong.WithContext(ctx).AddAndGet(5)
+	assert.Equalf(t, err, value, int64(5), "AtomicLong.WithContext failed")
+}
+
+func TestAtomicLong_GetAndSet(t *testing.T) {
+	defer destroyAndCreate()
+	atomicLong.Set(5)
+	oldValue, err := atomicLong.GetAndSet(10)
+	assert.Equalf(t, err, oldValue, int64(5), "AtomicLong.GetAndSet failed")
+	value, err := atomicLong.Get()
+	assert.Equalf(t, err, value, int64(10), "AtomicLong.GetAndSet failed")
+}
+
+func TestAtomicLong_CompareAndSet(t *testing.T) {
+	defer destroyAndCreate()
+	atomicLong.Set(5)
+	set, err := atomicLong.CompareAndSet(4, 10)
+	assert.Equalf(t, err, set, false, "AtomicLong.CompareAndSet failed")
+	set, err = atomicLong.CompareAndSet(5, 10)
+	assert.Equalf(t, err, set, true, "AtomicLong.CompareAndSet failed")
+	value, err := atomicLong.Get()
+	assert.Equalf(t, err, value, int64(10), "AtomicLong.CompareAndSet failed")
+}
+
+func TestAtomicLong_AddAndGet(t *testing.T) {
+	defer destroyAndCreate()
+	updatedValue, err := atomicLong.AddAndGet(5)
+	assert.Equalf(t, err, updatedValue, int64(5), "AtomicLong.AddAndGet failed")
+}
+
+func TestAtomicLong_GetAndAdd(t *testing.T) {
+	defer destroyAndCreate()
+	oldValue, err := atomicLong.GetAndAdd(5)
+	assert.Equalf(t, err, oldValue, int64(0), "AtomicLong.GetAndAdd failed")
+	value, err := atomicLong.Get()
+	assert.Equalf(t, err, value, int64(5), "AtomicLong.GetAndAdd failed")
+}
+
+func TestAtomicLong_IncrementAndGet(t *testing.T) {
+	defer destroyAndCreate()
+	updatedValue, err := atomicLong.IncrementAndGet()
+	assert.Equalf(t, err, updatedValue, int64(1), "AtomicLong.IncrementAndGet failed")
+}
+
+func TestAtomicLong_DecrementAndGet(t *testing.T) {
+	defer destroyAndCreate()
+	updatedValue, err := atomicLong.DecrementAndGet()
+	assert.Equalf(t, err, updatedValue, int64(-1), "AtomicLong.DecrementAndGet failed")
+}
+
+func TestAtomicLong_GetAndIncrement(t *testing.T) {
+	defer destroyAndCreate()
+	oldValue, err := atomicLong.GetAndIncrement()
+	assert.Equalf(t, err, oldValue, int64(0), "AtomicLong.GetAndIncrement failed")
+	value, err := atomicLong.Get()
+	assert.Equalf(t, err, value, int64(1), "AtomicLong.GetAndIncrement failed")
+}
+
+func TestAtomicLong_AlterWithNilFunction(t *testing.T) {
+	err := atomicLong.Alter(nil)
+	if _, ok := err.(*core.HazelcastNilPointerError); !ok {
+		t.Errorf("expected HazelcastNilPointerError, got %v", err)
+	}
+}
+
+func TestAtomicLong_ApplyWithNilFunction(t *testing.T) {
+	_, err := atomicLong.Apply(nil)
+	if _, ok := err.(*core.HazelcastNilPointerError); !ok {
+		t.Errorf("expected HazelcastNilPointerError, got %v", err)
+	}
+}
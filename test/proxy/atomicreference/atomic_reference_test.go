@@ -0,0 +1,148 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package atomicreference
+
+import (
+	"context"
+	"log"
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/rc"
+	"github.com/hazelcast/hazelcast-go-client/test"
+	"github.com/hazelcast/hazelcast-go-client/test/assert"
+)
+
+var reference core.AtomicReference
+var client hazelcast.Instance
+
+const referenceName = "myAtomicReference"
+
+var remoteController *rc.RemoteControllerClient
+var cluster *rc.Cluster
+var err error
+
+func TestMain(m *testing.M) {
+	remoteController, err = rc.NewRemoteControllerClient("localhost:9701")
+	if remoteController == nil || err != nil {
+		log.Fatal("create remote controller failed:", err)
+	}
+	cluster, err = remoteController.CreateCluster("", test.DefaultServerConfig)
+	remoteController.StartMember(cluster.ID)
+	client, _ = hazelcast.NewClient()
+	reference, _ = client.GetAtomicReference(referenceName)
+	m.Run()
+	reference.Destroy()
+	client.Shutdown()
+	remoteController.ShutdownCluster(cluster.ID)
+}
+
+func destroyAndCreate() {
+	reference.Destroy()
+	reference, _ = client.GetAtomicReference(referenceName)
+}
+
+func TestAtomicReference_ServiceName(t *testing.T) {
+	serviceName := bufutil.ServiceNameAtomicReference
+	if serviceName != reference.ServiceName() {
+		t.Error("AtomicReference.ServiceName failed")
+	}
+}
+
+func TestAtomicReference_GetWhenEmpty(t *testing.T) {
+	defer destroyAndCreate()
+	value, err := reference.Get()
+	assert.Nilf(t, err, value, "AtomicReference.Get failed")
+	isNil, err := reference.IsNil()
+	assert.Equalf(t, err, isNil, true, "AtomicReference.IsNil failed")
+}
+
+func TestAtomicReference_SetAndGet(t *testing.T) {
+	defer destroyAndCreate()
+	err := reference.Set("value")
+	assert.ErrorNil(t, err)
+	value, err := reference.Get()
+	assert.Equalf(t, err, value, "value", "AtomicReference.Set failed")
+}
+
+func TestAtomicReference_WithContext(t *testing.T) {
+	defer destroyAndCreate()
+	ctx, cancel := context.WithTimeout(context.Background(), test.Timeout)
+	defer cancel()
+	assert.ErrorNil(t, reference.WithContext(ctx).Set("value"))
+	value, err := reference.Get()
+	assert.Equalf(t, err, value, "value", "AtomicReference.WithContext failed")
+}
+
+func TestAtomicReference_GetAndSet(t *testing.T) {
+	defer destroyAndCreate()
+	reference.Set("value")
+	oldValue, err := reference.GetAndSet("newValue")
+	assert.Equalf(t, err, oldValue, "value", "AtomicReference.GetAndSet failed")
+	value, err := reference.Get()
+	assert.Equalf(t, err, value, "newValue", "AtomicReference.GetAndSet failed")
+}
+
+func TestAtomicReference_SetAndGetNew(t *testing.T) {
+	defer destroyAndCreate()
+	value, err := reference.SetAndGet("value")
+	assert.Equalf(t, err, value, "value", "AtomicReference.SetAndGet failed")
+}
+
+func TestAtomicReference_CompareAndSet(t *testing.T) {
+	defer destroyAndCreate()
+	set, err := reference.CompareAndSet(nil, "value")
+	assert.Equalf(t, err, set, true, "AtomicReference.CompareAndSet failed")
+	set, err = reference.CompareAndSet("other", "newValue")
+	assert.Equalf(t, err, set, false, "AtomicReference.CompareAndSet failed")
+	value, err := reference.Get()
+	assert.Equalf(t, err, value, "value", "AtomicReference.CompareAndSet failed")
+}
+
+func TestAtomicReference_Contains(t *testing.T) {
+	defer destroyAndCreate()
+	reference.Set("value")
+	found, err := reference.Contains("value")
+	assert.Equalf(t, err, found, true, "AtomicReference.Contains failed")
+	found, err = reference.Contains(nil)
+	assert.Equalf(t, err, found, false, "AtomicReference.Contains failed")
+}
+
+func TestAtomicReference_Clear(t *testing.T) {
+	defer destroyAndCreate()
+	reference.Set("value")
+	err := reference.Clear()
+	assert.ErrorNil(t, err)
+	isNil, err := reference.IsNil()
+	assert.Equalf(t, err, isNil, true, "AtomicReference.Clear failed")
+}
+
+func TestAtomicReference_SetNil(t *testing.T) {
+	defer destroyAndCreate()
+	reference.Set("value")
+	err := reference.Set(nil)
+	assert.ErrorNil(t, err)
+	isNil, err := reference.IsNil()
+	assert.Equalf(t, err, isNil, true, "AtomicReference.Set(nil) failed")
+}
+
+func TestAtomicReference_AlterWithNilFunction(t *testing.T) {
+	err := reference.Alter(nil)
+	if _, ok := err.(*core.HazelcastNilPointerError); !ok {
+		t.Errorf("expected HazelcastNilPointerError, got %v", err)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package countdownlatch
+
+import (
+	"context"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/rc"
+	"github.com/hazelcast/hazelcast-go-client/test"
+	"github.com/hazelcast/hazelcast-go-client/test/assert"
+)
+
+var latch core.CountDownLatch
+var client hazelcast.Instance
+
+const latchName = "myCountDownLatch"
+
+var remoteController *rc.RemoteControllerClient
+var cluster *rc.Cluster
+var err error
+
+func TestMain(m *testing.M) {
+	remoteController, err = rc.NewRemoteControllerClient("localhost:9701")
+	if remoteController == nil || err != nil {
+		log.Fatal("create remote controller failed:", err)
+	}
+	cluster, err = remoteController.CreateCluster("", test.DefaultServerConfig)
+	remoteController.StartMember(cluster.ID)
+	client, _ = hazelcast.NewClient()
+	latch, _ = client.GetCountDownLatch(latchName)
+	m.Run()
+	latch.Destroy()
+	client.Shutdown()
+	remoteController.ShutdownCluster(cluster.ID)
+}
+
+func destroyAndCreate() {
+	latch.Destroy()
+	latch, _ = client.GetCountDownLatch(latchName)
+}
+
+func TestCountDownLatch_ServiceName(t *testing.T) {
+	if bufutil.ServiceNameCountDownLatch != latch.ServiceName() {
+		t.Error("CountDownLatch.ServiceName failed")
+	}
+}
+
+func TestCountDownLatch_TrySetCount(t *testing.T) {
+	defer destroyAndCreate()
+	set, err := latch.TrySetCount(2)
+	assert.Equalf(t, err, set, true, "CountDownLatch.TrySetCount failed")
+	set, err = latch.TrySetCount(3)
+	assert.Equalf(t, err, set, false, "CountDownLatch.TrySetCount should fail when the count is not zero")
+	count, err := latch.GetCount()
+	assert.Equalf(t, err, count, int32(2), "CountDownLatch.GetCount failed")
+}
+
+func TestCountDownLatch_TrySetNegativeCount(t *testing.T) {
+	_, err := latch.TrySetCount(-1)
+	if _, ok := err.(*core.HazelcastIllegalArgumentError); !ok {
+		t.Errorf("expected HazelcastIllegalArgumentError, got %v", err)
+	}
+}
+
+func TestCountDownLatch_CountDown(t *testing.T) {
+	defer destroyAndCreate()
+	latch.TrySetCount(2)
+	err := latch.CountDown()
+	assert.ErrorNil(t, err)
+	count, err := latch.GetCount()
+	assert.Equalf(t, err, count, int32(1), "CountDownLatch.CountDown failed")
+}
+
+func TestCountDownLatch_Await(t *testing.T) {
+	defer destroyAndCreate()
+	latch.TrySetCount(1)
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		latch.CountDown()
+	}()
+	completed, err := latch.Await(10 * time.Second)
+	assert.Equalf(t, err, completed, true, "CountDownLatch.Await failed")
+}
+
+func TestCountDownLatch_AwaitTimeout(t *testing.T) {
+	defer destroyAndCreate()
+	latch.TrySetCount(1)
+	completed, err := latch.Await(100 * time.Millisecond)
+	assert.Equalf(t, err, completed, false, "CountDownLatch.Await should time out")
+}
+
+func TestCountDownLatch_AwaitWithCancelledContext(t *testing.T) {
+	defer destroyAndCreate()
+	latch.TrySetCount(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+	_, err := latch.WithContext(ctx).Await(time.Minute)
+	if _, ok := err.(*core.HazelcastCancellationError); !ok {
+		t.Fatalf("CountDownLatch.Await() with context should return HazelcastCancellationError, got %v", err)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"log"
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/rc"
+	"github.com/hazelcast/hazelcast-go-client/test"
+	"github.com/hazelcast/hazelcast-go-client/test/assert"
+)
+
+var executor core.ExecutorService
+var client hazelcast.Instance
+
+const executorName = "myExecutor"
+
+var remoteController *rc.RemoteControllerClient
+var cluster *rc.Cluster
+var err error
+
+func TestMain(m *testing.M) {
+	remoteController, err = rc.NewRemoteControllerClient("localhost:9701")
+	if remoteController == nil || err != nil {
+		log.Fatal("create remote controller failed:", err)
+	}
+	cluster, err = remoteController.CreateCluster("", test.DefaultServerConfig)
+	remoteController.StartMember(cluster.ID)
+	client, _ = hazelcast.NewClient()
+	executor, _ = client.GetExecutorService(executorName)
+	m.Run()
+	executor.Destroy()
+	client.Shutdown()
+	remoteController.ShutdownCluster(cluster.ID)
+}
+
+type noMemberSelector struct{}
+
+func (noMemberSelector) Select(member core.Member) bool {
+	return false
+}
+
+func TestExecutorService_ServiceName(t *testing.T) {
+	if bufutil.ServiceNameExecutor != executor.ServiceName() {
+		t.Error("ExecutorService.ServiceName failed")
+	}
+}
+
+func TestExecutorService_SubmitNilTask(t *testing.T) {
+	_, err := executor.Submit(nil).Get()
+	if _, ok := err.(*core.HazelcastNilPointerError); !ok {
+		t.Errorf("expected HazelcastNilPointerError, got %v", err)
+	}
+}
+
+func TestExecutorService_SubmitToMembersWithoutSelectedMember(t *testing.T) {
+	_, err := executor.SubmitToMembers("task", noMemberSelector{})
+	if _, ok := err.(*core.HazelcastIllegalStateError); !ok {
+		t.Errorf("expected HazelcastIllegalStateError, got %v", err)
+	}
+}
+
+func TestExecutorService_Shutdown(t *testing.T) {
+	shutdownExecutor, _ := client.GetExecutorService("shutdownExecutor")
+	defer shutdownExecutor.Destroy()
+	isShutdown, err := shutdownExecutor.IsShutdown()
+	assert.Equalf(t, err, isShutdown, false, "ExecutorService.IsShutdown failed")
+	err = shutdownExecutor.Shutdown()
+	assert.ErrorNil(t, err)
+	isShutdown, err = shutdownExecutor.IsShutdown()
+	assert.Equalf(t, err, isShutdown, true, "ExecutorService.Shutdown failed")
+}
@@ -15,6 +15,7 @@
 package flakeidgen
 
 import (
+	"context"
 	"log"
 	"strconv"
 	"sync"
@@ -87,6 +88,25 @@ func TestFlakeIDGeneratorProxy_ConfigTest(t *testing.T) {
 
 }
 
+func TestFlakeIDGeneratorProxy_WithContext(t *testing.T) {
+	cluster, _ := remoteController.CreateCluster("", test.DefaultServerConfig)
+	defer remoteController.ShutdownCluster(cluster.ID)
+	remoteController.StartMember(cluster.ID)
+	client, _ := hazelcast.NewClient()
+	defer client.Shutdown()
+	generator, _ := client.GetFlakeIDGenerator("gen")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := generator.WithContext(ctx).NewID()
+	if _, ok := err.(*core.HazelcastCancellationError); !ok {
+		t.Fatalf("FlakeIDGenerator.NewID() with a cancelled context should return HazelcastCancellationError, got %v", err)
+	}
+	ctx, cancel = context.WithTimeout(context.Background(), test.Timeout)
+	defer cancel()
+	_, err = generator.WithContext(ctx).NewID()
+	assert.ErrorNil(t, err)
+}
+
 func TestFlakeIDGeneratorProxy_ConcurrentlyGeneratedIds(t *testing.T) {
 	cluster, _ := remoteController.CreateCluster("", test.DefaultServerConfig)
 	defer remoteController.ShutdownCluster(cluster.ID)
@@ -0,0 +1,143 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lock
+
+import (
+	"context"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/rc"
+	"github.com/hazelcast/hazelcast-go-client/test"
+	"github.com/hazelcast/hazelcast-go-client/test/assert"
+)
+
+var lock core.Lock
+var client hazelcast.Instance
+
+const lockName = "myLock"
+
+var remoteController *rc.RemoteControllerClient
+var cluster *rc.Cluster
+var err error
+
+func TestMain(m *testing.M) {
+	remoteController, err = rc.NewRemoteControllerClient("localhost:9701")
+	if remoteController == nil || err != nil {
+		log.Fatal("create remote controller failed:", err)
+	}
+	cluster, err = remoteController.CreateCluster("", test.DefaultServerConfig)
+	remoteController.StartMember(cluster.ID)
+	client, _ = hazelcast.NewClient()
+	lock, _ = client.GetLock(lockName)
+	m.Run()
+	lock.Destroy()
+	client.Shutdown()
+	remoteController.ShutdownCluster(cluster.ID)
+}
+
+func withOwner(ownerID int64) core.Lock {
+	return lock.WithContext(core.WithLockOwner(context.Background(), ownerID))
+}
+
+func TestLock_ServiceName(t *testing.T) {
+	if bufutil.ServiceNameLock != lock.ServiceName() {
+		t.Error("Lock.ServiceName failed")
+	}
+}
+
+func TestLock_LockAndUnlock(t *testing.T) {
+	defer lock.ForceUnlock()
+	err := lock.Lock()
+	assert.ErrorNil(t, err)
+	locked, err := lock.IsLocked()
+	assert.Equalf(t, err, locked, true, "Lock.Lock failed")
+	err = lock.Unlock()
+	assert.ErrorNil(t, err)
+	locked, err = lock.IsLocked()
+	assert.Equalf(t, err, locked, false, "Lock.Unlock failed")
+}
+
+func TestLock_Reentrant(t *testing.T) {
+	defer lock.ForceUnlock()
+	lock.Lock()
+	lock.Lock()
+	count, err := lock.GetLockCount()
+	assert.Equalf(t, err, count, int32(2), "Lock.GetLockCount failed")
+}
+
+func TestLock_TryLockByAnotherOwner(t *testing.T) {
+	defer lock.ForceUnlock()
+	owner := withOwner(core.NewLockOwnerID())
+	other := withOwner(core.NewLockOwnerID())
+	locked, err := owner.TryLock()
+	assert.Equalf(t, err, locked, true, "Lock.TryLock failed")
+	locked, err = other.TryLockWithTimeout(100 * time.Millisecond)
+	assert.Equalf(t, err, locked, false, "Lock.TryLockWithTimeout should fail for another owner")
+	lockedByOwner, err := owner.IsLockedByCurrentThread()
+	assert.Equalf(t, err, lockedByOwner, true, "Lock.IsLockedByCurrentThread failed")
+	lockedByOther, err := other.IsLockedByCurrentThread()
+	assert.Equalf(t, err, lockedByOther, false, "Lock.IsLockedByCurrentThread failed")
+}
+
+func TestLock_UnlockByAnotherOwner(t *testing.T) {
+	defer lock.ForceUnlock()
+	withOwner(core.NewLockOwnerID()).Lock()
+	err := withOwner(core.NewLockOwnerID()).Unlock()
+	if _, ok := err.(*core.HazelcastIllegalMonitorStateError); !ok {
+		t.Errorf("expected HazelcastIllegalMonitorStateError, got %v", err)
+	}
+}
+
+func TestLock_ForceUnlock(t *testing.T) {
+	withOwner(core.NewLockOwnerID()).Lock()
+	err := lock.ForceUnlock()
+	assert.ErrorNil(t, err)
+	locked, err := lock.IsLocked()
+	assert.Equalf(t, err, locked, false, "Lock.ForceUnlock failed")
+}
+
+func TestLock_LockWithLease(t *testing.T) {
+	defer lock.ForceUnlock()
+	lock.LockWithLease(10 * time.Second)
+	remaining, err := lock.GetRemainingLeaseTime()
+	assert.ErrorNil(t, err)
+	if remaining <= 0 || remaining > 10*time.Second {
+		t.Errorf("unexpected remaining lease time %v", remaining)
+	}
+}
+
+func TestLock_RemainingLeaseTimeWhenNotLocked(t *testing.T) {
+	remaining, err := lock.GetRemainingLeaseTime()
+	assert.ErrorNil(t, err)
+	if remaining >= 0 {
+		t.Errorf("expected negative remaining lease time, got %v", remaining)
+	}
+}
+
+func TestMap_LockWithOwner(t *testing.T) {
+	mp, _ := client.GetMap("lockOwnerMap")
+	defer mp.Destroy()
+	owner := mp.WithContext(core.WithLockOwner(context.Background(), core.NewLockOwnerID()))
+	owner.Lock("key")
+	locked, err := mp.TryLock("key")
+	assert.Equalf(t, err, locked, false, "Map.TryLock should fail for another owner")
+	err = owner.Unlock("key")
+	assert.ErrorNil(t, err)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package map1
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/test/assert"
+)
+
+func TestMapProxy_GetAsync(t *testing.T) {
+	defer mp.Clear()
+	mp.Put("testingKey", "testingValue")
+	value, err := mp.GetAsync("testingKey").Get()
+	assert.Equalf(t, err, value, "testingValue", "map GetAsync() failed")
+}
+
+func TestMapProxy_GetAsyncWithNonExistingKey(t *testing.T) {
+	value, err := mp.GetAsync("nonExistingKey").Get()
+	assert.Nilf(t, err, value, "map GetAsync() should return nil for a non existing key")
+}
+
+func TestMapProxy_GetAsyncWithNilKey(t *testing.T) {
+	future := mp.GetAsync(nil)
+	select {
+	case <-future.Done():
+	default:
+		t.Fatal("map GetAsync() with a nil key should return a completed future")
+	}
+	_, err := future.Get()
+	if _, ok := err.(*core.HazelcastNilPointerError); !ok {
+		t.Fatal("map GetAsync() should return HazelcastNilPointerError for a nil key")
+	}
+}
+
+func TestMapProxy_PutAsync(t *testing.T) {
+	defer mp.Clear()
+	mp.Put("testingKey", "testingValue")
+	oldValue, err := mp.PutAsync("testingKey", "newValue").GetWithTimeout(5 * time.Second)
+	assert.Equalf(t, err, oldValue, "testingValue", "map PutAsync() failed")
+	value, err := mp.Get("testingKey")
+	assert.Equalf(t, err, value, "newValue", "map PutAsync() failed")
+}
+
+func TestMapProxy_SetAsync(t *testing.T) {
+	defer mp.Clear()
+	value, err := mp.SetAsync("testingKey", "testingValue").Get()
+	assert.Nilf(t, err, value, "map SetAsync() should return nil")
+	value, err = mp.Get("testingKey")
+	assert.Equalf(t, err, value, "testingValue", "map SetAsync() failed")
+}
+
+func TestMapProxy_RemoveAsync(t *testing.T) {
+	defer mp.Clear()
+	mp.Put("testingKey", "testingValue")
+	value, err := mp.RemoveAsync("testingKey").Get()
+	assert.Equalf(t, err, value, "testingValue", "map RemoveAsync() failed")
+	found, err := mp.ContainsKey("testingKey")
+	assert.Equalf(t, err, found, false, "map RemoveAsync() failed")
+}
+
+func TestMapProxy_DeleteAsync(t *testing.T) {
+	defer mp.Clear()
+	mp.Put("testingKey", "testingValue")
+	_, err := mp.DeleteAsync("testingKey").Get()
+	assert.ErrorNil(t, err)
+	found, err := mp.ContainsKey("testingKey")
+	assert.Equalf(t, err, found, false, "map DeleteAsync() failed")
+}
+
+func TestMapProxy_ExecuteOnKeyAsync(t *testing.T) {
+	config := hazelcast.NewConfig()
+	expectedValue := "newValue"
+	processor := newSimpleEntryProcessor(expectedValue, 66)
+	config.SerializationConfig().AddDataSerializableFactory(processor.identifiedFactory.factoryID, processor.identifiedFactory)
+	client, _ := hazelcast.NewClientWithConfig(config)
+	defer client.Shutdown()
+	mp2, _ := client.GetMap("testMap2")
+	defer mp2.Clear()
+	mp2.Put("testingKey", "testingValue")
+	value, err := mp2.ExecuteOnKeyAsync("testingKey", processor).Get()
+	assert.Equalf(t, err, value, expectedValue, "map ExecuteOnKeyAsync() failed")
+	newValue, err := mp2.Get("testingKey")
+	assert.Equalf(t, err, newValue, expectedValue, "map ExecuteOnKeyAsync() failed")
+}
+
+func TestMapProxy_AsyncFanOutWithCallbacks(t *testing.T) {
+	defer mp.Clear()
+	const count = 1000
+	var wg sync.WaitGroup
+	wg.Add(count)
+	var errLock sync.Mutex
+	var callbackErr error
+	for i := 0; i < count; i++ {
+		mp.SetAsync(strconv.Itoa(i), i).AndThen(func(value interface{}, err error) {
+			if err != nil {
+				errLock.Lock()
+				callbackErr = err
+				errLock.Unlock()
+			}
+			wg.Done()
+		})
+	}
+	wg.Wait()
+	assert.ErrorNil(t, callbackErr)
+	size, err := mp.Size()
+	assert.Equalf(t, err, size, int32(count), "map SetAsync() callbacks are called before the operations complete")
+}
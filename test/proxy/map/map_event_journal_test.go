@@ -0,0 +1,97 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package map1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/test/assert"
+)
+
+func TestMapProxy_ReadFromEventJournal(t *testing.T) {
+	journalMap, _ := client.GetMap("EventJournalTestRead")
+	defer journalMap.Destroy()
+	journalMap.Put("key", "value")
+	journalMap.Put("key", "newValue")
+	// The map has a single key, so only the partition of the key has events.
+	var resultSet core.EventJournalReadResultSet
+	var partitionID int32
+	for ; resultSet == nil || resultSet.Size() == 0; partitionID++ {
+		var err error
+		resultSet, err = journalMap.ReadFromEventJournal(0, 0, 10, partitionID, nil, nil)
+		assert.ErrorNil(t, err)
+	}
+	partitionID--
+	assert.Equalf(t, nil, resultSet.Size(), int32(2), "Map.ReadFromEventJournal failed")
+	item, err := resultSet.Get(1)
+	assert.ErrorNil(t, err)
+	event := item.(core.EventJournalMapEvent)
+	assert.Equalf(t, nil, event.Key(), "key", "Map.ReadFromEventJournal failed")
+	assert.Equalf(t, nil, event.OldValue(), "value", "Map.ReadFromEventJournal failed")
+	assert.Equalf(t, nil, event.NewValue(), "newValue", "Map.ReadFromEventJournal failed")
+	assert.Equalf(t, nil, event.EventType(), bufutil.EntryEventUpdated, "Map.ReadFromEventJournal failed")
+	assert.Equalf(t, nil, event.PartitionID(), partitionID, "Map.ReadFromEventJournal failed")
+	assert.Equalf(t, nil, resultSet.NextSequence(), event.Sequence()+1, "Map.ReadFromEventJournal failed")
+}
+
+func TestMapProxy_ReadFromEventJournalWithNegativeSequence(t *testing.T) {
+	_, err := mp.ReadFromEventJournal(-1, 1, 10, 0, nil, nil)
+	if _, ok := err.(*core.HazelcastIllegalArgumentError); !ok {
+		t.Errorf("expected HazelcastIllegalArgumentError, got %v", err)
+	}
+}
+
+func TestMapProxy_SubscribeToEventJournal(t *testing.T) {
+	journalMap, _ := client.GetMap("EventJournalTestSubscribe")
+	defer journalMap.Destroy()
+	journalMap.Put("key1", "value1")
+	subscription, err := journalMap.SubscribeToEventJournal(nil, nil)
+	assert.ErrorNil(t, err)
+	journalMap.Put("key2", "value2")
+	received := make(map[interface{}]interface{})
+	partitionIDs := make(map[interface{}]int32)
+	for len(received) < 2 {
+		select {
+		case event := <-subscription.Events():
+			received[event.Key()] = event.NewValue()
+			partitionIDs[event.Key()] = event.PartitionID()
+		case <-time.After(time.Minute):
+			t.Fatal("Map.SubscribeToEventJournal did not receive the events")
+		}
+	}
+	assert.Equalf(t, nil, received, map[interface{}]interface{}{"key1": "value1", "key2": "value2"},
+		"Map.SubscribeToEventJournal failed")
+	state := subscription.InitialStates()[partitionIDs["key1"]]
+	assert.LessThanf(t, nil, state.OldestSequence-1, state.NewestSequence, "Map.SubscribeToEventJournal failed")
+	subscription.Close()
+	for range subscription.Events() {
+	}
+	assert.ErrorNil(t, subscription.Err())
+
+	// Continue from the offsets of the closed subscription.
+	journalMap.Put("key3", "value3")
+	subscription, err = journalMap.SubscribeToEventJournal(subscription.Offsets(), nil)
+	assert.ErrorNil(t, err)
+	defer subscription.Close()
+	select {
+	case event := <-subscription.Events():
+		assert.Equalf(t, nil, event.Key(), "key3", "Map.SubscribeToEventJournal failed")
+	case <-time.After(time.Minute):
+		t.Fatal("Map.SubscribeToEventJournal did not continue from the offsets")
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package map1
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/test/assert"
+)
+
+func fillMapForIterator(t *testing.T, count int) map[interface{}]interface{} {
+	entries := make(map[interface{}]interface{}, count)
+	for i := 0; i < count; i++ {
+		entries["key"+strconv.Itoa(i)] = int32(i)
+	}
+	assert.ErrorNil(t, mp.PutAll(entries))
+	return entries
+}
+
+func TestMapProxy_Iterator(t *testing.T) {
+	defer mp.Clear()
+	expected := fillMapForIterator(t, 1000)
+	it, err := mp.Iterator(7)
+	assert.ErrorNil(t, err)
+	actual := make(map[interface{}]interface{})
+	for it.Next() {
+		actual[it.Key()] = it.Value()
+	}
+	assert.ErrorNil(t, it.Err())
+	assert.Equalf(t, nil, actual, expected, "Map.Iterator failed")
+	assert.Equalf(t, nil, it.Next(), false, "Map.Iterator failed")
+}
+
+func TestMapProxy_KeyIterator(t *testing.T) {
+	defer mp.Clear()
+	expected := fillMapForIterator(t, 100)
+	it, err := mp.KeyIterator(10)
+	assert.ErrorNil(t, err)
+	count := 0
+	for it.Next() {
+		if _, found := expected[it.Key()]; !found || it.Value() != nil {
+			t.Errorf("Map.KeyIterator returned unexpected entry %v %v", it.Key(), it.Value())
+		}
+		count++
+	}
+	assert.ErrorNil(t, it.Err())
+	assert.Equalf(t, nil, count, len(expected), "Map.KeyIterator failed")
+}
+
+func TestMapProxy_IteratorEmptyMap(t *testing.T) {
+	it, err := mp.Iterator(10)
+	assert.ErrorNil(t, err)
+	assert.Equalf(t, nil, it.Next(), false, "Map.Iterator failed")
+	assert.ErrorNil(t, it.Err())
+}
+
+func TestMapProxy_IteratorInvalidBatchSize(t *testing.T) {
+	_, err := mp.Iterator(0)
+	if _, ok := err.(*core.HazelcastIllegalArgumentError); !ok {
+		t.Errorf("expected HazelcastIllegalArgumentError, got %v", err)
+	}
+}
+
+func TestMapProxy_Range(t *testing.T) {
+	defer mp.Clear()
+	expected := fillMapForIterator(t, 300)
+	actual := make(map[interface{}]interface{})
+	err := mp.Range(func(key interface{}, value interface{}) bool {
+		actual[key] = value
+		return true
+	})
+	assert.ErrorNil(t, err)
+	assert.Equalf(t, nil, actual, expected, "Map.Range failed")
+	count := 0
+	err = mp.Range(func(key interface{}, value interface{}) bool {
+		count++
+		return count < 5
+	})
+	assert.ErrorNil(t, err)
+	assert.Equalf(t, nil, count, 5, "Map.Range did not stop")
+}
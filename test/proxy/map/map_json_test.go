@@ -0,0 +1,40 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package map1
+
+import (
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/core/predicate"
+	"github.com/hazelcast/hazelcast-go-client/test"
+	"github.com/hazelcast/hazelcast-go-client/test/assert"
+)
+
+func TestMapProxy_JSONValue(t *testing.T) {
+	if !test.ClusterVersionAtLeast(remoteController, cluster.ID, 3, 12) {
+		t.Skip("HazelcastJsonValue requires 3.12 or newer members")
+	}
+	defer mp.Clear()
+	joe := core.JSONValue(`{"name": "Joe", "address": {"city": "Istanbul"}}`)
+	jane, err := core.NewJSONValue(map[string]interface{}{"name": "Jane", "address": map[string]string{"city": "London"}})
+	assert.ErrorNil(t, err)
+	assert.ErrorNil(t, mp.Set("joe", joe))
+	assert.ErrorNil(t, mp.Set("jane", jane))
+	value, err := mp.Get("joe")
+	assert.Equalf(t, err, value, joe, "Map.Get() failed")
+	keys, err := mp.KeySetWithPredicate(predicate.Equal("address.city", "London"))
+	assert.Equalf(t, err, keys, []interface{}{"jane"}, "Map.KeySetWithPredicate() failed")
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package map1
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/core/predicate"
+	"github.com/hazelcast/hazelcast-go-client/test"
+	"github.com/hazelcast/hazelcast-go-client/test/assert"
+)
+
+type queryCacheListener struct {
+	wg    *sync.WaitGroup
+	event core.EntryEvent
+}
+
+func (l *queryCacheListener) EntryAdded(event core.EntryEvent) {
+	l.event = event
+	l.wg.Done()
+}
+
+func (l *queryCacheListener) EntryRemoved(event core.EntryEvent) {
+	l.event = event
+	l.wg.Done()
+}
+
+func TestMapProxy_GetQueryCache(t *testing.T) {
+	defer mp.Clear()
+	for i := int32(0); i < 10; i++ {
+		mp.Put(i, i)
+	}
+	queryCache, err := mp.GetQueryCache("queryCache", predicate.GreaterEqual("this", int32(5)), true)
+	assert.ErrorNil(t, err)
+	defer queryCache.Destroy()
+	assert.Equalf(t, nil, queryCache.Size(), int32(5), "Map.GetQueryCache failed")
+	value, err := queryCache.Get(int32(7))
+	assert.Equalf(t, err, value, int32(7), "QueryCache.Get failed")
+	value, err = queryCache.Get(int32(3))
+	assert.Nilf(t, err, value, "QueryCache.Get failed")
+	values, err := queryCache.ValuesWithPredicate(predicate.LessThan("this", int32(7)))
+	assert.SlicesHaveSameElements(t, err, values, []interface{}{int32(5), int32(6)}, "QueryCache.ValuesWithPredicate failed")
+	sameQueryCache, err := mp.GetQueryCache("queryCache", predicate.True(), false)
+	assert.Equalf(t, err, sameQueryCache, queryCache, "Map.GetQueryCache failed")
+}
+
+func TestMapProxy_GetQueryCacheUpdates(t *testing.T) {
+	defer mp.Clear()
+	queryCache, err := mp.GetQueryCache("queryCacheUpdates", predicate.GreaterEqual("this", int32(5)), true)
+	assert.ErrorNil(t, err)
+	defer queryCache.Destroy()
+	wg := new(sync.WaitGroup)
+	listener := &queryCacheListener{wg: wg}
+	_, err = queryCache.AddEntryListener(listener, true)
+	assert.ErrorNil(t, err)
+	wg.Add(1)
+	mp.Put("low", int32(1))
+	mp.Put("high", int32(10))
+	timeout := test.WaitTimeout(wg, test.Timeout)
+	assert.Equalf(t, nil, false, timeout, "QueryCache.AddEntryListener failed")
+	assert.Equalf(t, nil, listener.event.Key(), "high", "QueryCache.AddEntryListener failed")
+	keySet, err := queryCache.KeySet()
+	assert.SlicesHaveSameElements(t, err, keySet, []interface{}{"high"}, "QueryCache.KeySet failed")
+
+	wg.Add(1)
+	mp.Remove("high")
+	timeout = test.WaitTimeout(wg, test.Timeout)
+	assert.Equalf(t, nil, false, timeout, "QueryCache.AddEntryListener failed")
+	assert.Equalf(t, nil, listener.event.OldValue(), int32(10), "QueryCache.AddEntryListener failed")
+	assert.Equalf(t, nil, queryCache.IsEmpty(), true, "QueryCache.Remove failed")
+}
+
+func TestMapProxy_GetQueryCacheWithIndex(t *testing.T) {
+	defer mp.Clear()
+	for i := int32(0); i < 10; i++ {
+		mp.Put(i, i)
+	}
+	queryCache, err := mp.GetQueryCache("queryCacheWithIndex", predicate.True(), true)
+	assert.ErrorNil(t, err)
+	defer queryCache.Destroy()
+	assert.ErrorNil(t, queryCache.AddIndex("this", true))
+	keySet, err := queryCache.KeySetWithPredicate(predicate.Between("this", int32(2), int32(4)))
+	assert.SlicesHaveSameElements(t, err, keySet, []interface{}{int32(2), int32(3), int32(4)},
+		"QueryCache.KeySetWithPredicate failed")
+	recovered, err := queryCache.TryRecover()
+	assert.Equalf(t, err, recovered, true, "QueryCache.TryRecover failed")
+}
+
+func TestMapProxy_GetQueryCacheWithoutValues(t *testing.T) {
+	defer mp.Clear()
+	for i := int32(0); i < 10; i++ {
+		mp.Put(i, i)
+	}
+	queryCache, err := mp.GetQueryCache("queryCacheWithoutValues", predicate.GreaterEqual("this", int32(5)), false)
+	assert.ErrorNil(t, err)
+	defer queryCache.Destroy()
+	value, err := queryCache.Get(int32(7))
+	assert.Equalf(t, err, value, int32(7), "QueryCache.Get should read the value from the map")
+	value, err = queryCache.Get(int32(3))
+	assert.Nilf(t, err, value, "QueryCache.Get failed")
+	values, err := queryCache.ValuesWithPredicate(predicate.LessThan("__key", int32(7)))
+	assert.SlicesHaveSameElements(t, err, values, []interface{}{int32(5), int32(6)}, "QueryCache.ValuesWithPredicate failed")
+	_, err = queryCache.KeySetWithPredicate(predicate.LessThan("this", int32(7)))
+	if _, ok := err.(*core.HazelcastIllegalArgumentError); !ok {
+		t.Errorf("expected HazelcastIllegalArgumentError for a value predicate, got %v", err)
+	}
+}
+
+func TestMapProxy_GetQueryCacheWithNilPredicate(t *testing.T) {
+	_, err := mp.GetQueryCache("queryCacheNil", nil, true)
+	assert.ErrorNotNil(t, err, "Map.GetQueryCache should return an error for a nil predicate")
+}
+
+func TestMapProxy_GetQueryCacheAfterMemberAdded(t *testing.T) {
+	defer mp.Clear()
+	queryCache, err := mp.GetQueryCache("queryCacheMemberAdded", predicate.True(), true)
+	assert.ErrorNil(t, err)
+	defer queryCache.Destroy()
+	member, err := remoteController.StartMember(cluster.ID)
+	assert.ErrorNil(t, err)
+	defer remoteController.ShutdownMember(cluster.ID, member.UUID)
+	deadline := time.Now().Add(test.Timeout)
+	for len(client.GetCluster().GetMembers()) != 2 && time.Now().Before(deadline) {
+		time.Sleep(100 * time.Millisecond)
+	}
+	for i := int32(0); i < 100; i++ {
+		mp.Put(i, i)
+	}
+	for queryCache.Size() != 100 && time.Now().Before(deadline) {
+		time.Sleep(100 * time.Millisecond)
+	}
+	assert.Equalf(t, nil, queryCache.Size(), int32(100), "QueryCache should receive the events of the new member")
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package map1
+
+import (
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client/core/predicate"
+	"github.com/hazelcast/hazelcast-go-client/test/assert"
+)
+
+type structAddress struct {
+	City string `hz:"city"`
+}
+
+type structEmployee struct {
+	Name    string         `hz:"name"`
+	Age     int32          `hz:"age"`
+	Address *structAddress `hz:"address"`
+	Skills  []string       `hz:"skills"`
+}
+
+func TestMapProxy_Struct(t *testing.T) {
+	defer mp.Clear()
+	employee := &structEmployee{Name: "Joe", Age: 30, Address: &structAddress{City: "Istanbul"},
+		Skills: []string{"go", "java"}}
+	assert.ErrorNil(t, mp.Set("joe", employee))
+	assert.ErrorNil(t, mp.Set("jane", &structEmployee{Name: "Jane", Age: 40}))
+	value, err := mp.Get("joe")
+	assert.Equalf(t, err, value, employee, "Map.Get() failed")
+	values, err := mp.ValuesWithPredicate(predicate.GreaterThan("age", int32(35)))
+	assert.Equalf(t, err, values, []interface{}{&structEmployee{Name: "Jane", Age: 40}},
+		"Map.ValuesWithPredicate() failed")
+	keys, err := mp.KeySetWithPredicate(predicate.Equal("address.city", "Istanbul"))
+	assert.Equalf(t, err, keys, []interface{}{"joe"}, "Map.KeySetWithPredicate() failed")
+}
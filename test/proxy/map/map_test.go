@@ -36,17 +36,22 @@ import (
 var mp core.Map
 var mp2 core.Map
 var client hazelcast.Instance
+var remoteController rc.RemoteController
+var cluster *rc.Cluster
 
 func TestMain(m *testing.M) {
-	remoteController, err := rc.NewRemoteControllerClient("localhost:9701")
+	var err error
+	remoteController, err = rc.NewRemoteControllerClient("localhost:9701")
 	if remoteController == nil || err != nil {
 		log.Fatal("create remote controller failed:", err)
 	}
 
-	cluster, _ := remoteController.CreateCluster("", test.DefaultServerConfig)
+	cluster, _ = remoteController.CreateCluster("", test.DefaultServerConfig)
 	remoteController.StartMember(cluster.ID)
 	config := hazelcast.NewConfig()
 	config.SerializationConfig().AddPortableFactory(666, &portableFactory{})
+	config.SerializationConfig().AddStruct(reflect.TypeOf(&structEmployee{}), 667, 1)
+	config.SerializationConfig().AddStruct(reflect.TypeOf(structAddress{}), 667, 2)
 	client, _ = hazelcast.NewClientWithConfig(config)
 	mp, _ = client.GetMap("myMap")
 	predicateTestInit()
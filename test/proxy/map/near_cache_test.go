@@ -0,0 +1,89 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package map1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/config"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/test/assert"
+)
+
+func newNearCachedMap(t *testing.T, name string) (hazelcast.Instance, core.Map) {
+	cfg := hazelcast.NewConfig()
+	cfg.AddNearCacheConfig(config.NewNearCacheConfig(name))
+	nearCachedClient, err := hazelcast.NewClientWithConfig(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nearCachedMap, err := nearCachedClient.GetMap(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return nearCachedClient, nearCachedMap
+}
+
+func TestMapProxy_NearCacheStatsWithoutNearCache(t *testing.T) {
+	if mp.NearCacheStats() != nil {
+		t.Fatal("NearCacheStats should be nil when the Near Cache is not configured")
+	}
+}
+
+func TestNearCachedMapProxy_GetIsServedFromNearCache(t *testing.T) {
+	nearCachedClient, nearCachedMap := newNearCachedMap(t, "nearCachedMap")
+	defer nearCachedClient.Shutdown()
+	defer nearCachedMap.Clear()
+	nearCachedMap.Put("key", "value")
+	for i := 0; i < 3; i++ {
+		value, err := nearCachedMap.Get("key")
+		assert.Equalf(t, err, value, "value", "nearCachedMap Get() failed")
+	}
+	stats := nearCachedMap.NearCacheStats()
+	assert.Equalf(t, nil, stats.Misses(), int64(1), "nearCachedMap Get() should miss once")
+	assert.Equalf(t, nil, stats.Hits(), int64(2), "nearCachedMap Get() should hit after the first call")
+	assert.Equalf(t, nil, stats.OwnedEntryCount(), int64(1), "nearCachedMap should cache the entry")
+}
+
+func TestNearCachedMapProxy_LocalPutInvalidates(t *testing.T) {
+	nearCachedClient, nearCachedMap := newNearCachedMap(t, "nearCachedMapLocalPut")
+	defer nearCachedClient.Shutdown()
+	defer nearCachedMap.Clear()
+	nearCachedMap.Put("key", "value")
+	nearCachedMap.Get("key")
+	nearCachedMap.Put("key", "newValue")
+	value, err := nearCachedMap.Get("key")
+	assert.Equalf(t, err, value, "newValue", "nearCachedMap Get() returned a stale value")
+}
+
+func TestNearCachedMapProxy_RemotePutInvalidates(t *testing.T) {
+	nearCachedClient, nearCachedMap := newNearCachedMap(t, "nearCachedMapRemotePut")
+	defer nearCachedClient.Shutdown()
+	defer nearCachedMap.Clear()
+	otherMap, _ := client.GetMap("nearCachedMapRemotePut")
+	otherMap.Put("key", "value")
+	nearCachedMap.Get("key")
+	otherMap.Put("key", "newValue")
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		if value, _ := nearCachedMap.Get("key"); value == "newValue" {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Fatal("nearCachedMap entry was not invalidated after a remote update")
+}
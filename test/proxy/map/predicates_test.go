@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/hazelcast/hazelcast-go-client/config"
+	"github.com/hazelcast/hazelcast-go-client/core"
 	"github.com/hazelcast/hazelcast-go-client/core/predicate"
 	"github.com/hazelcast/hazelcast-go-client/internal/serialization"
 )
@@ -294,3 +295,47 @@ func TestTrue(t *testing.T) {
 	testSerialization(t, true)
 	testPredicate(t, true, expecteds)
 }
+
+func TestPaging(t *testing.T) {
+	paging := predicate.Paging(predicate.GreaterEqual("this", int32(40)), 4, nil)
+	testSerialization(t, paging)
+	expectedPages := [][]interface{}{
+		{int32(40), int32(41), int32(42), int32(43)},
+		{int32(44), int32(45), int32(46), int32(47)},
+		{int32(48), int32(49)},
+		{},
+	}
+	for _, expected := range expectedPages {
+		values, err := mp2.ValuesWithPredicate(paging)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(values, expected) {
+			t.Errorf("Paging failed on page %d, expected %v got %v", paging.Page(), expected, values)
+		}
+		paging.NextPage()
+	}
+	paging.SetPage(1)
+	keySet, err := mp2.KeySetWithPredicate(paging)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(keySet, []interface{}{"key44", "key45", "key46", "key47"}) {
+		t.Errorf("Paging failed for KeySetWithPredicate, got %v", keySet)
+	}
+	paging.PreviousPage()
+	entries, err := mp2.EntrySetWithPredicate(paging)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 4 || entries[0].Key() != "key40" || entries[0].Value() != int32(40) {
+		t.Errorf("Paging failed for EntrySetWithPredicate, got %v", entries)
+	}
+}
+
+func TestPagingWithInvalidPageSize(t *testing.T) {
+	_, err := mp2.ValuesWithPredicate(predicate.Paging(nil, 0, nil))
+	if _, ok := err.(*core.HazelcastIllegalArgumentError); !ok {
+		t.Errorf("expected HazelcastIllegalArgumentError, got %v", err)
+	}
+}
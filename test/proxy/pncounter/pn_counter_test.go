@@ -15,6 +15,7 @@
 package pncounter
 
 import (
+	"context"
 	"log"
 	"sync"
 	"testing"
@@ -109,6 +110,16 @@ func TestPNCounter_AddAndGet(t *testing.T) {
 	assert.Equalf(t, err, updatedValue, delta, "PNCounter.AddAndGet failed")
 }
 
+func TestPNCounter_WithContext(t *testing.T) {
+	defer destroyAndCreate()
+	ctx, cancel := context.WithTimeout(context.Background(), test.Timeout)
+	defer cancel()
+	updatedValue, err := counter.WithContext(ctx).AddAndGet(5)
+	assert.Equalf(t, err, updatedValue, int64(5), "PNCounter.WithContext failed")
+	value, err := counter.Get()
+	assert.Equalf(t, err, value, int64(5), "PNCounter.WithContext should share the session of the counter")
+}
+
 func TestPNCounter_GetAndSubtract(t *testing.T) {
 	defer destroyAndCreate()
 	var delta int64 = 5
@@ -15,6 +15,7 @@
 package queue
 
 import (
+	"context"
 	"log"
 	"sync"
 	"testing"
@@ -428,3 +429,23 @@ func (l *itemListener) ItemRemoved(event core.ItemEvent) {
 	l.event = event
 	l.wg.Done()
 }
+
+func TestQueueProxy_TakeWithContextTimeout(t *testing.T) {
+	defer queue.Clear()
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := queue.WithContext(ctx).Take()
+	if _, ok := err.(*core.HazelcastTimeoutError); !ok {
+		t.Fatalf("queue Take() with context should return HazelcastTimeoutError, got %v", err)
+	}
+}
+
+func TestQueueProxy_TakeWithCancelledContext(t *testing.T) {
+	defer queue.Clear()
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+	_, err := queue.WithContext(ctx).Take()
+	if _, ok := err.(*core.HazelcastCancellationError); !ok {
+		t.Fatalf("queue Take() with context should return HazelcastCancellationError, got %v", err)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reliabletopic
+
+import (
+	"log"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/rc"
+	"github.com/hazelcast/hazelcast-go-client/test"
+	"github.com/hazelcast/hazelcast-go-client/test/assert"
+)
+
+var topic core.Topic
+var client hazelcast.Instance
+
+func TestMain(m *testing.M) {
+	remoteController, err := rc.NewRemoteControllerClient("localhost:9701")
+	if remoteController == nil || err != nil {
+		log.Fatal("create remote controller failed:", err)
+	}
+	cluster, _ := remoteController.CreateCluster("", test.DefaultServerConfig)
+	remoteController.StartMember(cluster.ID)
+	client, _ = hazelcast.NewClient()
+	topic, _ = client.GetReliableTopic("myReliableTopic")
+	m.Run()
+	topic.Destroy()
+	client.Shutdown()
+	remoteController.ShutdownCluster(cluster.ID)
+}
+
+func TestReliableTopicProxy_ServiceName(t *testing.T) {
+	if bufutil.ServiceNameReliableTopic != topic.ServiceName() {
+		t.Error("ReliableTopic.ServiceName failed")
+	}
+}
+
+func TestReliableTopicProxy_AddListener(t *testing.T) {
+	var wg = new(sync.WaitGroup)
+	wg.Add(1)
+	listener := &topicMessageListener{wg: wg}
+	registrationID, err := topic.AddMessageListener(listener)
+	defer topic.RemoveMessageListener(registrationID)
+	assert.Nilf(t, err, nil, "reliable topic AddListener() failed")
+	topic.Publish("item-value")
+	timeout := test.WaitTimeout(wg, test.Timeout)
+	assert.Equalf(t, nil, false, timeout, "reliable topic AddListener() failed")
+	assert.Equalf(t, nil, listener.messages[0], "item-value", "reliable topic AddListener() failed")
+	if !listener.publishTime.After(time.Time{}) {
+		t.Fatal("publishTime should be greater than 0")
+	}
+}
+
+func TestReliableTopicProxy_AddListenerNil(t *testing.T) {
+	_, err := topic.AddMessageListener(nil)
+	if _, ok := err.(*core.HazelcastNilPointerError); !ok {
+		t.Errorf("expected HazelcastNilPointerError, got %v", err)
+	}
+}
+
+func TestReliableTopicProxy_RemoveListener(t *testing.T) {
+	var wg = new(sync.WaitGroup)
+	wg.Add(1)
+	listener := &topicMessageListener{wg: wg}
+	registrationID, err := topic.AddMessageListener(listener)
+	assert.Nilf(t, err, nil, "reliable topic AddListener() failed")
+	removed, err := topic.RemoveMessageListener(registrationID)
+	assert.Equalf(t, err, removed, true, "reliable topic RemoveListener() failed")
+	topic.Publish("item-value")
+	timeout := test.WaitTimeout(wg, test.Timeout/10)
+	assert.Equalf(t, nil, true, timeout, "reliable topic RemoveListener() failed")
+}
+
+func TestReliableTopicProxy_ReliableListenerStartsFromInitialSequence(t *testing.T) {
+	reliableTopic, _ := client.GetReliableTopic("initialSequenceTopic")
+	defer reliableTopic.Destroy()
+	for _, item := range []string{"item-0", "item-1", "item-2"} {
+		assert.ErrorNil(t, reliableTopic.Publish(item))
+	}
+	var wg = new(sync.WaitGroup)
+	wg.Add(2)
+	listener := &reliableTopicMessageListener{topicMessageListener: topicMessageListener{wg: wg}, initialSequence: 1}
+	registrationID, err := reliableTopic.AddMessageListener(listener)
+	defer reliableTopic.RemoveMessageListener(registrationID)
+	assert.Nilf(t, err, nil, "reliable topic AddListener() failed")
+	timeout := test.WaitTimeout(wg, test.Timeout)
+	assert.Equalf(t, nil, false, timeout, "reliable topic AddListener() failed")
+	assert.Equalf(t, nil, listener.messages, []interface{}{"item-1", "item-2"}, "reliable topic AddListener() failed")
+	assert.Equalf(t, nil, listener.sequences, []int64{1, 2}, "reliable topic StoreSequence() failed")
+}
+
+type topicMessageListener struct {
+	wg          *sync.WaitGroup
+	messages    []interface{}
+	publishTime time.Time
+}
+
+func (l *topicMessageListener) OnMessage(message core.Message) {
+	l.messages = append(l.messages, message.MessageObject())
+	l.publishTime = message.PublishTime()
+	l.wg.Done()
+}
+
+type reliableTopicMessageListener struct {
+	topicMessageListener
+	initialSequence int64
+	sequences       []int64
+}
+
+func (l *reliableTopicMessageListener) RetrieveInitialSequence() int64 {
+	return l.initialSequence
+}
+
+func (l *reliableTopicMessageListener) StoreSequence(sequence int64) {
+	l.sequences = append(l.sequences, sequence)
+}
+
+func (l *reliableTopicMessageListener) IsLossTolerant() bool {
+	return false
+}
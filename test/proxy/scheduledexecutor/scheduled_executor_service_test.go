@@ -0,0 +1,100 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduledexecutor
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/rc"
+	"github.com/hazelcast/hazelcast-go-client/test"
+)
+
+var scheduler core.ScheduledExecutorService
+var client hazelcast.Instance
+
+const schedulerName = "myScheduler"
+
+var remoteController *rc.RemoteControllerClient
+var cluster *rc.Cluster
+var err error
+
+func TestMain(m *testing.M) {
+	remoteController, err = rc.NewRemoteControllerClient("localhost:9701")
+	if remoteController == nil || err != nil {
+		log.Fatal("create remote controller failed:", err)
+	}
+	cluster, err = remoteController.CreateCluster("", test.DefaultServerConfig)
+	remoteController.StartMember(cluster.ID)
+	client, _ = hazelcast.NewClient()
+	scheduler, _ = client.GetScheduledExecutorService(schedulerName)
+	m.Run()
+	scheduler.Destroy()
+	client.Shutdown()
+	remoteController.ShutdownCluster(cluster.ID)
+}
+
+func TestScheduledExecutorService_ServiceName(t *testing.T) {
+	if bufutil.ServiceNameScheduledExecutor != scheduler.ServiceName() {
+		t.Error("ScheduledExecutorService.ServiceName failed")
+	}
+}
+
+func TestScheduledExecutorService_ScheduleNilTask(t *testing.T) {
+	_, err := scheduler.Schedule(nil, time.Second)
+	if _, ok := err.(*core.HazelcastNilPointerError); !ok {
+		t.Errorf("expected HazelcastNilPointerError, got %v", err)
+	}
+}
+
+func TestScheduledExecutorService_ScheduleAtFixedRateWithNonPositivePeriod(t *testing.T) {
+	_, err := scheduler.ScheduleAtFixedRate("task", time.Second, 0)
+	if _, ok := err.(*core.HazelcastIllegalArgumentError); !ok {
+		t.Errorf("expected HazelcastIllegalArgumentError, got %v", err)
+	}
+}
+
+func TestScheduledExecutorService_GetScheduledFutureWithInvalidURN(t *testing.T) {
+	_, err := scheduler.GetScheduledFuture("invalid")
+	if _, ok := err.(*core.HazelcastIllegalArgumentError); !ok {
+		t.Errorf("expected HazelcastIllegalArgumentError, got %v", err)
+	}
+}
+
+func TestScheduledExecutorService_GetAllScheduledFuturesWhenEmpty(t *testing.T) {
+	emptyScheduler, _ := client.GetScheduledExecutorService("emptyScheduler")
+	defer emptyScheduler.Destroy()
+	futures, err := emptyScheduler.GetAllScheduledFutures()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for member, memberFutures := range futures {
+		if len(memberFutures) != 0 {
+			t.Errorf("expected no scheduled futures on %v, got %d", member, len(memberFutures))
+		}
+	}
+}
+
+func TestScheduledExecutorService_Shutdown(t *testing.T) {
+	shutdownScheduler, _ := client.GetScheduledExecutorService("shutdownScheduler")
+	defer shutdownScheduler.Destroy()
+	if err := shutdownScheduler.Shutdown(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semaphore
+
+import (
+	"context"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+	"github.com/hazelcast/hazelcast-go-client/rc"
+	"github.com/hazelcast/hazelcast-go-client/test"
+	"github.com/hazelcast/hazelcast-go-client/test/assert"
+)
+
+var semaphore core.Semaphore
+var client hazelcast.Instance
+
+const semaphoreName = "mySemaphore"
+
+var remoteController *rc.RemoteControllerClient
+var cluster *rc.Cluster
+var err error
+
+func TestMain(m *testing.M) {
+	remoteController, err = rc.NewRemoteControllerClient("localhost:9701")
+	if remoteController == nil || err != nil {
+		log.Fatal("create remote controller failed:", err)
+	}
+	cluster, err = remoteController.CreateCluster("", test.DefaultServerConfig)
+	remoteController.StartMember(cluster.ID)
+	client, _ = hazelcast.NewClient()
+	semaphore, _ = client.GetSemaphore(semaphoreName)
+	m.Run()
+	semaphore.Destroy()
+	client.Shutdown()
+	remoteController.ShutdownCluster(cluster.ID)
+}
+
+func destroyAndCreate() {
+	semaphore.Destroy()
+	semaphore, _ = client.GetSemaphore(semaphoreName)
+}
+
+func TestSemaphore_ServiceName(t *testing.T) {
+	if bufutil.ServiceNameSemaphore != semaphore.ServiceName() {
+		t.Error("Semaphore.ServiceName failed")
+	}
+}
+
+func TestSemaphore_Init(t *testing.T) {
+	defer destroyAndCreate()
+	initialized, err := semaphore.Init(5)
+	assert.Equalf(t, err, initialized, true, "Semaphore.Init failed")
+	initialized, err = semaphore.Init(10)
+	assert.Equalf(t, err, initialized, false, "Semaphore.Init should fail when already initialized")
+	permits, err := semaphore.AvailablePermits()
+	assert.Equalf(t, err, permits, int32(5), "Semaphore.AvailablePermits failed")
+}
+
+func TestSemaphore_AcquireAndRelease(t *testing.T) {
+	defer destroyAndCreate()
+	semaphore.Init(5)
+	err := semaphore.Acquire(3)
+	assert.ErrorNil(t, err)
+	permits, err := semaphore.AvailablePermits()
+	assert.Equalf(t, err, permits, int32(2), "Semaphore.Acquire failed")
+	err = semaphore.Release(3)
+	assert.ErrorNil(t, err)
+	permits, err = semaphore.AvailablePermits()
+	assert.Equalf(t, err, permits, int32(5), "Semaphore.Release failed")
+}
+
+func TestSemaphore_TryAcquire(t *testing.T) {
+	defer destroyAndCreate()
+	semaphore.Init(1)
+	acquired, err := semaphore.TryAcquire(2, 100*time.Millisecond)
+	assert.Equalf(t, err, acquired, false, "Semaphore.TryAcquire should fail without enough permits")
+	acquired, err = semaphore.TryAcquire(1, 0)
+	assert.Equalf(t, err, acquired, true, "Semaphore.TryAcquire failed")
+}
+
+func TestSemaphore_DrainPermits(t *testing.T) {
+	defer destroyAndCreate()
+	semaphore.Init(5)
+	drained, err := semaphore.DrainPermits()
+	assert.Equalf(t, err, drained, int32(5), "Semaphore.DrainPermits failed")
+	permits, err := semaphore.AvailablePermits()
+	assert.Equalf(t, err, permits, int32(0), "Semaphore.DrainPermits failed")
+}
+
+func TestSemaphore_ReducePermits(t *testing.T) {
+	defer destroyAndCreate()
+	semaphore.Init(5)
+	err := semaphore.ReducePermits(2)
+	assert.ErrorNil(t, err)
+	permits, err := semaphore.AvailablePermits()
+	assert.Equalf(t, err, permits, int32(3), "Semaphore.ReducePermits failed")
+}
+
+func TestSemaphore_NegativePermits(t *testing.T) {
+	err := semaphore.Acquire(-1)
+	if _, ok := err.(*core.HazelcastIllegalArgumentError); !ok {
+		t.Errorf("expected HazelcastIllegalArgumentError, got %v", err)
+	}
+}
+
+func TestSemaphore_AcquireWithContextTimeout(t *testing.T) {
+	defer destroyAndCreate()
+	semaphore.Init(1)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err := semaphore.WithContext(ctx).Acquire(2)
+	if _, ok := err.(*core.HazelcastTimeoutError); !ok {
+		t.Fatalf("Semaphore.Acquire() with context should return HazelcastTimeoutError, got %v", err)
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/config/property"
+	"github.com/hazelcast/hazelcast-go-client/test/assert"
+)
+
+func TestStatistics(t *testing.T) {
+	cluster, _ = remoteController.CreateCluster("", DefaultServerConfig)
+	remoteController.StartMember(cluster.ID)
+	defer remoteController.ShutdownCluster(cluster.ID)
+	config := hazelcast.NewConfig()
+	config.SetProperty(property.StatisticsEnabled.Name(), "true")
+	config.SetProperty(property.StatisticsPeriodSeconds.Name(), "1")
+	client, _ := hazelcast.NewClientWithConfig(config)
+	defer client.Shutdown()
+	mp, _ := client.GetMap("statisticsMap")
+	mp.Put("key", "value")
+	mp.Get("key")
+	mp.Get("key")
+
+	stats := client.Statistics()
+	assert.Equalf(t, nil, int64(1), stats.Maps["statisticsMap"].Operations["MapPut"], "Statistics map put count failed")
+	assert.Equalf(t, nil, int64(2), stats.Maps["statisticsMap"].Operations["MapGet"], "Statistics map get count failed")
+	assert.Equalf(t, nil, int64(2), stats.InvocationLatencies["MapGet"].Count, "Statistics latency count failed")
+	assert.Equalf(t, nil, 1, len(stats.Connections), "Statistics connections failed")
+	connection := stats.Connections[0]
+	assert.Equalf(t, nil, true, connection.Owner, "Statistics owner connection failed")
+	assert.Equalf(t, nil, true, connection.BytesSent > 0 && connection.BytesReceived > 0,
+		"Statistics connection bytes failed")
+	assert.Equalf(t, nil, true, connection.MessagesSent >= 3 && connection.MessagesReceived >= 3,
+		"Statistics connection messages failed")
+}
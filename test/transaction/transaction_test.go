@@ -0,0 +1,162 @@
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transaction
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/rc"
+	"github.com/hazelcast/hazelcast-go-client/test"
+	"github.com/hazelcast/hazelcast-go-client/test/assert"
+)
+
+var client hazelcast.Instance
+
+var remoteController *rc.RemoteControllerClient
+var cluster *rc.Cluster
+var err error
+
+func TestMain(m *testing.M) {
+	remoteController, err = rc.NewRemoteControllerClient("localhost:9701")
+	if remoteController == nil || err != nil {
+		log.Fatal("create remote controller failed:", err)
+	}
+	cluster, err = remoteController.CreateCluster("", test.DefaultServerConfig)
+	remoteController.StartMember(cluster.ID)
+	client, _ = hazelcast.NewClient()
+	m.Run()
+	client.Shutdown()
+	remoteController.ShutdownCluster(cluster.ID)
+}
+
+func beginTransaction(t *testing.T, options *core.TransactionOptions) core.TransactionContext {
+	context := client.NewTransactionContext(options)
+	if err := context.Begin(); err != nil {
+		t.Fatal(err)
+	}
+	return context
+}
+
+func TestTransaction_CommitMap(t *testing.T) {
+	mp, _ := client.GetMap("txnCommitMap")
+	defer mp.Destroy()
+	context := beginTransaction(t, nil)
+	txnMap, err := context.GetMap("txnCommitMap")
+	assert.ErrorNil(t, err)
+	txnMap.Put("key", "value")
+	size, err := mp.Size()
+	assert.Equalf(t, err, size, int32(0), "map should not see uncommitted changes")
+	txnSize, err := txnMap.Size()
+	assert.Equalf(t, err, txnSize, int32(1), "TransactionalMap.Size failed")
+	err = context.Commit()
+	assert.ErrorNil(t, err)
+	value, err := mp.Get("key")
+	assert.Equalf(t, err, value, "value", "TransactionContext.Commit failed")
+}
+
+func TestTransaction_RollbackMap(t *testing.T) {
+	mp, _ := client.GetMap("txnRollbackMap")
+	defer mp.Destroy()
+	context := beginTransaction(t, nil)
+	txnMap, _ := context.GetMap("txnRollbackMap")
+	txnMap.Put("key", "value")
+	err := context.Rollback()
+	assert.ErrorNil(t, err)
+	size, err := mp.Size()
+	assert.Equalf(t, err, size, int32(0), "TransactionContext.Rollback failed")
+}
+
+func TestTransaction_OnePhase(t *testing.T) {
+	mp, _ := client.GetMap("txnOnePhaseMap")
+	defer mp.Destroy()
+	options := core.NewTransactionOptions().SetTransactionType(core.TransactionTypeOnePhase)
+	context := beginTransaction(t, options)
+	txnMap, _ := context.GetMap("txnOnePhaseMap")
+	txnMap.Set("key", "value")
+	err := context.Commit()
+	assert.ErrorNil(t, err)
+	value, err := mp.Get("key")
+	assert.Equalf(t, err, value, "value", "one phase transaction failed")
+}
+
+func TestTransaction_QueueAndList(t *testing.T) {
+	queue, _ := client.GetQueue("txnQueue")
+	defer queue.Destroy()
+	list, _ := client.GetList("txnList")
+	defer list.Destroy()
+	queue.Offer("item")
+	context := beginTransaction(t, nil)
+	txnQueue, _ := context.GetQueue("txnQueue")
+	txnList, _ := context.GetList("txnList")
+	item, err := txnQueue.Poll()
+	assert.Equalf(t, err, item, "item", "TransactionalQueue.Poll failed")
+	changed, err := txnList.Add(item)
+	assert.Equalf(t, err, changed, true, "TransactionalList.Add failed")
+	err = context.Commit()
+	assert.ErrorNil(t, err)
+	queueSize, err := queue.Size()
+	assert.Equalf(t, err, queueSize, int32(0), "queue should be empty after commit")
+	listItem, err := list.Get(0)
+	assert.Equalf(t, err, listItem, "item", "list should contain the item after commit")
+}
+
+func TestTransaction_SetAndMultiMap(t *testing.T) {
+	set, _ := client.GetSet("txnSet")
+	defer set.Destroy()
+	multiMap, _ := client.GetMultiMap("txnMultiMap")
+	defer multiMap.Destroy()
+	context := beginTransaction(t, nil)
+	txnSet, _ := context.GetSet("txnSet")
+	txnMultiMap, _ := context.GetMultiMap("txnMultiMap")
+	txnSet.Add("item")
+	txnMultiMap.Put("key", "value1")
+	txnMultiMap.Put("key", "value2")
+	valueCount, err := txnMultiMap.ValueCount("key")
+	assert.Equalf(t, err, valueCount, int32(2), "TransactionalMultiMap.ValueCount failed")
+	removed, err := txnMultiMap.Remove("key", "value1")
+	assert.Equalf(t, err, removed, true, "TransactionalMultiMap.Remove failed")
+	err = context.Commit()
+	assert.ErrorNil(t, err)
+	found, err := set.Contains("item")
+	assert.Equalf(t, err, found, true, "set should contain the item after commit")
+	values, err := multiMap.Get("key")
+	assert.Equalf(t, err, len(values), 1, "multi-map should contain one value after commit")
+}
+
+func TestTransaction_OperationAfterCommit(t *testing.T) {
+	context := beginTransaction(t, nil)
+	txnMap, _ := context.GetMap("txnAfterCommitMap")
+	context.Commit()
+	_, err := txnMap.Put("key", "value")
+	if _, ok := err.(*core.HazelcastTransactionError); !ok {
+		t.Errorf("expected HazelcastTransactionError, got %v", err)
+	}
+}
+
+func TestTransaction_CommitAfterTimeout(t *testing.T) {
+	options := core.NewTransactionOptions().SetTimeout(100 * time.Millisecond)
+	context := beginTransaction(t, options)
+	time.Sleep(200 * time.Millisecond)
+	err := context.Commit()
+	if _, ok := err.(*core.HazelcastTransactionError); !ok {
+		t.Errorf("expected HazelcastTransactionError, got %v", err)
+	}
+	err = context.Rollback()
+	assert.ErrorNil(t, err)
+}
@@ -0,0 +1,139 @@
+//go:build go1.18
+// +build go1.18
+
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typed
+
+import (
+	"log"
+	"sync"
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/rc"
+	"github.com/hazelcast/hazelcast-go-client/test"
+	"github.com/hazelcast/hazelcast-go-client/test/assert"
+	"github.com/hazelcast/hazelcast-go-client/typed"
+)
+
+var client hazelcast.Instance
+
+func TestMain(m *testing.M) {
+	remoteController, err := rc.NewRemoteControllerClient("localhost:9701")
+	if remoteController == nil || err != nil {
+		log.Fatal("create remote controller failed:", err)
+	}
+	cluster, _ := remoteController.CreateCluster("", test.DefaultServerConfig)
+	remoteController.StartMember(cluster.ID)
+	client, _ = hazelcast.NewClient()
+	m.Run()
+	client.Shutdown()
+	remoteController.ShutdownCluster(cluster.ID)
+}
+
+func TestMap(t *testing.T) {
+	m, err := typed.GetMap[string, int](client, "typedMap")
+	assert.ErrorNil(t, err)
+	defer m.Clear()
+	oldValue, err := m.Put("one", 1)
+	assert.Equalf(t, err, oldValue, 0, "Map.Put() failed")
+	value, err := m.Get("one")
+	assert.Equalf(t, err, value, 1, "Map.Get() failed")
+	value, err = m.Get("missing")
+	assert.Equalf(t, err, value, 0, "Map.Get() failed")
+	assert.ErrorNil(t, m.PutAll(map[string]int{"two": 2, "three": 3}))
+	entries, err := m.GetAll([]string{"one", "three", "missing"})
+	assert.Equalf(t, err, entries, map[string]int{"one": 1, "three": 3}, "Map.GetAll() failed")
+	values, err := m.Values()
+	assert.Equalf(t, err, len(values), 3, "Map.Values() failed")
+	sum := 0
+	assert.ErrorNil(t, m.Range(func(key string, value int) bool {
+		sum += value
+		return true
+	}))
+	assert.Equalf(t, nil, sum, 6, "Map.Range() failed")
+}
+
+func TestMap_DecodeError(t *testing.T) {
+	m, err := typed.GetMap[string, int](client, "typedMapDecode")
+	assert.ErrorNil(t, err)
+	defer m.Clear()
+	assert.ErrorNil(t, m.Unwrap().Set("key", "not an int"))
+	_, err = m.Get("key")
+	if _, ok := err.(*typed.DecodeError); !ok {
+		t.Fatalf("expected *typed.DecodeError, got %v", err)
+	}
+}
+
+func TestMap_EntryListener(t *testing.T) {
+	m, err := typed.GetMap[string, int](client, "typedMapListener")
+	assert.ErrorNil(t, err)
+	defer m.Clear()
+	wg := new(sync.WaitGroup)
+	wg.Add(2)
+	var added, updated typed.EntryEvent[string, int]
+	registrationID, err := m.AddEntryListener(&typed.EntryListener[string, int]{
+		Added: func(event typed.EntryEvent[string, int]) {
+			added = event
+			wg.Done()
+		},
+		Updated: func(event typed.EntryEvent[string, int]) {
+			updated = event
+			wg.Done()
+		},
+	}, true)
+	assert.ErrorNil(t, err)
+	defer m.RemoveEntryListener(registrationID)
+	m.Put("key", 1)
+	m.Put("key", 2)
+	timeout := test.WaitTimeout(wg, test.Timeout)
+	assert.Equalf(t, nil, false, timeout, "Map.AddEntryListener() failed")
+	assert.Equalf(t, nil, added.Value, 1, "Map.AddEntryListener() failed")
+	assert.Equalf(t, nil, updated.Value, 2, "Map.AddEntryListener() failed")
+	assert.Equalf(t, nil, updated.OldValue, 1, "Map.AddEntryListener() failed")
+}
+
+func TestQueue(t *testing.T) {
+	q, err := typed.GetQueue[int32](client, "typedQueue")
+	assert.ErrorNil(t, err)
+	defer q.Clear()
+	changed, err := q.AddAll([]int32{1, 2, 3})
+	assert.Equalf(t, err, changed, true, "Queue.AddAll() failed")
+	items, err := q.ToSlice()
+	assert.Equalf(t, err, items, []int32{1, 2, 3}, "Queue.ToSlice() failed")
+	item, err := q.Poll()
+	assert.Equalf(t, err, item, int32(1), "Queue.Poll() failed")
+}
+
+func TestTopic(t *testing.T) {
+	topic, err := typed.GetTopic[string](client, "typedTopic")
+	assert.ErrorNil(t, err)
+	wg := new(sync.WaitGroup)
+	wg.Add(1)
+	var received string
+	registrationID, err := topic.AddMessageListener(&typed.MessageListener[string]{
+		Message: func(message typed.Message[string]) {
+			received = message.Value
+			wg.Done()
+		},
+	})
+	assert.ErrorNil(t, err)
+	defer topic.RemoveMessageListener(registrationID)
+	assert.ErrorNil(t, topic.Publish("message"))
+	timeout := test.WaitTimeout(wg, test.Timeout)
+	assert.Equalf(t, nil, false, timeout, "Topic.AddMessageListener() failed")
+	assert.Equalf(t, nil, received, "message", "Topic.AddMessageListener() failed")
+}
@@ -15,9 +15,12 @@
 package test
 
 import (
+	"fmt"
 	"io/ioutil"
 	"sync"
 	"time"
+
+	"github.com/hazelcast/hazelcast-go-client/rc"
 )
 
 var Timeout = 1 * time.Minute
@@ -81,6 +84,10 @@ const DefaultServerConfig = `
         <capacity>10</capacity>
         <time-to-live-seconds>180</time-to-live-seconds>
     </ringbuffer>
+    <event-journal enabled="true">
+        <mapName>EventJournalTest*</mapName>
+        <capacity>10000</capacity>
+    </event-journal>
 </hazelcast>
 `
 
@@ -89,6 +96,21 @@ func Read(filename string) (string, error) {
 	return string(bytes), err
 }
 
+// ClusterVersionAtLeast reports whether the cluster version of the members is major.minor or newer.
+func ClusterVersionAtLeast(remoteController rc.RemoteController, clusterID string, major, minor int) bool {
+	script := "var version = instance_0.getCluster().getClusterVersion();" +
+		"result = \"\" + version.getMajor() + \" \" + version.getMinor();"
+	response, err := remoteController.ExecuteOnController(clusterID, script, rc.Lang_JAVASCRIPT)
+	if err != nil || !response.Success {
+		return false
+	}
+	var clusterMajor, clusterMinor int
+	if _, err := fmt.Sscan(string(response.Result_), &clusterMajor, &clusterMinor); err != nil {
+		return false
+	}
+	return clusterMajor > major || clusterMajor == major && clusterMinor >= minor
+}
+
 func WaitTimeout(wg *sync.WaitGroup, timeout time.Duration) bool {
 	c := make(chan struct{})
 	go func() {
@@ -0,0 +1,106 @@
+//go:build go1.18
+// +build go1.18
+
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package typed provides generic views of the distributed data structures.
+// The views convert the values read from the cluster to the type parameters and
+// return a *DecodeError, instead of panicking on a failed type assertion, when a value
+// cannot be represented as the requested type.
+// The package is built only with Go 1.18 or newer, since it uses type parameters.
+package typed
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+)
+
+// DecodeError is returned when a value read from the cluster cannot be converted to the
+// type parameter of a typed view. It is also a core.HazelcastSerializationError.
+type DecodeError struct {
+	*core.HazelcastSerializationError
+
+	// Value is the deserialized value that could not be converted.
+	Value interface{}
+
+	// Type is the type that the value was expected to have.
+	Type reflect.Type
+}
+
+func newDecodeError(value interface{}, typ reflect.Type) *DecodeError {
+	return &DecodeError{
+		HazelcastSerializationError: core.NewHazelcastSerializationError(
+			fmt.Sprintf("cannot decode value of type %T as %v", value, typ), nil),
+		Value: value,
+		Type:  typ,
+	}
+}
+
+// decode converts a deserialized value to T. A nil value is decoded as the zero value of T.
+// Numeric values are converted between the numeric types as long as the conversion is lossless,
+// since, for example, an int is deserialized as an int64.
+func decode[T any](raw interface{}) (T, error) {
+	var zero T
+	if raw == nil {
+		return zero, nil
+	}
+	if value, ok := raw.(T); ok {
+		return value, nil
+	}
+	typ := reflect.TypeOf(&zero).Elem()
+	rawValue := reflect.ValueOf(raw)
+	if isNumeric(rawValue.Kind()) && isNumeric(typ.Kind()) {
+		converted := rawValue.Convert(typ)
+		if isNegative(converted) == isNegative(rawValue) &&
+			converted.Convert(rawValue.Type()).Interface() == raw {
+			return converted.Interface().(T), nil
+		}
+	}
+	return zero, newDecodeError(raw, typ)
+}
+
+func decodeSlice[T any](raws []interface{}) ([]T, error) {
+	values := make([]T, len(raws))
+	for i, raw := range raws {
+		value, err := decode[T](raw)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+func isNumeric(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func isNegative(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int() < 0
+	case reflect.Float32, reflect.Float64:
+		return value.Float() < 0
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+//go:build go1.18
+// +build go1.18
+
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typed
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+)
+
+type id int64
+
+type named interface {
+	Name() string
+}
+
+type person struct {
+	name string
+}
+
+func (p *person) Name() string {
+	return p.name
+}
+
+func TestDecode(t *testing.T) {
+	p := &person{name: "Joe"}
+	testCases := []struct {
+		name     string
+		decode   func() (interface{}, error)
+		expected interface{}
+	}{
+		{"nilAsZero", func() (interface{}, error) { return decode[string](nil) }, ""},
+		{"sameType", func() (interface{}, error) { return decode[string]("a") }, "a"},
+		{"int64AsInt", func() (interface{}, error) { return decode[int](int64(3)) }, 3},
+		{"int64AsNamedType", func() (interface{}, error) { return decode[id](int64(3)) }, id(3)},
+		{"int32AsFloat64", func() (interface{}, error) { return decode[float64](int32(-3)) }, float64(-3)},
+		{"integralFloatAsInt32", func() (interface{}, error) { return decode[int32](2.0) }, int32(2)},
+		{"interface", func() (interface{}, error) { return decode[named](p) }, p},
+		{"any", func() (interface{}, error) { return decode[interface{}](int16(1)) }, int16(1)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value, err := tc.decode()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if value != tc.expected {
+				t.Errorf("expected %v (%T), got %v (%T)", tc.expected, tc.expected, value, value)
+			}
+		})
+	}
+}
+
+func TestDecode_Error(t *testing.T) {
+	testCases := []struct {
+		name   string
+		decode func() error
+		raw    interface{}
+		typ    reflect.Type
+	}{
+		{"stringAsInt", func() error { _, err := decode[int]("1"); return err }, "1", reflect.TypeOf(0)},
+		{"overflow", func() error { _, err := decode[int8](int64(300)); return err }, int64(300), reflect.TypeOf(int8(0))},
+		{"negativeAsUnsigned", func() error { _, err := decode[uint64](int64(-1)); return err }, int64(-1),
+			reflect.TypeOf(uint64(0))},
+		{"fraction", func() error { _, err := decode[int64](1.5); return err }, 1.5, reflect.TypeOf(int64(0))},
+		{"notImplemented", func() error { _, err := decode[named]("Joe"); return err }, "Joe",
+			reflect.TypeOf((*named)(nil)).Elem()},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.decode()
+			decodeErr, ok := err.(*DecodeError)
+			if !ok {
+				t.Fatalf("expected *DecodeError, got %v", err)
+			}
+			if decodeErr.Value != tc.raw || decodeErr.Type != tc.typ {
+				t.Errorf("expected %v as %v, got %v as %v", tc.raw, tc.typ, decodeErr.Value, decodeErr.Type)
+			}
+			var serializationErr core.HazelcastError = decodeErr.HazelcastSerializationError
+			if serializationErr.Error() != decodeErr.Error() {
+				t.Errorf("unexpected error message: %s", decodeErr.Error())
+			}
+		})
+	}
+}
+
+func TestDecodeSlice(t *testing.T) {
+	values, err := decodeSlice[int]([]interface{}{int64(1), nil, int32(3)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(values, []int{1, 0, 3}) {
+		t.Errorf("unexpected values: %v", values)
+	}
+	if _, err := decodeSlice[int]([]interface{}{int64(1), "2"}); err == nil {
+		t.Error("expected a decode error")
+	}
+}
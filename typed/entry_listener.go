@@ -0,0 +1,166 @@
+//go:build go1.18
+// +build go1.18
+
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typed
+
+import (
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+)
+
+// EntryEvent is an entry event of a Map with the key and the values decoded.
+type EntryEvent[K comparable, V any] struct {
+	// Name is the name of the map.
+	Name string
+
+	// Member is the member that fired the event.
+	Member core.Member
+
+	// EventType is the type of the event.
+	EventType int32
+
+	Key          K
+	Value        V
+	OldValue     V
+	MergingValue V
+}
+
+// EntryListener is a set of callbacks for the entry events of a Map.
+// The listener is registered only for the events whose callback is set,
+// so at least one of them must be set.
+type EntryListener[K comparable, V any] struct {
+	Added   func(event EntryEvent[K, V])
+	Removed func(event EntryEvent[K, V])
+	Updated func(event EntryEvent[K, V])
+	Evicted func(event EntryEvent[K, V])
+	Expired func(event EntryEvent[K, V])
+	Merged  func(event EntryEvent[K, V])
+
+	// MapEvicted is called when all the entries are evicted by Map.EvictAll.
+	MapEvicted func(event core.MapEvent)
+
+	// MapCleared is called when all the entries are removed by Map.Clear.
+	MapCleared func(event core.MapEvent)
+
+	// DecodeFailed is called with a *DecodeError when the key or a value of an event
+	// cannot be decoded. The event is dropped if it is not set.
+	DecodeFailed func(err error)
+}
+
+// entryListenerAdapter implements the untyped listener interfaces for an EntryListener.
+type entryListenerAdapter[K comparable, V any] struct {
+	listener *EntryListener[K, V]
+}
+
+func newEntryListenerAdapter[K comparable, V any](listener *EntryListener[K, V]) interface{} {
+	if listener == nil {
+		return nil
+	}
+	return &entryListenerAdapter[K, V]{listener: listener}
+}
+
+func (a *entryListenerAdapter[K, V]) ListenerFlags() int32 {
+	l := a.listener
+	var flags int32
+	for _, callback := range []struct {
+		set  bool
+		flag int32
+	}{
+		{l.Added != nil, bufutil.EntryEventAdded},
+		{l.Removed != nil, bufutil.EntryEventRemoved},
+		{l.Updated != nil, bufutil.EntryEventUpdated},
+		{l.Evicted != nil, bufutil.EntryEventEvicted},
+		{l.Expired != nil, bufutil.EntryEventExpired},
+		{l.Merged != nil, bufutil.EntryEventMerged},
+		{l.MapEvicted != nil, bufutil.MapEventEvicted},
+		{l.MapCleared != nil, bufutil.MapEventCleared},
+	} {
+		if callback.set {
+			flags |= callback.flag
+		}
+	}
+	return flags
+}
+
+func (a *entryListenerAdapter[K, V]) EntryAdded(event core.EntryEvent) {
+	a.fire(a.listener.Added, event)
+}
+
+func (a *entryListenerAdapter[K, V]) EntryRemoved(event core.EntryEvent) {
+	a.fire(a.listener.Removed, event)
+}
+
+func (a *entryListenerAdapter[K, V]) EntryUpdated(event core.EntryEvent) {
+	a.fire(a.listener.Updated, event)
+}
+
+func (a *entryListenerAdapter[K, V]) EntryEvicted(event core.EntryEvent) {
+	a.fire(a.listener.Evicted, event)
+}
+
+func (a *entryListenerAdapter[K, V]) EntryExpired(event core.EntryEvent) {
+	a.fire(a.listener.Expired, event)
+}
+
+func (a *entryListenerAdapter[K, V]) EntryMerged(event core.EntryEvent) {
+	a.fire(a.listener.Merged, event)
+}
+
+func (a *entryListenerAdapter[K, V]) MapEvicted(event core.MapEvent) {
+	if a.listener.MapEvicted != nil {
+		a.listener.MapEvicted(event)
+	}
+}
+
+func (a *entryListenerAdapter[K, V]) MapCleared(event core.MapEvent) {
+	if a.listener.MapCleared != nil {
+		a.listener.MapCleared(event)
+	}
+}
+
+func (a *entryListenerAdapter[K, V]) fire(callback func(EntryEvent[K, V]), event core.EntryEvent) {
+	if callback == nil {
+		return
+	}
+	typedEvent, err := decodeEntryEvent[K, V](event)
+	if err != nil {
+		if a.listener.DecodeFailed != nil {
+			a.listener.DecodeFailed(err)
+		}
+		return
+	}
+	callback(typedEvent)
+}
+
+func decodeEntryEvent[K comparable, V any](event core.EntryEvent) (typedEvent EntryEvent[K, V], err error) {
+	typedEvent = EntryEvent[K, V]{
+		Name:      event.Name(),
+		Member:    event.Member(),
+		EventType: event.EventType(),
+	}
+	if typedEvent.Key, err = decode[K](event.Key()); err != nil {
+		return
+	}
+	if typedEvent.Value, err = decode[V](event.Value()); err != nil {
+		return
+	}
+	if typedEvent.OldValue, err = decode[V](event.OldValue()); err != nil {
+		return
+	}
+	typedEvent.MergingValue, err = decode[V](event.MergingValue())
+	return
+}
@@ -0,0 +1,73 @@
+//go:build go1.18
+// +build go1.18
+
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typed
+
+import (
+	"testing"
+
+	"github.com/hazelcast/hazelcast-go-client/core"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto"
+	"github.com/hazelcast/hazelcast-go-client/internal/proto/bufutil"
+)
+
+func TestEntryListenerAdapter_ListenerFlags(t *testing.T) {
+	noop := func(EntryEvent[string, int]) {}
+	adapter := newEntryListenerAdapter(&EntryListener[string, int]{Added: noop, Expired: noop,
+		MapCleared: func(core.MapEvent) {}})
+	flags, err := proto.GetMapListenerFlags(adapter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := bufutil.EntryEventAdded | bufutil.EntryEventExpired | bufutil.MapEventCleared; flags != expected {
+		t.Errorf("expected flags %d, got %d", expected, flags)
+	}
+	if _, err := proto.GetMapListenerFlags(newEntryListenerAdapter(&EntryListener[string, int]{})); err == nil {
+		t.Error("expected an error for a listener without callbacks")
+	}
+}
+
+func TestEntryListenerAdapter_Dispatch(t *testing.T) {
+	var added, updated []EntryEvent[string, int]
+	var decodeErrs []error
+	adapter := newEntryListenerAdapter(&EntryListener[string, int]{
+		Added:        func(event EntryEvent[string, int]) { added = append(added, event) },
+		Updated:      func(event EntryEvent[string, int]) { updated = append(updated, event) },
+		DecodeFailed: func(err error) { decodeErrs = append(decodeErrs, err) },
+	})
+	adapter.(core.EntryAddedListener).EntryAdded(proto.NewEntryEvent("m", nil, bufutil.EntryEventAdded,
+		"k", int64(1), nil, nil))
+	adapter.(core.EntryUpdatedListener).EntryUpdated(proto.NewEntryEvent("m", nil, bufutil.EntryEventUpdated,
+		"k", int64(2), int64(1), nil))
+	adapter.(core.EntryUpdatedListener).EntryUpdated(proto.NewEntryEvent("m", nil, bufutil.EntryEventUpdated,
+		"k", "not an int", int64(2), nil))
+	adapter.(core.EntryRemovedListener).EntryRemoved(proto.NewEntryEvent("m", nil, bufutil.EntryEventRemoved,
+		"k", nil, int64(2), nil))
+	if len(added) != 1 || added[0].Key != "k" || added[0].Value != 1 || added[0].Name != "m" {
+		t.Errorf("unexpected added events: %v", added)
+	}
+	if len(updated) != 1 || updated[0].Value != 2 || updated[0].OldValue != 1 ||
+		updated[0].EventType != bufutil.EntryEventUpdated {
+		t.Errorf("unexpected updated events: %v", updated)
+	}
+	if len(decodeErrs) != 1 {
+		t.Fatalf("expected one decode error, got %v", decodeErrs)
+	}
+	if _, ok := decodeErrs[0].(*DecodeError); !ok {
+		t.Errorf("expected *DecodeError, got %T", decodeErrs[0])
+	}
+}
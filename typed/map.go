@@ -0,0 +1,277 @@
+//go:build go1.18
+// +build go1.18
+
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typed
+
+import (
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/core"
+)
+
+// Map is a view of a core.Map whose keys are of type K and values are of type V.
+// A missing value is returned as the zero value of V.
+type Map[K comparable, V any] struct {
+	m core.Map
+}
+
+// NewMap returns a typed view of the given map.
+func NewMap[K comparable, V any](m core.Map) *Map[K, V] {
+	return &Map[K, V]{m: m}
+}
+
+// GetMap returns a typed view of the distributed map with the given name.
+func GetMap[K comparable, V any](client hazelcast.Instance, name string) (*Map[K, V], error) {
+	m, err := client.GetMap(name)
+	if err != nil {
+		return nil, err
+	}
+	return NewMap[K, V](m), nil
+}
+
+// Entry is a key-value pair of a Map.
+type Entry[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// Unwrap returns the underlying untyped map.
+func (m *Map[K, V]) Unwrap() core.Map {
+	return m.m
+}
+
+// Name returns the name of the map.
+func (m *Map[K, V]) Name() string {
+	return m.m.Name()
+}
+
+// Put puts the value for the given key and returns the old value.
+func (m *Map[K, V]) Put(key K, value V) (oldValue V, err error) {
+	return decodeResult[V](m.m.Put(key, value))
+}
+
+// Get returns the value for the given key.
+func (m *Map[K, V]) Get(key K) (value V, err error) {
+	return decodeResult[V](m.m.Get(key))
+}
+
+// Remove removes the mapping for the given key and returns the removed value.
+func (m *Map[K, V]) Remove(key K) (value V, err error) {
+	return decodeResult[V](m.m.Remove(key))
+}
+
+// RemoveIfSame removes the mapping for the given key only if it is mapped to the given value.
+func (m *Map[K, V]) RemoveIfSame(key K, value V) (ok bool, err error) {
+	return m.m.RemoveIfSame(key, value)
+}
+
+// Delete removes the mapping for the given key without returning the old value.
+func (m *Map[K, V]) Delete(key K) (err error) {
+	return m.m.Delete(key)
+}
+
+// ContainsKey returns true if the map contains the given key.
+func (m *Map[K, V]) ContainsKey(key K) (found bool, err error) {
+	return m.m.ContainsKey(key)
+}
+
+// ContainsValue returns true if the map has one or more keys mapped to the given value.
+func (m *Map[K, V]) ContainsValue(value V) (found bool, err error) {
+	return m.m.ContainsValue(value)
+}
+
+// Size returns the number of entries in the map.
+func (m *Map[K, V]) Size() (size int32, err error) {
+	return m.m.Size()
+}
+
+// IsEmpty returns true if the map contains no entries.
+func (m *Map[K, V]) IsEmpty() (empty bool, err error) {
+	return m.m.IsEmpty()
+}
+
+// Clear removes all the entries of the map.
+func (m *Map[K, V]) Clear() (err error) {
+	return m.m.Clear()
+}
+
+// PutIfAbsent puts the value for the given key if the key is not mapped yet,
+// and returns the current value otherwise.
+func (m *Map[K, V]) PutIfAbsent(key K, value V) (oldValue V, err error) {
+	return decodeResult[V](m.m.PutIfAbsent(key, value))
+}
+
+// Replace replaces the value of the given key only if the key is mapped, and returns the old value.
+func (m *Map[K, V]) Replace(key K, value V) (oldValue V, err error) {
+	return decodeResult[V](m.m.Replace(key, value))
+}
+
+// ReplaceIfSame replaces the value of the given key only if it is mapped to oldValue.
+func (m *Map[K, V]) ReplaceIfSame(key K, oldValue V, newValue V) (replaced bool, err error) {
+	return m.m.ReplaceIfSame(key, oldValue, newValue)
+}
+
+// Set puts the value for the given key without returning the old value.
+func (m *Map[K, V]) Set(key K, value V) (err error) {
+	return m.m.Set(key, value)
+}
+
+// SetWithTTL puts the value for the given key with the given time to live.
+func (m *Map[K, V]) SetWithTTL(key K, value V, ttl time.Duration) (err error) {
+	return m.m.SetWithTTL(key, value, ttl)
+}
+
+// PutAll puts all the given entries to the map.
+func (m *Map[K, V]) PutAll(entries map[K]V) (err error) {
+	untyped := make(map[interface{}]interface{}, len(entries))
+	for key, value := range entries {
+		untyped[key] = value
+	}
+	return m.m.PutAll(untyped)
+}
+
+// GetAll returns the entries for the given keys. The keys that are not mapped are not in the result.
+func (m *Map[K, V]) GetAll(keys []K) (entries map[K]V, err error) {
+	untyped, err := m.m.GetAll(toInterfaceSlice(keys))
+	if err != nil {
+		return nil, err
+	}
+	entries = make(map[K]V, len(untyped))
+	for rawKey, rawValue := range untyped {
+		key, err := decode[K](rawKey)
+		if err != nil {
+			return nil, err
+		}
+		value, err := decode[V](rawValue)
+		if err != nil {
+			return nil, err
+		}
+		entries[key] = value
+	}
+	return entries, nil
+}
+
+// KeySet returns the keys of the map.
+func (m *Map[K, V]) KeySet() (keys []K, err error) {
+	return decodeSliceResult[K](m.m.KeySet())
+}
+
+// KeySetWithPredicate returns the keys of the entries that match the given predicate.
+func (m *Map[K, V]) KeySetWithPredicate(predicate interface{}) (keys []K, err error) {
+	return decodeSliceResult[K](m.m.KeySetWithPredicate(predicate))
+}
+
+// Values returns the values of the map.
+func (m *Map[K, V]) Values() (values []V, err error) {
+	return decodeSliceResult[V](m.m.Values())
+}
+
+// ValuesWithPredicate returns the values of the entries that match the given predicate.
+func (m *Map[K, V]) ValuesWithPredicate(predicate interface{}) (values []V, err error) {
+	return decodeSliceResult[V](m.m.ValuesWithPredicate(predicate))
+}
+
+// EntrySet returns the entries of the map.
+func (m *Map[K, V]) EntrySet() (entries []Entry[K, V], err error) {
+	return decodeEntries[K, V](m.m.EntrySet())
+}
+
+// EntrySetWithPredicate returns the entries that match the given predicate.
+func (m *Map[K, V]) EntrySetWithPredicate(predicate interface{}) (entries []Entry[K, V], err error) {
+	return decodeEntries[K, V](m.m.EntrySetWithPredicate(predicate))
+}
+
+// Range calls f for the entries of the map until f returns false, see core.Map.Range.
+// It stops at the first entry that cannot be decoded and returns a *DecodeError for it.
+func (m *Map[K, V]) Range(f func(key K, value V) bool) (err error) {
+	var decodeErr error
+	err = m.m.Range(func(rawKey interface{}, rawValue interface{}) bool {
+		key, err := decode[K](rawKey)
+		if err != nil {
+			decodeErr = err
+			return false
+		}
+		value, err := decode[V](rawValue)
+		if err != nil {
+			decodeErr = err
+			return false
+		}
+		return f(key, value)
+	})
+	if err != nil {
+		return err
+	}
+	return decodeErr
+}
+
+// AddEntryListener adds the listener for the entries of the map. The listener is registered only for
+// the events that it has a callback for. If includeValue is false, the values of the events are zero values.
+func (m *Map[K, V]) AddEntryListener(listener *EntryListener[K, V], includeValue bool) (registrationID string, err error) {
+	return m.m.AddEntryListener(newEntryListenerAdapter(listener), includeValue)
+}
+
+// AddEntryListenerWithPredicate adds the listener for the entries that match the given predicate.
+func (m *Map[K, V]) AddEntryListenerWithPredicate(listener *EntryListener[K, V], predicate interface{},
+	includeValue bool) (registrationID string, err error) {
+	return m.m.AddEntryListenerWithPredicate(newEntryListenerAdapter(listener), predicate, includeValue)
+}
+
+// AddEntryListenerToKey adds the listener for the entry with the given key.
+func (m *Map[K, V]) AddEntryListenerToKey(listener *EntryListener[K, V], key K,
+	includeValue bool) (registrationID string, err error) {
+	return m.m.AddEntryListenerToKey(newEntryListenerAdapter(listener), key, includeValue)
+}
+
+// RemoveEntryListener removes the entry listener with the given registrationID.
+func (m *Map[K, V]) RemoveEntryListener(registrationID string) (removed bool, err error) {
+	return m.m.RemoveEntryListener(registrationID)
+}
+
+func decodeResult[T any](raw interface{}, err error) (T, error) {
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return decode[T](raw)
+}
+
+func decodeSliceResult[T any](raws []interface{}, err error) ([]T, error) {
+	if err != nil {
+		return nil, err
+	}
+	return decodeSlice[T](raws)
+}
+
+func decodeEntries[K comparable, V any](pairs []core.Pair, err error) ([]Entry[K, V], error) {
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]Entry[K, V], len(pairs))
+	for i, pair := range pairs {
+		key, err := decode[K](pair.Key())
+		if err != nil {
+			return nil, err
+		}
+		value, err := decode[V](pair.Value())
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = Entry[K, V]{Key: key, Value: value}
+	}
+	return entries, nil
+}
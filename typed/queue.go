@@ -0,0 +1,213 @@
+//go:build go1.18
+// +build go1.18
+
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typed
+
+import (
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/core"
+)
+
+// Queue is a view of a core.Queue whose items are of type T.
+// Poll and Peek return the zero value of T when the queue is empty.
+type Queue[T any] struct {
+	q core.Queue
+}
+
+// NewQueue returns a typed view of the given queue.
+func NewQueue[T any](q core.Queue) *Queue[T] {
+	return &Queue[T]{q: q}
+}
+
+// GetQueue returns a typed view of the distributed queue with the given name.
+func GetQueue[T any](client hazelcast.Instance, name string) (*Queue[T], error) {
+	q, err := client.GetQueue(name)
+	if err != nil {
+		return nil, err
+	}
+	return NewQueue[T](q), nil
+}
+
+// Unwrap returns the underlying untyped queue.
+func (q *Queue[T]) Unwrap() core.Queue {
+	return q.q
+}
+
+// Name returns the name of the queue.
+func (q *Queue[T]) Name() string {
+	return q.q.Name()
+}
+
+// Offer inserts the item if it is possible without exceeding the capacity of the queue.
+func (q *Queue[T]) Offer(item T) (added bool, err error) {
+	return q.q.Offer(item)
+}
+
+// OfferWithTimeout inserts the item, waiting up to the given timeout for space to become available.
+func (q *Queue[T]) OfferWithTimeout(item T, timeout time.Duration) (added bool, err error) {
+	return q.q.OfferWithTimeout(item, timeout)
+}
+
+// Put inserts the item, waiting for space to become available if necessary.
+func (q *Queue[T]) Put(item T) (err error) {
+	return q.q.Put(item)
+}
+
+// AddAll adds all the given items to the queue.
+func (q *Queue[T]) AddAll(items []T) (changed bool, err error) {
+	return q.q.AddAll(toInterfaceSlice(items))
+}
+
+// Poll retrieves and removes the head of the queue.
+func (q *Queue[T]) Poll() (item T, err error) {
+	return decodeResult[T](q.q.Poll())
+}
+
+// PollWithTimeout retrieves and removes the head of the queue, waiting up to the given timeout
+// for an item to become available.
+func (q *Queue[T]) PollWithTimeout(timeout time.Duration) (item T, err error) {
+	return decodeResult[T](q.q.PollWithTimeout(timeout))
+}
+
+// Take retrieves and removes the head of the queue, waiting until an item becomes available.
+func (q *Queue[T]) Take() (item T, err error) {
+	return decodeResult[T](q.q.Take())
+}
+
+// Peek retrieves, but does not remove, the head of the queue.
+func (q *Queue[T]) Peek() (item T, err error) {
+	return decodeResult[T](q.q.Peek())
+}
+
+// Contains returns true if the queue contains the given item.
+func (q *Queue[T]) Contains(item T) (found bool, err error) {
+	return q.q.Contains(item)
+}
+
+// Remove removes one instance of the given item from the queue.
+func (q *Queue[T]) Remove(item T) (removed bool, err error) {
+	return q.q.Remove(item)
+}
+
+// Size returns the number of items in the queue.
+func (q *Queue[T]) Size() (size int32, err error) {
+	return q.q.Size()
+}
+
+// IsEmpty returns true if the queue contains no items.
+func (q *Queue[T]) IsEmpty() (empty bool, err error) {
+	return q.q.IsEmpty()
+}
+
+// RemainingCapacity returns the number of items that the queue can accept without blocking.
+func (q *Queue[T]) RemainingCapacity() (remainingCapacity int32, err error) {
+	return q.q.RemainingCapacity()
+}
+
+// Clear removes all the items of the queue.
+func (q *Queue[T]) Clear() (err error) {
+	return q.q.Clear()
+}
+
+// ToSlice returns the items of the queue in order.
+func (q *Queue[T]) ToSlice() (items []T, err error) {
+	return decodeSliceResult[T](q.q.ToSlice())
+}
+
+// AddItemListener adds the listener for the items of the queue. If includeValue is false,
+// the items of the events are zero values.
+func (q *Queue[T]) AddItemListener(listener *ItemListener[T], includeValue bool) (registrationID string, err error) {
+	var adapter interface{}
+	if listener != nil {
+		adapter = &itemListenerAdapter[T]{listener: listener}
+	}
+	return q.q.AddItemListener(adapter, includeValue)
+}
+
+// RemoveItemListener removes the item listener with the given registrationID.
+func (q *Queue[T]) RemoveItemListener(registrationID string) (removed bool, err error) {
+	return q.q.RemoveItemListener(registrationID)
+}
+
+// ItemEvent is an item event of a Queue with the item decoded.
+type ItemEvent[T any] struct {
+	// Name is the name of the queue.
+	Name string
+
+	// Member is the member that fired the event.
+	Member core.Member
+
+	// EventType is 1 if the item is added and 2 if it is removed.
+	EventType int32
+
+	Item T
+}
+
+// ItemListener is a set of callbacks for the item events of a Queue.
+type ItemListener[T any] struct {
+	Added   func(event ItemEvent[T])
+	Removed func(event ItemEvent[T])
+
+	// DecodeFailed is called with a *DecodeError when the item of an event cannot be decoded.
+	// The event is dropped if it is not set.
+	DecodeFailed func(err error)
+}
+
+// itemListenerAdapter implements the untyped listener interfaces for an ItemListener.
+type itemListenerAdapter[T any] struct {
+	listener *ItemListener[T]
+}
+
+func (a *itemListenerAdapter[T]) ItemAdded(event core.ItemEvent) {
+	a.fire(a.listener.Added, event)
+}
+
+func (a *itemListenerAdapter[T]) ItemRemoved(event core.ItemEvent) {
+	a.fire(a.listener.Removed, event)
+}
+
+func (a *itemListenerAdapter[T]) fire(callback func(ItemEvent[T]), event core.ItemEvent) {
+	if callback == nil {
+		return
+	}
+	item, err := decode[T](event.Item())
+	if err != nil {
+		if a.listener.DecodeFailed != nil {
+			a.listener.DecodeFailed(err)
+		}
+		return
+	}
+	callback(ItemEvent[T]{
+		Name:      event.Name(),
+		Member:    event.Member(),
+		EventType: event.EventType(),
+		Item:      item,
+	})
+}
+
+func toInterfaceSlice[T any](items []T) []interface{} {
+	if items == nil {
+		return nil
+	}
+	untyped := make([]interface{}, len(items))
+	for i, item := range items {
+		untyped[i] = item
+	}
+	return untyped
+}
@@ -0,0 +1,121 @@
+//go:build go1.18
+// +build go1.18
+
+// Copyright (c) 2008-2018, Hazelcast, Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typed
+
+import (
+	"time"
+
+	"github.com/hazelcast/hazelcast-go-client"
+	"github.com/hazelcast/hazelcast-go-client/core"
+)
+
+// Topic is a view of a core.Topic whose messages are of type T.
+// Both the topics and the reliable topics can be wrapped.
+type Topic[T any] struct {
+	t core.Topic
+}
+
+// NewTopic returns a typed view of the given topic.
+func NewTopic[T any](t core.Topic) *Topic[T] {
+	return &Topic[T]{t: t}
+}
+
+// GetTopic returns a typed view of the distributed topic with the given name.
+func GetTopic[T any](client hazelcast.Instance, name string) (*Topic[T], error) {
+	t, err := client.GetTopic(name)
+	if err != nil {
+		return nil, err
+	}
+	return NewTopic[T](t), nil
+}
+
+// GetReliableTopic returns a typed view of the reliable topic with the given name.
+func GetReliableTopic[T any](client hazelcast.Instance, name string) (*Topic[T], error) {
+	t, err := client.GetReliableTopic(name)
+	if err != nil {
+		return nil, err
+	}
+	return NewTopic[T](t), nil
+}
+
+// Unwrap returns the underlying untyped topic.
+func (t *Topic[T]) Unwrap() core.Topic {
+	return t.t
+}
+
+// Name returns the name of the topic.
+func (t *Topic[T]) Name() string {
+	return t.t.Name()
+}
+
+// Publish publishes the message to all the subscribers of the topic.
+func (t *Topic[T]) Publish(message T) (err error) {
+	return t.t.Publish(message)
+}
+
+// AddMessageListener subscribes the listener to the topic.
+func (t *Topic[T]) AddMessageListener(listener *MessageListener[T]) (registrationID string, err error) {
+	if listener == nil || listener.Message == nil {
+		return "", core.NewHazelcastNilPointerError("message listener callback should not be nil", nil)
+	}
+	return t.t.AddMessageListener(&messageListenerAdapter[T]{listener: listener})
+}
+
+// RemoveMessageListener removes the message listener with the given registrationID.
+func (t *Topic[T]) RemoveMessageListener(registrationID string) (removed bool, err error) {
+	return t.t.RemoveMessageListener(registrationID)
+}
+
+// Message is a message of a Topic with the published object decoded.
+type Message[T any] struct {
+	Value       T
+	PublishTime time.Time
+
+	// PublishingMember is the member that published the message,
+	// it is nil if the message was published by a client.
+	PublishingMember core.Member
+}
+
+// MessageListener is a set of callbacks for the messages of a Topic.
+type MessageListener[T any] struct {
+	Message func(message Message[T])
+
+	// DecodeFailed is called with a *DecodeError when a message cannot be decoded.
+	// The message is dropped if it is not set.
+	DecodeFailed func(err error)
+}
+
+// messageListenerAdapter implements core.MessageListener for a MessageListener.
+type messageListenerAdapter[T any] struct {
+	listener *MessageListener[T]
+}
+
+func (a *messageListenerAdapter[T]) OnMessage(message core.Message) {
+	value, err := decode[T](message.MessageObject())
+	if err != nil {
+		if a.listener.DecodeFailed != nil {
+			a.listener.DecodeFailed(err)
+		}
+		return
+	}
+	a.listener.Message(Message[T]{
+		Value:            value,
+		PublishTime:      message.PublishTime(),
+		PublishingMember: message.PublishingMember(),
+	})
+}